@@ -192,6 +192,7 @@ func setUp(t *testing.T) (paramkeeper.Keeper, *minfeekeeper.Keeper, storetypes.C
 	storeKey := storetypes.NewKVStoreKey(paramtypes.StoreKey)
 	mfStoreKey := storetypes.NewKVStoreKey(minfeetypes.StoreKey)
 	tStoreKey := storetypes.NewTransientStoreKey(paramtypes.TStoreKey)
+	mfTStoreKey := storetypes.NewTransientStoreKey(minfeetypes.TransientStoreKey)
 
 	// Create the state store
 	db := dbm.NewMemDB()
@@ -199,6 +200,7 @@ func setUp(t *testing.T) (paramkeeper.Keeper, *minfeekeeper.Keeper, storetypes.C
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(mfStoreKey, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(tStoreKey, storetypes.StoreTypeTransient, nil)
+	stateStore.MountStoreWithDB(mfTStoreKey, storetypes.StoreTypeTransient, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
@@ -207,6 +209,6 @@ func setUp(t *testing.T) (paramkeeper.Keeper, *minfeekeeper.Keeper, storetypes.C
 	paramsKeeper := paramkeeper.NewKeeper(codec.NewProtoCodec(registry), codec.NewLegacyAmino(), storeKey, tStoreKey)
 	subspace := paramsKeeper.Subspace(minfeetypes.ModuleName)
 
-	mfk := minfeekeeper.NewKeeper(encoding.MakeConfig(app.ModuleEncodingRegisters...).Codec, mfStoreKey, paramsKeeper, subspace, "")
+	mfk := minfeekeeper.NewKeeper(encoding.MakeConfig(app.ModuleEncodingRegisters...).Codec, mfStoreKey, mfTStoreKey, paramsKeeper, subspace, "")
 	return paramsKeeper, mfk, stateStore
 }
@@ -246,7 +246,7 @@ func New(
 	baseApp.SetInterfaceRegistry(encodingConfig.InterfaceRegistry)
 
 	keys := storetypes.NewKVStoreKeys(allStoreKeys()...)
-	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, minfeetypes.TransientStoreKey)
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	govModuleAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
@@ -336,7 +336,9 @@ func New(
 	app.SignalKeeper = signal.NewKeeper(
 		encodingConfig.Codec,
 		keys[signaltypes.StoreKey],
+		runtime.NewKVStoreService(keys[signaltypes.HistoryStoreKey]),
 		app.StakingKeeper,
+		govModuleAddr,
 	)
 
 	app.IBCKeeper = ibckeeper.NewKeeper(
@@ -409,7 +411,7 @@ func New(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	app.MinFeeKeeper = minfeekeeper.NewKeeper(encodingConfig.Codec, keys[minfeetypes.StoreKey], app.ParamsKeeper, app.GetSubspace(minfeetypes.ModuleName), authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	app.MinFeeKeeper = minfeekeeper.NewKeeper(encodingConfig.Codec, keys[minfeetypes.StoreKey], tkeys[minfeetypes.TransientStoreKey], app.ParamsKeeper, app.GetSubspace(minfeetypes.ModuleName), authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
 	ibcRouter := ibcporttypes.NewRouter()                                                   // Create static IBC router
@@ -623,7 +625,10 @@ func (app *App) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFin
 }
 
 // PreBlocker application updates every pre block
-func (app *App) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+func (app *App) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	// Record the size of the block so that the minfee end blocker can adjust
+	// the dynamic network min gas price.
+	app.MinFeeKeeper.SetBlockFill(ctx, app.blockFill(ctx, req.Txs))
 	return app.ModuleManager.PreBlock(ctx)
 }
 
@@ -668,6 +673,9 @@ func (app *App) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
 				return sdk.EndBlock{}, err
 			}
 			app.SignalKeeper.ResetTally(ctx)
+			if err := app.SignalKeeper.PruneSignalHistory(ctx, signalUpgrade.AppVersion); err != nil {
+				return sdk.EndBlock{}, fmt.Errorf("failed to prune signal history: %w", err)
+			}
 		}
 	}
 
@@ -855,8 +863,7 @@ func (app *App) getMinGasPrice() (float64, error) {
 	if err != nil {
 		return localMinGasPrice, err
 	}
-	params := app.MinFeeKeeper.GetParams(ctx)
-	networkMinGasPrice := params.NetworkMinGasPrice.MustFloat64()
+	networkMinGasPrice := app.MinFeeKeeper.GetNetworkMinGasPrice(ctx).MustFloat64()
 	return math.Max(networkMinGasPrice, localMinGasPrice), nil
 }
 
@@ -48,6 +48,12 @@ func (app *App) ExportAppStateAndValidators(
 	}, err
 }
 
+// ExportModuleStates exports the genesis states of modulesToExport from the
+// state in ctx. It is used by the verifiable state export.
+func (app *App) ExportModuleStates(ctx sdk.Context, modulesToExport []string) (map[string]json.RawMessage, error) {
+	return app.ModuleManager.ExportGenesisForModules(ctx, app.AppCodec(), modulesToExport)
+}
+
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 // in favour of export at a block height
@@ -3,6 +3,7 @@ package app
 import (
 	"cosmossdk.io/log"
 	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v10/x/blob/types"
 	fibretypes "github.com/celestiaorg/celestia-app/v10/x/fibre/types"
 	square "github.com/celestiaorg/go-square/v4"
 	"github.com/celestiaorg/go-square/v4/tx"
@@ -17,10 +18,11 @@ import (
 // FilteredSquareBuilder filters txs and blobs using a copy of the state and tx validity
 // rules before adding it the square.
 type FilteredSquareBuilder struct {
-	handler   sdk.AnteHandler
-	msgRouter baseapp.MessageRouter
-	txConfig  client.TxConfig
-	builder   *square.Builder
+	handler      sdk.AnteHandler
+	msgRouter    baseapp.MessageRouter
+	txConfig     client.TxConfig
+	builder      *square.Builder
+	reservations *blobtypes.ReservationLanes
 }
 
 func NewFilteredSquareBuilder(
@@ -50,6 +52,12 @@ func (fsb *FilteredSquareBuilder) Builder() *square.Builder {
 	return fsb.builder
 }
 
+// SetReservationLanes sets the square space reservations that blob txs are
+// prioritised by in Fill.
+func (fsb *FilteredSquareBuilder) SetReservationLanes(lanes *blobtypes.ReservationLanes) {
+	fsb.reservations = lanes
+}
+
 func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte, maxTxBytes int64) [][]byte {
 	logger := ctx.Logger().With("app/filtered-square-builder")
 
@@ -88,7 +96,6 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte, maxTxBytes
 		pfbMessageCount = 0
 		dec             = fsb.txConfig.TxDecoder()
 		n               = 0
-		m               = 0
 	)
 
 	for _, tx := range normalTxs {
@@ -136,29 +143,28 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte, maxTxBytes
 		n++
 	}
 
-	for i, tx := range blobTxs {
-		sdkTx, err := dec(tx.Tx)
-		if err != nil {
-			logger.Error("decoding already checked blob transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()), "error", err)
-			continue
-		}
+	// addBlobTx appends the blob tx to the square and runs the ante handler
+	// on it. It returns false if the blob tx was not added.
+	keptBlobTxs := make([][]byte, 0, len(blobTxs))
+	addBlobTx := func(i int, sdkTx sdk.Tx) bool {
+		tx := blobTxs[i]
 
 		// Set the tx size on the context before calling the AnteHandler
 		ctx = ctx.WithTxBytes(tx.Tx)
 
 		if pfbMessageCount+len(sdkTx.GetMsgs()) > appconsts.MaxPFBMessages {
 			logger.Debug("skipping blob tx because the max pfb message count was reached", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()))
-			continue
+			return false
 		}
 
 		ok, err := fsb.builder.AppendBlobTx(tx)
 		if err != nil {
 			logger.Debug("skipping blob tx due to error", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()), "err", err)
-			continue
+			return false
 		}
 		if !ok {
 			logger.Debug("skipping tx because it was too large to fit in the square", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()))
-			continue
+			return false
 		}
 
 		ctx, err = fsb.handler(ctx, sdkTx, false)
@@ -174,21 +180,92 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte, maxTxBytes
 			if err != nil {
 				logger.Error("reverting last blob transaction failed", "error", err)
 			}
-			continue
+			return false
 		}
 
 		pfbMessageCount += len(sdkTx.GetMsgs())
-		rawBlobTxs[m] = rawBlobTxs[i]
-		m++
+		keptBlobTxs = append(keptBlobTxs, rawBlobTxs[i])
+		return true
+	}
+
+	sdkBlobTxs := make([]sdk.Tx, len(blobTxs))
+	for i, tx := range blobTxs {
+		sdkTx, err := dec(tx.Tx)
+		if err != nil {
+			logger.Error("decoding already checked blob transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()), "error", err)
+			continue
+		}
+		sdkBlobTxs[i] = sdkTx
+	}
+
+	// Blob txs that fit in a reservation are added first, in priority order,
+	// so that they form a lane ahead of the open market. The blob txs of a
+	// signer must stay in sequence order, so only the leading blob txs of each
+	// signer that fit in a reservation are added here. Once a blob tx of a
+	// signer is not added, all later blob txs of that signer are left for the
+	// open market.
+	added := make([]bool, len(blobTxs))
+	if fsb.reservations != nil {
+		deferred := make(map[string]struct{})
+		for i, sdkTx := range sdkBlobTxs {
+			if sdkTx == nil {
+				continue
+			}
+			pfb, ok := hasPFB(sdkTx.GetMsgs())
+			if !ok {
+				continue
+			}
+			if _, ok := deferred[pfb.Signer]; ok {
+				continue
+			}
+			if reserved, err := fsb.reservations.CanAdmit(pfb); err != nil || !reserved {
+				deferred[pfb.Signer] = struct{}{}
+				continue
+			}
+			if !addBlobTx(i, sdkTx) {
+				deferred[pfb.Signer] = struct{}{}
+				continue
+			}
+			added[i] = true
+			if err := fsb.reservations.Admit(pfb); err != nil {
+				logger.Error("admitting reserved blob tx", "tx", tmbytes.HexBytes(coretypes.Tx(blobTxs[i].Tx).Hash()), "error", err)
+			}
+		}
+	}
+
+	// All other blob txs, including those that exceeded their reservation,
+	// compete for the shares that are not reserved, in their original order.
+	for i, sdkTx := range sdkBlobTxs {
+		if sdkTx == nil || added[i] {
+			continue
+		}
+		pfb, ok := hasPFB(sdkTx.GetMsgs())
+		if ok && fsb.reservations != nil {
+			// ProcessProposal rejects a blob tx that fits in a reservation
+			// after the open market has started, or that exceeds the shares
+			// that are not reserved.
+			if reserved, err := fsb.reservations.CanAdmit(pfb); err != nil || reserved {
+				logger.Debug("skipping blob tx that does not fit in the open market", "tx", tmbytes.HexBytes(coretypes.Tx(blobTxs[i].Tx).Hash()), "err", err)
+				continue
+			}
+		}
+		if !addBlobTx(i, sdkTx) {
+			continue
+		}
+		if ok && fsb.reservations != nil {
+			if err := fsb.reservations.Admit(pfb); err != nil {
+				logger.Error("admitting open market blob tx", "tx", tmbytes.HexBytes(coretypes.Tx(blobTxs[i].Tx).Hash()), "error", err)
+			}
+		}
 	}
 
 	fibreTxs := processFibreTxsForSquare(fsb, ctx, payForFibreTxs)
 
-	kept := make([][]byte, 0, n+m+len(fibreTxs))
+	kept := make([][]byte, 0, n+len(keptBlobTxs)+len(fibreTxs))
 	kept = append(kept, normalTxs[:n]...)
 	// separateTxs only keeps canonically encoded blob txs, so the raw bytes
 	// are identical to re-marshaling the decoded blob txs and can be reused.
-	kept = append(kept, rawBlobTxs[:m]...)
+	kept = append(kept, keptBlobTxs...)
 	kept = append(kept, fibreTxs...)
 	return kept
 }
@@ -232,5 +232,6 @@ func allStoreKeys() []string {
 		zkismtypes.StoreKey,       // added in v7
 		valaddrtypes.StoreKey,
 		fibretypes.StoreKey,
+		signaltypes.HistoryStoreKey,
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v10/app/ante"
 	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v10/pkg/da"
+	blobtypes "github.com/celestiaorg/celestia-app/v10/x/blob/types"
 	"github.com/celestiaorg/go-square/v4/share"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -46,6 +47,8 @@ func (app *App) PrepareProposalHandler(ctx sdk.Context, req *abci.RequestPrepare
 		return nil, fmt.Errorf("failed to create FilteredSquareBuilder: %w", err)
 	}
 
+	fsb.SetReservationLanes(blobtypes.NewReservationLanes(app.BlobKeeper.GetReservations(ctx), app.BlobKeeper.MaxSquareShares(ctx)))
+
 	txs := fsb.Fill(ctx, req.Txs, req.MaxTxBytes)
 
 	// Build the square from the set of valid and prioritised transactions.
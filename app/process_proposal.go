@@ -56,6 +56,7 @@ func (app *App) ProcessProposalHandler(ctx sdk.Context, req *abci.RequestProcess
 		app.pffSigCache,
 	)
 	blockHeader := ctx.BlockHeader()
+	reservations := blobtypes.NewReservationLanes(app.BlobKeeper.GetReservations(ctx), app.BlobKeeper.MaxSquareShares(ctx))
 
 	var (
 		sdkMessageCount int
@@ -179,6 +180,15 @@ func (app *App) ProcessProposalHandler(ctx sdk.Context, req *abci.RequestProcess
 			return reject(), nil
 		}
 
+		// Blob txs that fit in a reservation must precede all other blob txs,
+		// which may only occupy the shares that are not reserved.
+		if pfb, ok := hasPFB(sdkTx.GetMsgs()); ok {
+			if err := reservations.Admit(pfb); err != nil {
+				logInvalidPropBlockError(app.Logger(), blockHeader, fmt.Sprintf("blob tx %d violates reservations", idx), err)
+				return reject(), nil
+			}
+		}
+
 	}
 
 	// Classify txs (marking pay-for-fibre txs and synthesizing their system
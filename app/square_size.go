@@ -1,13 +1,25 @@
 package app
 
 import (
-	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/go-square/v4/share"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // MaxEffectiveSquareSize returns the max effective square size.
 func (app *App) MaxEffectiveSquareSize(ctx sdk.Context) int {
-	govMax := app.BlobKeeper.GetParams(ctx).GovMaxSquareSize
-	hardMax := appconsts.GetSquareSizeUpperBound(ctx.ChainID())
-	return min(int(govMax), hardMax)
+	return app.BlobKeeper.MaxEffectiveSquareSize(ctx)
+}
+
+// blockFill returns the fraction of the max effective square that txs fill,
+// measured in bytes. PrepareProposal fills blocks up to the same square size.
+func (app *App) blockFill(ctx sdk.Context, txs [][]byte) math.LegacyDec {
+	maxSquareSize := int64(app.MaxEffectiveSquareSize(ctx))
+	maxBytes := maxSquareSize * maxSquareSize * share.ShareSize
+
+	var size int64
+	for _, tx := range txs {
+		size += int64(len(tx))
+	}
+	return math.LegacyNewDec(size).QuoInt64(maxBytes)
 }
@@ -0,0 +1,153 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v10/app"
+	"github.com/celestiaorg/celestia-app/v10/app/encoding"
+	"github.com/celestiaorg/celestia-app/v10/pkg/user"
+	testutil "github.com/celestiaorg/celestia-app/v10/test/util"
+	"github.com/celestiaorg/celestia-app/v10/test/util/random"
+	"github.com/celestiaorg/celestia-app/v10/test/util/testfactory"
+	blobtypes "github.com/celestiaorg/celestia-app/v10/x/blob/types"
+	"github.com/celestiaorg/go-square/v4/share"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	coretypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPrepareProposalKeepsReservedBlobTxsInSequence verifies that a signer
+// whose blob txs only partly fit in a reservation keeps its blob txs in
+// sequence order: the leading reserved blob tx is added to the reserved lane
+// and the rest follow in the open market.
+func TestPrepareProposalKeepsReservedBlobTxsInSequence(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := testfactory.GenerateAccounts(2)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+
+	reservedNamespace := share.RandomBlobNamespace()
+	openNamespace := share.RandomBlobNamespace()
+	setReservations(t, testApp, blobtypes.DefaultGovMaxSquareSize, blobtypes.Reservation{
+		Namespace:     reservedNamespace.Bytes(),
+		ShareFraction: math.LegacyNewDecWithPrec(1, 1),
+	})
+
+	signers := newSignerFactory(t, kr, enc.TxConfig, accounts, queryAccountInfo(testApp, accounts, kr))
+	reservedSigner, openSigner := signers(0), signers(1)
+	newBlobTx := func(signer *user.Signer, account string, ns share.Namespace) []byte {
+		t.Helper()
+		blob, err := blobtypes.NewV0Blob(ns, random.Bytes(200))
+		require.NoError(t, err)
+		blobTx, _, err := signer.CreatePayForBlobs(account, []*share.Blob{blob}, user.SetGasLimitAndGasPrice(200_000, 1))
+		require.NoError(t, err)
+		require.NoError(t, signer.IncrementSequence(account))
+		return blobTx
+	}
+
+	// The second blob tx of the reserved signer does not fit in the
+	// reservation, so the third one must follow it in the open market.
+	first := newBlobTx(reservedSigner, accounts[0], reservedNamespace)
+	second := newBlobTx(reservedSigner, accounts[0], openNamespace)
+	third := newBlobTx(reservedSigner, accounts[0], reservedNamespace)
+	open := newBlobTx(openSigner, accounts[1], openNamespace)
+
+	height, blockTime := testApp.LastBlockHeight()+1, time.Now()
+	prepareResp, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+		Txs:    [][]byte{open, first, second, third},
+		Height: height,
+		Time:   blockTime,
+	})
+	require.NoError(t, err)
+
+	var blobTxs [][]byte
+	for _, tx := range prepareResp.Txs {
+		if _, isBlobTx := coretypes.UnmarshalBlobTx(tx); isBlobTx {
+			blobTxs = append(blobTxs, tx)
+		}
+	}
+	require.Equal(t, [][]byte{first, open, second, third}, blobTxs)
+
+	processResp, err := testApp.ProcessProposal(&abci.RequestProcessProposal{
+		Time:         blockTime,
+		Height:       height,
+		Txs:          prepareResp.Txs,
+		DataRootHash: prepareResp.DataRootHash,
+		SquareSize:   prepareResp.SquareSize,
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processResp.Status)
+}
+
+// TestProcessProposalEnforcesReservedCapacity verifies that a proposer cannot
+// give shares that are reserved to blob txs outside of a reservation, even if
+// no blob tx uses the reservation.
+func TestProcessProposalEnforcesReservedCapacity(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := testfactory.GenerateAccounts(2)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+
+	// Half of the 64 shares of an 8x8 square are reserved.
+	setReservations(t, testApp, 8, blobtypes.Reservation{
+		Namespace:     share.RandomBlobNamespace().Bytes(),
+		ShareFraction: math.LegacyNewDecWithPrec(5, 1),
+	})
+
+	signers := newSignerFactory(t, kr, enc.TxConfig, accounts, queryAccountInfo(testApp, accounts, kr))
+	txs := make([][]byte, len(accounts))
+	for i, account := range accounts {
+		// Each blob occupies 20 shares, so both exceed the 32 unreserved shares.
+		blob, err := blobtypes.NewV0Blob(share.RandomBlobNamespace(), random.Bytes(share.AvailableBytesFromSparseShares(20)))
+		require.NoError(t, err)
+		txs[i], _, err = signers(i).CreatePayForBlobs(account, []*share.Blob{blob}, user.SetGasLimitAndGasPrice(1_000_000, 1))
+		require.NoError(t, err)
+	}
+
+	height, blockTime := testApp.LastBlockHeight()+1, time.Now()
+	prepareResp, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+		Txs:    txs,
+		Height: height,
+		Time:   blockTime,
+	})
+	require.NoError(t, err)
+	require.Len(t, prepareResp.Txs, 1, "only one blob tx fits in the unreserved shares")
+
+	processResp, err := testApp.ProcessProposal(&abci.RequestProcessProposal{
+		Time:         blockTime,
+		Height:       height,
+		Txs:          txs,
+		DataRootHash: calculateNewDataHash(t, txs),
+		SquareSize:   8,
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processResp.Status)
+}
+
+// setReservations commits a block that sets the gov max square size and the
+// reservations.
+func setReservations(t *testing.T, testApp *app.App, govMaxSquareSize uint64, reservations ...blobtypes.Reservation) {
+	t.Helper()
+	height := testApp.LastBlockHeight() + 1
+	_, err := testApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: height,
+		Time:   testutil.GenesisTime.Add(time.Duration(height) * time.Second),
+		Hash:   testApp.LastCommitID().Hash,
+	})
+	require.NoError(t, err)
+
+	ctx := testApp.NewUncachedContext(false, cmtproto.Header{Height: height, ChainID: testutil.ChainID})
+	params := testApp.BlobKeeper.GetParams(ctx)
+	params.GovMaxSquareSize = govMaxSquareSize
+	testApp.BlobKeeper.SetParams(ctx, params)
+	for _, reservation := range reservations {
+		_, err := testApp.BlobKeeper.SetReservation(ctx, &blobtypes.MsgSetReservation{
+			Authority:   testApp.BlobKeeper.GetAuthority(),
+			Reservation: reservation,
+		})
+		require.NoError(t, err)
+	}
+	_, err = testApp.Commit()
+	require.NoError(t, err)
+}
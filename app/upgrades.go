@@ -10,6 +10,7 @@ import (
 	blobtypes "github.com/celestiaorg/celestia-app/v10/x/blob/types"
 	fibretypes "github.com/celestiaorg/celestia-app/v10/x/fibre/types"
 	minfeetypes "github.com/celestiaorg/celestia-app/v10/x/minfee/types"
+	signaltypes "github.com/celestiaorg/celestia-app/v10/x/signal/types"
 	valaddrtypes "github.com/celestiaorg/celestia-app/v10/x/valaddr/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -92,7 +93,7 @@ func (app App) RegisterUpgradeHandlers() {
 
 	if upgradeInfo.Name == upgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) { //nolint:staticcheck
 		storeUpgrades := storetypes.StoreUpgrades{
-			Added: []string{fibretypes.StoreKey, valaddrtypes.StoreKey},
+			Added: []string{fibretypes.StoreKey, valaddrtypes.StoreKey, signaltypes.HistoryStoreKey},
 		}
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
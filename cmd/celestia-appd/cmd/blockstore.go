@@ -0,0 +1,546 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v10/pkg/blockarchive"
+	cometbftdb "github.com/cometbft/cometbft-db"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagExportFrom  = "from"
+	flagExportTo    = "to"
+	flagExportOut   = "out"
+	flagTrustedHash = "trusted-hash"
+
+	// blockstoreProgressInterval is the number of blocks after which export
+	// and import log their progress.
+	blockstoreProgressInterval = 1000
+)
+
+func blockstoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blockstore",
+		Short: "Export and import blocks of the CometBFT blockstore",
+	}
+	cmd.AddCommand(blockstoreExportCommand(), blockstoreImportCommand())
+	return cmd
+}
+
+func blockstoreExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export blocks, their commits and data square shares to an archive",
+		Long: `Export blocks, their commits and the shares of their original data square to
+a portable archive, for example before the blocks are pruned.
+
+The shares are computed from the transactions of each block and verified
+against its data hash, so an archive that was written successfully is
+consistent with the block headers it contains.
+
+The node MUST be stopped before running this command.
+
+Examples:
+  celestia-appd blockstore export --from 1 --to 100000 --out blocks-1-100000.gz
+`,
+		Args: cobra.NoArgs,
+		RunE: runBlockstoreExport,
+	}
+	cmd.Flags().Int64(flagExportFrom, 0, "First height to export (defaults to the base of the blockstore)")
+	cmd.Flags().Int64(flagExportTo, 0, "Last height to export (defaults to the height of the blockstore)")
+	cmd.Flags().String(flagExportOut, "", "Path of the archive to write")
+	_ = cmd.MarkFlagRequired(flagExportOut)
+	return cmd
+}
+
+func blockstoreImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [archive]",
+		Short: "Import blocks from an archive into the blockstore",
+		Long: `Import blocks from an archive written by "blockstore export" into the
+blockstore, for example to seed a new node, to backfill the history of a
+state-synced node or to extend an archival node.
+
+The whole archive is verified before any block is saved. The data square
+shares of every block are verified against its data hash and every block
+must be the one the next block of the archive refers to by its last block ID
+and last commit hash, so the last block of the archive authenticates all
+others. The last block itself is trusted if
+  - the next block of the blockstore refers to it,
+  - its hash matches --trusted-hash, e.g. taken from a trusted RPC endpoint or
+    block explorer, or
+  - its commit is signed by the validator set of its height in the state of
+    the node.
+
+The archive may end right below the base of the blockstore, which backfills
+its history, or continue the blockstore. Blocks the blockstore already
+contains are skipped and a gap between the blockstore and the archive is
+rejected. Only the blockstore is written.
+
+The node MUST be stopped before running this command.
+
+Examples:
+  celestia-appd blockstore import blocks-1-100000.gz --home /var/lib/celestia-app
+  celestia-appd blockstore import blocks-1-100000.gz --trusted-hash <hash of block 100000>
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runBlockstoreImport,
+	}
+	cmd.Flags().String(flagTrustedHash, "", "Hex encoded hash of the last block of the archive")
+	return cmd
+}
+
+func runBlockstoreExport(cmd *cobra.Command, _ []string) error {
+	sctx := server.GetServerContextFromCmd(cmd)
+
+	from, err := cmd.Flags().GetInt64(flagExportFrom)
+	if err != nil {
+		return err
+	}
+	to, err := cmd.Flags().GetInt64(flagExportTo)
+	if err != nil {
+		return err
+	}
+	out, err := cmd.Flags().GetString(flagExportOut)
+	if err != nil {
+		return err
+	}
+
+	blockStore, closeDB, err := openBlockStore(sctx)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	file, err := os.Create(out)
+	if err != nil {
+		return fmt.Errorf("failed to create archive: %w", err)
+	}
+	if err := exportBlocks(sctx.Logger, blockStore, file, from, to); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func runBlockstoreImport(cmd *cobra.Command, args []string) error {
+	sctx := server.GetServerContextFromCmd(cmd)
+
+	trustedHash, err := cmd.Flags().GetString(flagTrustedHash)
+	if err != nil {
+		return err
+	}
+	hash, err := hex.DecodeString(trustedHash)
+	if err != nil {
+		return fmt.Errorf("invalid trusted hash: %w", err)
+	}
+
+	openArchive := func() (io.ReadCloser, error) {
+		file, err := os.Open(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to open archive: %w", err)
+		}
+		return file, nil
+	}
+
+	blockStore, closeBlockStoreDB, err := openBlockStore(sctx)
+	if err != nil {
+		return err
+	}
+	// the blockstore is closed before it is replaced.
+	closeDB := sync.OnceFunc(closeBlockStoreDB)
+	defer closeDB()
+
+	stateStore, closeStateDB, err := openStateStore(sctx)
+	if err != nil {
+		return err
+	}
+	defer closeStateDB()
+
+	// blocks below the base of the blockstore are imported into a new
+	// blockstore that replaces the existing one.
+	var closeImportDB func()
+	newBlockStore := func() (*store.BlockStore, error) {
+		// a previous import may have been interrupted.
+		if err := os.RemoveAll(blockStoreDBPath(sctx, importBlockStoreDB)); err != nil {
+			return nil, err
+		}
+		importStore, closeDB, err := openBlockStoreDB(sctx, importBlockStoreDB)
+		if err != nil {
+			return nil, err
+		}
+		closeImportDB = closeDB
+		return importStore, nil
+	}
+
+	imported, err := importBlocks(sctx.Logger, blockStore, stateStore, hash, openArchive, newBlockStore)
+	if closeImportDB == nil {
+		return err
+	}
+	closeImportDB()
+	if err != nil {
+		return errors.Join(err, os.RemoveAll(blockStoreDBPath(sctx, importBlockStoreDB)))
+	}
+	if imported == blockStore {
+		return nil
+	}
+	closeDB()
+	return replaceBlockStoreDB(sctx)
+}
+
+// importBlockStoreDB is the name of the database blocks are imported into
+// before it replaces the blockstore.
+const importBlockStoreDB = "blockstore-import"
+
+// blockStoreDBPath returns the path of the database with name of the node in
+// sctx.
+func blockStoreDBPath(sctx *server.Context, name string) string {
+	return filepath.Join(sctx.Config.DBDir(), name+".db")
+}
+
+// replaceBlockStoreDB replaces the blockstore of the node in sctx with the
+// database blocks were imported into.
+func replaceBlockStoreDB(sctx *server.Context) error {
+	current := blockStoreDBPath(sctx, "blockstore")
+	backup := current + ".bak"
+	if err := os.Rename(current, backup); err != nil {
+		return fmt.Errorf("failed to move blockstore: %w", err)
+	}
+	if err := os.Rename(blockStoreDBPath(sctx, importBlockStoreDB), current); err != nil {
+		return errors.Join(fmt.Errorf("failed to replace blockstore: %w", err), os.Rename(backup, current))
+	}
+	return os.RemoveAll(backup)
+}
+
+// openBlockStore opens the blockstore of the node in sctx. The returned
+// function closes the underlying database.
+func openBlockStore(sctx *server.Context) (*store.BlockStore, func(), error) {
+	return openBlockStoreDB(sctx, "blockstore")
+}
+
+// openBlockStoreDB opens a blockstore in the database with name of the node in
+// sctx. The returned function closes the underlying database.
+func openBlockStoreDB(sctx *server.Context, name string) (*store.BlockStore, func(), error) {
+	cfg := sctx.Config
+	db, err := cometbftdb.NewDB(name, cometbftdb.BackendType(cfg.DBBackend), cfg.DBDir())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open %s: %w", name, err)
+	}
+	closeDB := func() {
+		if err := db.Close(); err != nil {
+			sctx.Logger.Warn("error closing database", "db", name, "err", err)
+		}
+	}
+	return store.NewBlockStore(db), closeDB, nil
+}
+
+// openStateStore opens the state store of the node in sctx. The returned
+// function closes the underlying database.
+func openStateStore(sctx *server.Context) (sm.Store, func(), error) {
+	cfg := sctx.Config
+	db, err := cometbftdb.NewDB("state", cometbftdb.BackendType(cfg.DBBackend), cfg.DBDir())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open state store: %w", err)
+	}
+	closeDB := func() {
+		if err := db.Close(); err != nil {
+			sctx.Logger.Warn("error closing database", "db", "state", "err", err)
+		}
+	}
+	return sm.NewStore(db, sm.StoreOptions{DiscardABCIResponses: false}), closeDB, nil
+}
+
+// validatorStore loads the validator set of a height. It is implemented by
+// the state store.
+type validatorStore interface {
+	LoadValidators(height int64) (*types.ValidatorSet, error)
+}
+
+// exportBlocks writes the blocks from to to of blockStore to an archive in w.
+// A from or to of 0 defaults to the base or the height of the blockstore.
+func exportBlocks(logger log.Logger, blockStore *store.BlockStore, w io.Writer, from, to int64) error {
+	if from == 0 {
+		from = blockStore.Base()
+	}
+	if to == 0 {
+		to = blockStore.Height()
+	}
+	if from < blockStore.Base() || to > blockStore.Height() || from > to {
+		return fmt.Errorf("invalid range %d to %d: the blockstore contains heights %d to %d", from, to, blockStore.Base(), blockStore.Height())
+	}
+
+	first := blockStore.LoadBlock(from)
+	if first == nil {
+		return fmt.Errorf("block %d not found", from)
+	}
+	archive, err := blockarchive.NewWriter(w, blockarchive.Header{ChainID: first.ChainID, FromHeight: from, ToHeight: to})
+	if err != nil {
+		return fmt.Errorf("failed to write archive header: %w", err)
+	}
+
+	logger.Info("exporting blocks", "from", from, "to", to)
+	for height := from; height <= to; height++ {
+		block := blockStore.LoadBlock(height)
+		if block == nil {
+			return fmt.Errorf("block %d not found", height)
+		}
+		entry, err := blockarchive.NewEntry(block, loadCommit(blockStore, height))
+		if err != nil {
+			return err
+		}
+		if err := archive.Write(entry); err != nil {
+			return fmt.Errorf("failed to write block %d: %w", height, err)
+		}
+		if height%blockstoreProgressInterval == 0 {
+			logger.Info("exported blocks", "height", height, "to", to)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("failed to complete archive: %w", err)
+	}
+	logger.Info("export complete", "from", from, "to", to)
+	return nil
+}
+
+// loadCommit returns the canonical commit for the block at height or the
+// commit the node has seen if the next block is not stored yet.
+func loadCommit(blockStore *store.BlockStore, height int64) *types.Commit {
+	if commit := blockStore.LoadBlockCommit(height); commit != nil {
+		return commit
+	}
+	return blockStore.LoadSeenCommit(height)
+}
+
+// archiveLink is what the next block of an archive commits to about a block.
+type archiveLink struct {
+	blockHash  []byte
+	commitHash []byte
+}
+
+// importBlocks saves the blocks of the archive opened by openArchive to
+// blockStore. The archive is verified by verifyArchive before any block is
+// saved. If the archive backfills blocks below the base of blockStore, the
+// blocks of the archive and of blockStore are saved to the blockstore returned
+// by newBlockStore. importBlocks returns the blockstore the blocks were saved
+// to.
+func importBlocks(
+	logger log.Logger,
+	blockStore *store.BlockStore,
+	validators validatorStore,
+	trustedHash []byte,
+	openArchive func() (io.ReadCloser, error),
+	newBlockStore func() (*store.BlockStore, error),
+) (*store.BlockStore, error) {
+	header, links, err := verifyArchive(logger, blockStore, validators, trustedHash, openArchive)
+	if err != nil {
+		return nil, err
+	}
+
+	base, height := blockStore.Base(), blockStore.Height()
+	if height > 0 && (header.ToHeight < base-1 || header.FromHeight > height+1) {
+		return nil, fmt.Errorf("blocks %d to %d of the archive do not continue the blockstore with blocks %d to %d", header.FromHeight, header.ToHeight, base, height)
+	}
+	target := blockStore
+	// blocks can only be saved in ascending order, so a backfill rebuilds the
+	// blockstore.
+	backfill := height > 0 && header.FromHeight < base
+	if backfill {
+		logger.Info("backfilling blocks below the base of the blockstore", "base", base)
+		target, err = newBlockStore()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r, err := openArchive()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	archive, err := blockarchive.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var imported, skipped int
+	for {
+		entry, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		block := entry.Block
+		// the archive must not have changed since it was verified.
+		i := block.Height - header.FromHeight
+		if i < 0 || i >= int64(len(links)) {
+			return nil, fmt.Errorf("block %d changed after the archive was verified", block.Height)
+		}
+		if link := links[i]; !bytes.Equal(block.Hash(), link.blockHash) || !bytes.Equal(entry.Commit.Hash(), link.commitHash) {
+			return nil, fmt.Errorf("block %d changed after the archive was verified", block.Height)
+		}
+
+		if backfill && block.Height == base {
+			if err := copyBlocks(target, blockStore); err != nil {
+				return nil, err
+			}
+			backfill = false
+		}
+		if block.Height <= target.Height() {
+			skipped++
+			continue
+		}
+		if target.Height() > 0 && block.Height != target.Height()+1 {
+			return nil, fmt.Errorf("block %d does not continue the blockstore at height %d", block.Height, target.Height())
+		}
+		if meta := target.LoadBlockMeta(block.Height - 1); meta != nil {
+			if err := entry.Follows(meta.BlockID); err != nil {
+				return nil, err
+			}
+		}
+
+		parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to make part set of block %d: %w", block.Height, err)
+		}
+		target.SaveBlock(block, parts, entry.Commit)
+		imported++
+		if block.Height%blockstoreProgressInterval == 0 {
+			logger.Info("imported blocks", "height", block.Height, "to", header.ToHeight)
+		}
+	}
+	if backfill {
+		if err := copyBlocks(target, blockStore); err != nil {
+			return nil, err
+		}
+	}
+	logger.Info("import complete", "imported", imported, "skipped", skipped, "base", target.Base(), "height", target.Height())
+	return target, nil
+}
+
+// verifyArchive verifies the archive opened by openArchive without saving any
+// of its blocks. Every block must be the one the next block refers to by its
+// last block ID and last commit hash, so the last block authenticates the
+// archive. The last block is trusted if the next block of blockStore refers
+// to it, if its hash is trustedHash or if its commit is signed by its
+// validator set in validators. verifyArchive returns the header of the archive
+// and the link of every block.
+func verifyArchive(logger log.Logger, blockStore *store.BlockStore, validators validatorStore, trustedHash []byte, openArchive func() (io.ReadCloser, error)) (blockarchive.Header, []archiveLink, error) {
+	r, err := openArchive()
+	if err != nil {
+		return blockarchive.Header{}, nil, err
+	}
+	defer r.Close()
+	archive, err := blockarchive.NewReader(r)
+	if err != nil {
+		return blockarchive.Header{}, nil, err
+	}
+	header := archive.Header()
+	logger.Info("verifying archive", "chain_id", header.ChainID, "from", header.FromHeight, "to", header.ToHeight)
+
+	var (
+		links []archiveLink
+		last  blockarchive.Entry
+	)
+	for {
+		entry, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return header, nil, err
+		}
+		if err := entry.Validate(); err != nil {
+			return header, nil, err
+		}
+
+		block := entry.Block
+		if block.ChainID != header.ChainID {
+			return header, nil, fmt.Errorf("block %d is from chain %s, not %s", block.Height, block.ChainID, header.ChainID)
+		}
+		if want := header.FromHeight + int64(len(links)); block.Height != want {
+			return header, nil, fmt.Errorf("archive contains block %d instead of block %d", block.Height, want)
+		}
+		if last.Block != nil {
+			if err := entry.Follows(last.Commit.BlockID); err != nil {
+				return header, nil, err
+			}
+			if !bytes.Equal(block.LastCommitHash, last.Commit.Hash()) {
+				return header, nil, fmt.Errorf("commit of block %d does not match the last commit hash of block %d", last.Block.Height, block.Height)
+			}
+		}
+		links = append(links, archiveLink{blockHash: block.Hash(), commitHash: entry.Commit.Hash()})
+		last = entry
+	}
+	if last.Block == nil || last.Block.Height != header.ToHeight {
+		return header, nil, fmt.Errorf("archive ends before block %d", header.ToHeight)
+	}
+
+	if err := verifyLastBlock(logger, blockStore, validators, trustedHash, header.ChainID, last); err != nil {
+		return header, nil, err
+	}
+	return header, links, nil
+}
+
+// verifyLastBlock checks that the last block of an archive can be trusted.
+func verifyLastBlock(logger log.Logger, blockStore *store.BlockStore, validators validatorStore, trustedHash []byte, chainID string, last blockarchive.Entry) error {
+	height := last.Block.Height
+	if meta := blockStore.LoadBlockMeta(height + 1); meta != nil {
+		if !meta.Header.LastBlockID.Equals(last.Commit.BlockID) {
+			return fmt.Errorf("block %d of the blockstore does not follow block %X of the archive: its last block is %X", height+1, last.Block.Hash(), meta.Header.LastBlockID.Hash)
+		}
+		if !bytes.Equal(meta.Header.LastCommitHash, last.Commit.Hash()) {
+			return fmt.Errorf("commit of block %d does not match the last commit hash of block %d of the blockstore", height, height+1)
+		}
+		return nil
+	}
+	if meta := blockStore.LoadBlockMeta(height); meta != nil {
+		if !bytes.Equal(meta.BlockID.Hash, last.Block.Hash()) {
+			return fmt.Errorf("block %d of the archive is %X, not block %X of the blockstore", height, last.Block.Hash(), meta.BlockID.Hash)
+		}
+		return nil
+	}
+
+	vals, err := validators.LoadValidators(height)
+	if len(trustedHash) > 0 {
+		if !bytes.Equal(last.Block.Hash(), trustedHash) {
+			return fmt.Errorf("block %d of the archive is %X, not the trusted block %X", height, last.Block.Hash(), trustedHash)
+		}
+		if err != nil {
+			logger.Info("the state does not contain the validator set of the trusted block, its commit is not verified", "height", height)
+			return nil
+		}
+	} else if err != nil {
+		return fmt.Errorf("failed to verify the last block %d of the archive: no trusted hash was given and the validator set can't be loaded: %w", height, err)
+	}
+	return last.VerifyCommit(chainID, vals)
+}
+
+// copyBlocks saves the blocks of src to dst, which must end right below the
+// base of src.
+func copyBlocks(dst, src *store.BlockStore) error {
+	for height := src.Base(); height <= src.Height(); height++ {
+		block := src.LoadBlock(height)
+		if block == nil {
+			return fmt.Errorf("block %d not found", height)
+		}
+		parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+		if err != nil {
+			return fmt.Errorf("failed to make part set of block %d: %w", height, err)
+		}
+		dst.SaveBlock(block, parts, loadCommit(src, height))
+	}
+	return nil
+}
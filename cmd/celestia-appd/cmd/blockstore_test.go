@@ -0,0 +1,283 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v10/pkg/blockarchive"
+	"github.com/celestiaorg/celestia-app/v10/pkg/da"
+	cometbftdb "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImportBlocks(t *testing.T) {
+	source := newSeededBlockStore(t, 5)
+
+	t.Run("should seed an empty blockstore", func(t *testing.T) {
+		var archive bytes.Buffer
+		require.NoError(t, exportBlocks(log.NewNopLogger(), source, &archive, 2, 4))
+
+		target, err := importArchive(store.NewBlockStore(cometbftdb.NewMemDB()), newTestValidators(), nil, archive.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, int64(2), target.Base())
+		require.Equal(t, int64(4), target.Height())
+		for height := int64(2); height <= 4; height++ {
+			require.Equal(t, source.LoadBlock(height).Hash(), target.LoadBlock(height).Hash())
+		}
+	})
+	t.Run("should skip blocks the blockstore already contains", func(t *testing.T) {
+		var archive bytes.Buffer
+		require.NoError(t, exportBlocks(log.NewNopLogger(), source, &archive, 0, 0))
+
+		blockStore := newSeededBlockStore(t, 3)
+		target, err := importArchive(blockStore, newTestValidators(), nil, archive.Bytes())
+		require.NoError(t, err)
+		require.Same(t, blockStore, target)
+		require.Equal(t, int64(1), target.Base())
+		require.Equal(t, int64(5), target.Height())
+		require.Equal(t, source.LoadBlock(5).Hash(), target.LoadBlock(5).Hash())
+	})
+	t.Run("should reject a gap between the blockstore and the archive", func(t *testing.T) {
+		var archive bytes.Buffer
+		require.NoError(t, exportBlocks(log.NewNopLogger(), source, &archive, 4, 5))
+
+		_, err := importArchive(newSeededBlockStore(t, 2), newTestValidators(), nil, archive.Bytes())
+		require.ErrorContains(t, err, "do not continue the blockstore")
+	})
+	t.Run("should seed an empty blockstore from a trusted hash", func(t *testing.T) {
+		var archive bytes.Buffer
+		require.NoError(t, exportBlocks(log.NewNopLogger(), source, &archive, 2, 4))
+
+		// The state of a new node does not contain any validator set.
+		target, err := importArchive(store.NewBlockStore(cometbftdb.NewMemDB()), testValidators{}, source.LoadBlock(4).Hash(), archive.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, int64(2), target.Base())
+		require.Equal(t, int64(4), target.Height())
+
+		_, err = importArchive(store.NewBlockStore(cometbftdb.NewMemDB()), testValidators{}, source.LoadBlock(3).Hash(), archive.Bytes())
+		require.ErrorContains(t, err, "not the trusted block")
+		_, err = importArchive(store.NewBlockStore(cometbftdb.NewMemDB()), testValidators{}, nil, archive.Bytes())
+		require.ErrorContains(t, err, "no trusted hash was given")
+	})
+	t.Run("should backfill blocks below the base of the blockstore", func(t *testing.T) {
+		var archive bytes.Buffer
+		require.NoError(t, exportBlocks(log.NewNopLogger(), source, &archive, 1, 3))
+
+		// A state-synced blockstore that starts at height 4.
+		blockStore := store.NewBlockStore(cometbftdb.NewMemDB())
+		for height := int64(4); height <= 5; height++ {
+			block := source.LoadBlock(height)
+			parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+			require.NoError(t, err)
+			blockStore.SaveBlock(block, parts, loadCommit(source, height))
+		}
+
+		// Block 4 of the blockstore authenticates the archive.
+		target, err := importArchive(blockStore, testValidators{}, nil, archive.Bytes())
+		require.NoError(t, err)
+		require.NotSame(t, blockStore, target)
+		require.Equal(t, int64(1), target.Base())
+		require.Equal(t, int64(5), target.Height())
+		for height := int64(1); height <= 5; height++ {
+			require.Equal(t, source.LoadBlock(height).Hash(), target.LoadBlock(height).Hash())
+		}
+		require.Equal(t, source.LoadSeenCommit(5).Hash(), target.LoadSeenCommit(5).Hash())
+	})
+	t.Run("should reject an archive the blockstore does not follow", func(t *testing.T) {
+		var archive bytes.Buffer
+		require.NoError(t, exportBlocks(log.NewNopLogger(), source, &archive, 1, 3))
+
+		// Block 4 of another chain.
+		other := newSeededBlockStore(t, 4)
+		block := other.LoadBlock(4)
+		block.LastBlockID = types.BlockID{Hash: bytes.Repeat([]byte{1}, 32), PartSetHeader: block.LastBlockID.PartSetHeader}
+		parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockStore := store.NewBlockStore(cometbftdb.NewMemDB())
+		blockStore.SaveBlock(block, parts, signCommit(t, block))
+
+		_, err = importArchive(blockStore, testValidators{}, nil, archive.Bytes())
+		require.ErrorContains(t, err, "does not follow block")
+		require.Equal(t, int64(4), blockStore.Base())
+	})
+	t.Run("should reject a commit that is not signed by the validator set", func(t *testing.T) {
+		var archive bytes.Buffer
+		require.NoError(t, exportBlocks(log.NewNopLogger(), source, &archive, 0, 0))
+
+		other := ed25519.GenPrivKey()
+		validators := testValidators{vals: types.NewValidatorSet([]*types.Validator{types.NewValidator(other.PubKey(), 10)})}
+		target := store.NewBlockStore(cometbftdb.NewMemDB())
+		_, err := importArchive(target, validators, nil, archive.Bytes())
+		require.ErrorContains(t, err, "does not match the validators hash")
+		require.Equal(t, int64(0), target.Height())
+	})
+	t.Run("should reject a tampered archive", func(t *testing.T) {
+		// Replace block 3 by a block that is committed by a forged commit.
+		var archive bytes.Buffer
+		w, err := blockarchive.NewWriter(&archive, blockarchive.Header{ChainID: "test-chain", FromHeight: 1, ToHeight: 3})
+		require.NoError(t, err)
+		for height := int64(1); height <= 3; height++ {
+			block, commit := source.LoadBlock(height), source.LoadBlockCommit(height)
+			if height == 3 {
+				block.Time = block.Time.Add(time.Second)
+				parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+				require.NoError(t, err)
+				forged := *commit
+				forged.BlockID = types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+				commit = &forged
+			}
+			entry, err := blockarchive.NewEntry(block, commit)
+			require.NoError(t, err)
+			require.NoError(t, w.Write(entry))
+		}
+		require.NoError(t, w.Close())
+
+		// No block is saved before the whole archive is verified.
+		target := store.NewBlockStore(cometbftdb.NewMemDB())
+		_, err = importArchive(target, newTestValidators(), nil, archive.Bytes())
+		require.ErrorContains(t, err, "invalid commit of block 3")
+		require.Equal(t, int64(0), target.Height())
+	})
+	t.Run("should reject blocks that do not follow each other", func(t *testing.T) {
+		// A validly committed block 2 that does not follow block 1.
+		block := source.LoadBlock(2)
+		block.LastBlockID = types.BlockID{Hash: bytes.Repeat([]byte{1}, 32), PartSetHeader: block.LastBlockID.PartSetHeader}
+		entry, err := blockarchive.NewEntry(block, signCommit(t, block))
+		require.NoError(t, err)
+
+		var archive bytes.Buffer
+		w, err := blockarchive.NewWriter(&archive, blockarchive.Header{ChainID: "test-chain", FromHeight: 1, ToHeight: 2})
+		require.NoError(t, err)
+		first, err := blockarchive.NewEntry(source.LoadBlock(1), source.LoadBlockCommit(1))
+		require.NoError(t, err)
+		require.NoError(t, w.Write(first))
+		require.NoError(t, w.Write(entry))
+		require.NoError(t, w.Close())
+
+		target := store.NewBlockStore(cometbftdb.NewMemDB())
+		_, err = importArchive(target, newTestValidators(), nil, archive.Bytes())
+		require.ErrorContains(t, err, "does not follow")
+		require.Equal(t, int64(0), target.Height())
+	})
+	t.Run("should reject a range outside of the blockstore", func(t *testing.T) {
+		var archive bytes.Buffer
+		require.ErrorContains(t, exportBlocks(log.NewNopLogger(), source, &archive, 1, 6), "invalid range")
+		require.ErrorContains(t, exportBlocks(log.NewNopLogger(), source, &archive, 4, 3), "invalid range")
+	})
+}
+
+// importArchive imports archive into blockStore and backfills into an
+// in-memory blockstore.
+func importArchive(blockStore *store.BlockStore, validators validatorStore, trustedHash []byte, archive []byte) (*store.BlockStore, error) {
+	openArchive := func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(archive)), nil
+	}
+	newBlockStore := func() (*store.BlockStore, error) {
+		return store.NewBlockStore(cometbftdb.NewMemDB()), nil
+	}
+	return importBlocks(log.NewNopLogger(), blockStore, validators, trustedHash, openArchive, newBlockStore)
+}
+
+// testValidators returns the same validator set for every height or an error
+// if it has none, like the state of a new node.
+type testValidators struct {
+	vals *types.ValidatorSet
+}
+
+func (v testValidators) LoadValidators(height int64) (*types.ValidatorSet, error) {
+	if v.vals == nil {
+		return nil, fmt.Errorf("validator set of height %d not found", height)
+	}
+	return v.vals, nil
+}
+
+// testKey is the key of the only validator of the blocks of
+// newSeededBlockStore.
+var testKey = ed25519.GenPrivKeyFromSecret([]byte("blockstore"))
+
+// newSeededBlockStore returns an in-memory blockstore that contains n
+// consecutive empty blocks starting at height 1, committed by the validator
+// set of newTestValidators.
+func newSeededBlockStore(t *testing.T, n int) *store.BlockStore {
+	t.Helper()
+
+	eds, err := da.ConstructEDS(nil, appconsts.Version, -1)
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	data, err := types.DataFromProto(&cmtproto.Data{Hash: dah.Hash(), SquareSize: uint64(eds.Width() / 2)})
+	require.NoError(t, err)
+
+	blockStore := store.NewBlockStore(cometbftdb.NewMemDB())
+	vals := newTestValidators().vals
+	address := testKey.PubKey().Address()
+	lastCommit := &types.Commit{}
+	for i := range n {
+		block := &types.Block{
+			Header: types.Header{
+				Version:            cmtversion.Consensus{Block: version.BlockProtocol, App: appconsts.Version},
+				ChainID:            "test-chain",
+				Height:             int64(i + 1),
+				Time:               time.Unix(int64(i), 0).UTC(),
+				LastBlockID:        lastCommit.BlockID,
+				DataHash:           dah.Hash(),
+				ValidatorsHash:     vals.Hash(),
+				NextValidatorsHash: vals.Hash(),
+				ProposerAddress:    address,
+			},
+			Data:       data,
+			LastCommit: lastCommit,
+		}
+		parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		lastCommit = signCommit(t, block)
+		blockStore.SaveBlock(block, parts, lastCommit)
+	}
+	return blockStore
+}
+
+// signCommit returns the commit for block signed by testKey.
+func signCommit(t *testing.T, block *types.Block) *types.Commit {
+	t.Helper()
+
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+	address := testKey.PubKey().Address()
+	vote := &cmtproto.Vote{
+		Type:             cmtproto.PrecommitType,
+		Height:           block.Height,
+		BlockID:          blockID.ToProto(),
+		Timestamp:        block.Time,
+		ValidatorAddress: address,
+	}
+	signature, err := testKey.Sign(types.VoteSignBytes(block.ChainID, vote))
+	require.NoError(t, err)
+	return &types.Commit{
+		Height:  block.Height,
+		BlockID: blockID,
+		Signatures: []types.CommitSig{{
+			BlockIDFlag:      types.BlockIDFlagCommit,
+			ValidatorAddress: address,
+			Timestamp:        block.Time,
+			Signature:        signature,
+		}},
+	}
+}
+
+// newTestValidators returns the validators of the blocks of
+// newSeededBlockStore.
+func newTestValidators() testValidators {
+	return testValidators{vals: types.NewValidatorSet([]*types.Validator{types.NewValidator(testKey.PubKey(), 10)})}
+}
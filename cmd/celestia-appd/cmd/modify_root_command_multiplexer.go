@@ -11,7 +11,9 @@ import (
 	"github.com/celestiaorg/celestia-app/v10/multiplexer/appd"
 	multiplexer "github.com/celestiaorg/celestia-app/v10/multiplexer/cmd"
 	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/spf13/cobra"
 )
 
@@ -21,6 +23,10 @@ import (
 // -ldflags="-X 'github.com/celestiaorg/celestia-app/v10/cmd/celestia-appd/cmd.v2UpgradeHeight=2371495'" for mainnet
 var v2UpgradeHeight = ""
 
+// FlagExternalBinaries replaces the embedded binaries of app versions by
+// external binaries. It can also be set as external-binaries in app.toml.
+const FlagExternalBinaries = "external-binaries"
+
 var defaultArgs = []string{
 	"--with-tendermint=false",
 	"--transport=grpc",
@@ -156,6 +162,7 @@ func modifyRootCommand(rootCommand *cobra.Command) {
 
 	rootCommand.AddCommand(
 		multiplexer.NewPassthroughCmd(versions),
+		multiplexer.NewMultiplexerCmd(versions, NewAppServer),
 	)
 
 	// Add the following commands to the rootCommand: start, tendermint, export, version, and rollback and wire multiplexer.
@@ -165,8 +172,29 @@ func modifyRootCommand(rootCommand *cobra.Command) {
 		NewAppServer,
 		appExporter,
 		server.StartCmdOptions{
-			AddFlags:            addStartFlags,
-			StartCommandHandler: multiplexer.New(versions),
+			AddFlags: func(startCmd *cobra.Command) {
+				addStartFlags(startCmd)
+				startCmd.Flags().StringSlice(FlagExternalBinaries, nil, "Replace the embedded binary of an app version by an external binary, specified as <app version>:<sha256>[:<path>]. Without a path, the binary is looked up by its checksum in the binary cache.")
+			},
+			StartCommandHandler: startWithExternalBinaries(versions),
 		},
 	)
 }
+
+// startWithExternalBinaries returns a start command handler that starts the
+// multiplexer with the external binaries configured by the operator in place
+// of the embedded ones.
+func startWithExternalBinaries(versions abci.Versions) multiplexer.StartCommandHandler {
+	return func(svrCtx *server.Context, clientCtx client.Context, appCreator servertypes.AppCreator, withCmt bool, opts server.StartCmdOptions) error {
+		versions, err := versions.WithExternalBinaries(svrCtx.Viper.GetStringSlice(FlagExternalBinaries))
+		if err != nil {
+			return fmt.Errorf("failed to configure external binaries: %w", err)
+		}
+		for _, version := range versions {
+			if version.SHA256 != "" {
+				svrCtx.Logger.Info("using external binary", "app_version", version.AppVersion, "sha256", version.SHA256, "path", version.BinaryPath)
+			}
+		}
+		return multiplexer.New(versions)(svrCtx, clientCtx, appCreator, withCmt, opts)
+	}
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/celestiaorg/celestia-app/v10/pkg/remotesigner"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagRemoteSignerListenAddr = "listen-addr"
+	flagRemoteSignerTLSCert    = "tls-cert"
+	flagRemoteSignerTLSKey     = "tls-key"
+	flagRemoteSignerTLSCA      = "tls-client-ca"
+	flagRemoteSignerKeys       = "keys"
+)
+
+func remoteSignerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remote-signer",
+		Short: "Serve a remote signing service backed by the local keyring",
+		Long: `Serve a remote signing service backed by the local keyring.
+
+Clients connect over gRPC with mutual TLS: the service presents the
+certificate in --tls-cert and only accepts clients presenting a certificate
+issued by the CA in --tls-client-ca. Clients such as the transaction client
+and the fibre client sign with the service's keys without holding them.
+
+Examples:
+  celestia-appd remote-signer --tls-cert server.pem --tls-key server-key.pem --tls-client-ca ca.pem
+  celestia-appd remote-signer --keys sequencer --keyring-backend file --tls-cert server.pem --tls-key server-key.pem --tls-client-ca ca.pem
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			listenAddr, _ := cmd.Flags().GetString(flagRemoteSignerListenAddr)
+			certFile, _ := cmd.Flags().GetString(flagRemoteSignerTLSCert)
+			keyFile, _ := cmd.Flags().GetString(flagRemoteSignerTLSKey)
+			caFile, _ := cmd.Flags().GetString(flagRemoteSignerTLSCA)
+			keyNames, _ := cmd.Flags().GetStringSlice(flagRemoteSignerKeys)
+
+			tlsConfig := remotesigner.TLSConfig{CertFile: certFile, KeyFile: keyFile, CAFile: caFile}
+			grpcServer, err := remotesigner.NewGRPCServer(remotesigner.NewServer(clientCtx.Keyring, keyNames...), tlsConfig)
+			if err != nil {
+				return err
+			}
+
+			lis, err := net.Listen("tcp", listenAddr)
+			if err != nil {
+				return fmt.Errorf("listening on %s: %w", listenAddr, err)
+			}
+			go func() {
+				<-cmd.Context().Done()
+				grpcServer.GracefulStop()
+			}()
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Serving remote signer on %s\n", lis.Addr())
+			return grpcServer.Serve(lis)
+		},
+	}
+
+	cmd.Flags().String(flagRemoteSignerListenAddr, "localhost:9095", "Address the signing service listens on")
+	cmd.Flags().String(flagRemoteSignerTLSCert, "", "PEM encoded TLS certificate of the signing service")
+	cmd.Flags().String(flagRemoteSignerTLSKey, "", "PEM encoded private key of the TLS certificate")
+	cmd.Flags().String(flagRemoteSignerTLSCA, "", "PEM encoded certificate of the CA that issues client certificates")
+	cmd.Flags().StringSlice(flagRemoteSignerKeys, nil, "Names of the keys clients may sign with (default: all keys)")
+	flags.AddKeyringFlags(cmd.Flags())
+	return cmd
+}
@@ -126,6 +126,9 @@ func initRootCommand(rootCommand *cobra.Command, capp *app.App) {
 		confixcmd.ConfigCommand(),
 		addrbookCommand(),
 		compactBlockstoreCommand(),
+		blockstoreCommand(),
+		stateCommand(),
+		remoteSignerCommand(),
 		downloadGenesisCommand(),
 		addrConversionCmd(),
 		server.StatusCommand(),
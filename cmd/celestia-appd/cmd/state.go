@@ -0,0 +1,436 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-app/v10/app"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+const flagStateHeight = "height"
+
+func stateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Inspect the application state of a stopped node",
+		Long: `Inspect the application state of a stopped node at any height that is still
+available in its IAVL stores.
+
+The node MUST be stopped before running these commands.`,
+	}
+	cmd.AddCommand(
+		stateListCommand(),
+		stateDumpCommand(),
+		stateDiffCommand(),
+		stateProofCommand(),
+		stateExportCommand(),
+		stateVerifyCommand(),
+	)
+	return cmd
+}
+
+func stateListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the collections that can be dumped and diffed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			w := cmd.OutOrStdout()
+			for _, c := range stateCollections {
+				fmt.Fprintf(w, "%-8s %-20s %s\n", c.module, c.name, c.description)
+			}
+			fmt.Fprintf(w, "%-8s %-20s %s\n", "<module>", genesisCollection, "the exported genesis state of any module, one entry per field")
+			return nil
+		},
+	}
+}
+
+func stateDumpCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump [module] [collection]",
+		Short: "Dump a collection of module state as JSON",
+		Long: `Dump a collection of module state at a height as JSON. Values are decoded with
+the codec of the app. Run "state list" for the available collections.
+
+Entries that are stored under a single key include it as store_key, which can
+be passed to "state proof".
+
+Examples:
+  celestia-appd state dump fibre escrow-accounts --height 2748392
+  celestia-appd state dump staking genesis
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := cmd.Flags().GetInt64(flagStateHeight)
+			if err != nil {
+				return err
+			}
+			reader, err := openStateReader(server.GetServerContextFromCmd(cmd))
+			if err != nil {
+				return err
+			}
+			defer reader.Close()
+
+			height, err = reader.resolveHeight(height)
+			if err != nil {
+				return err
+			}
+			entries, err := reader.dump(args[0], args[1], height)
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd.OutOrStdout(), stateDump{
+				Module:     args[0],
+				Collection: args[1],
+				Height:     height,
+				Entries:    entries,
+			})
+		},
+	}
+	cmd.Flags().Int64(flagStateHeight, 0, "Height of the state to dump (defaults to the latest height)")
+	return cmd
+}
+
+func stateDiffCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff [module] [collection] [from-height] [to-height]",
+		Short: "Show how a collection of module state changed between two heights",
+		Long: `Show the entries of a collection of module state that were added, removed or
+changed between two heights. Run "state list" for the available collections.
+
+Examples:
+  celestia-appd state diff signal signals 2748000 2748392
+`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from height: %w", err)
+			}
+			to, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid to height: %w", err)
+			}
+			reader, err := openStateReader(server.GetServerContextFromCmd(cmd))
+			if err != nil {
+				return err
+			}
+			defer reader.Close()
+
+			diff, err := reader.diff(args[0], args[1], from, to)
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd.OutOrStdout(), diff)
+		},
+	}
+}
+
+func stateProofCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof [store] [key]",
+		Short: "Export a merkle proof for a key of a store",
+		Long: `Export a merkle proof of the value, or of the absence, of a hex encoded key in
+a store at a height. The proof is verified against the app hash of the height
+before it is written. That app hash is included in the header of the next
+block.
+
+The store_key of the entries of "state dump" can be passed as key.
+
+Examples:
+  celestia-appd state dump signal upgrade --height 2748392
+  celestia-appd state proof signal 00 --height 2748392
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := cmd.Flags().GetInt64(flagStateHeight)
+			if err != nil {
+				return err
+			}
+			key, err := hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+			if err != nil {
+				return fmt.Errorf("invalid key: %w", err)
+			}
+			reader, err := openStateReader(server.GetServerContextFromCmd(cmd))
+			if err != nil {
+				return err
+			}
+			defer reader.Close()
+
+			height, err = reader.resolveHeight(height)
+			if err != nil {
+				return err
+			}
+			proof, err := reader.proof(args[0], key, height)
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd.OutOrStdout(), proof)
+		},
+	}
+	cmd.Flags().Int64(flagStateHeight, 0, "Height of the state to prove (defaults to the latest height)")
+	return cmd
+}
+
+// stateDump is the output of the state dump command.
+type stateDump struct {
+	Module     string       `json:"module"`
+	Collection string       `json:"collection"`
+	Height     int64        `json:"height"`
+	Entries    []stateEntry `json:"entries"`
+}
+
+// stateDiff is the output of the state diff command.
+type stateDiff struct {
+	Module     string        `json:"module"`
+	Collection string        `json:"collection"`
+	From       int64         `json:"from"`
+	To         int64         `json:"to"`
+	Added      []stateEntry  `json:"added"`
+	Removed    []stateEntry  `json:"removed"`
+	Changed    []stateChange `json:"changed"`
+}
+
+// stateChange is an entry whose value differs between two heights.
+type stateChange struct {
+	Key  string          `json:"key"`
+	From json.RawMessage `json:"from"`
+	To   json.RawMessage `json:"to"`
+}
+
+// stateProof is the output of the state proof command.
+type stateProof struct {
+	Store  string `json:"store"`
+	Key    string `json:"key"`
+	Height int64  `json:"height"`
+	// Value is the hex encoded value of the key. It is empty if the proof
+	// proves the absence of the key.
+	Value string `json:"value,omitempty"`
+	// AppHash is the root the proof is verified against.
+	AppHash  string                   `json:"app_hash"`
+	ProofOps *cmtprotocrypto.ProofOps `json:"proof_ops"`
+}
+
+// stateReader reads the committed state of an app at past heights.
+type stateReader struct {
+	app     *app.App
+	cms     storetypes.CommitMultiStore
+	logger  log.Logger
+	closeDB func()
+}
+
+// openStateReader opens the application database of the node in sctx.
+func openStateReader(sctx *server.Context) (*stateReader, error) {
+	dataDir := filepath.Join(sctx.Config.RootDir, "data")
+	db, err := dbm.NewDB("application", server.GetAppDBBackend(sctx.Viper), dataDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	capp, ok := NewAppServer(sctx.Logger, db, nil, sctx.Viper).(*app.App)
+	if !ok {
+		db.Close()
+		return nil, fmt.Errorf("failed to cast application to *app.App")
+	}
+	reader := newStateReader(capp, sctx.Logger)
+	reader.closeDB = func() {
+		if err := db.Close(); err != nil {
+			sctx.Logger.Warn("error closing database", "db", "application", "err", err)
+		}
+	}
+	return reader, nil
+}
+
+// newStateReader returns a reader of the state committed by capp.
+func newStateReader(capp *app.App, logger log.Logger) *stateReader {
+	return &stateReader{app: capp, cms: capp.CommitMultiStore(), logger: logger, closeDB: func() {}}
+}
+
+// Close closes the underlying database.
+func (r *stateReader) Close() {
+	r.closeDB()
+}
+
+// resolveHeight returns the latest height for a height of 0 and checks that
+// any other height has been committed.
+func (r *stateReader) resolveHeight(height int64) (int64, error) {
+	latest := r.cms.LatestVersion()
+	switch {
+	case height == 0:
+		return latest, nil
+	case height < 0 || height > latest:
+		return 0, fmt.Errorf("height %d is not between 1 and the latest height %d", height, latest)
+	default:
+		return height, nil
+	}
+}
+
+// context returns a read-only context over the state at height.
+func (r *stateReader) context(height int64) (sdk.Context, error) {
+	height, err := r.resolveHeight(height)
+	if err != nil {
+		return sdk.Context{}, err
+	}
+	ms, err := r.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return sdk.Context{}, fmt.Errorf("failed to load state at height %d: %w", height, err)
+	}
+	header := cmtproto.Header{Height: height}
+	ctx := sdk.NewContext(ms, header, false, r.logger)
+	appVersion, err := r.app.AppVersion(ctx)
+	if err != nil {
+		return sdk.Context{}, fmt.Errorf("failed to get app version at height %d: %w", height, err)
+	}
+	header.Version.App = appVersion
+	return ctx.WithBlockHeader(header), nil
+}
+
+// dump returns the entries of a collection of module at height sorted by key.
+func (r *stateReader) dump(module, collection string, height int64) ([]stateEntry, error) {
+	entriesFn, err := r.lookupCollection(module, collection)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err := r.context(height)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := entriesFn(ctx, r.app)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s %s at height %d: %w", module, collection, height, err)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+	return entries, nil
+}
+
+// diff compares a collection of module between two heights.
+func (r *stateReader) diff(module, collection string, from, to int64) (stateDiff, error) {
+	fromEntries, err := r.dump(module, collection, from)
+	if err != nil {
+		return stateDiff{}, err
+	}
+	toEntries, err := r.dump(module, collection, to)
+	if err != nil {
+		return stateDiff{}, err
+	}
+	diff := diffEntries(fromEntries, toEntries)
+	diff.Module, diff.Collection, diff.From, diff.To = module, collection, from, to
+	return diff, nil
+}
+
+// diffEntries returns the entries that were added, removed or changed from
+// from to to. Both must be sorted by key.
+func diffEntries(from, to []stateEntry) stateDiff {
+	diff := stateDiff{Added: []stateEntry{}, Removed: []stateEntry{}, Changed: []stateChange{}}
+	i, j := 0, 0
+	for i < len(from) || j < len(to) {
+		switch {
+		case j == len(to) || (i < len(from) && from[i].Key < to[j].Key):
+			diff.Removed = append(diff.Removed, from[i])
+			i++
+		case i == len(from) || to[j].Key < from[i].Key:
+			diff.Added = append(diff.Added, to[j])
+			j++
+		default:
+			if !bytes.Equal(from[i].Value, to[j].Value) {
+				diff.Changed = append(diff.Changed, stateChange{Key: to[j].Key, From: from[i].Value, To: to[j].Value})
+			}
+			i++
+			j++
+		}
+	}
+	return diff
+}
+
+// proof returns a verified merkle proof for key in the store storeName at
+// height.
+func (r *stateReader) proof(storeName string, key []byte, height int64) (stateProof, error) {
+	res, err := r.query(storeName, key, height)
+	if err != nil {
+		return stateProof{}, err
+	}
+	commitInfo, err := r.commitInfo(height)
+	if err != nil {
+		return stateProof{}, err
+	}
+	appHash := commitInfo.Hash()
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(storeName), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+	runtime := rootmulti.DefaultProofRuntime()
+	if res.Value != nil {
+		err = runtime.VerifyValue(res.ProofOps, appHash, keyPath, res.Value)
+	} else {
+		err = runtime.VerifyAbsence(res.ProofOps, appHash, keyPath)
+	}
+	if err != nil {
+		return stateProof{}, fmt.Errorf("failed to verify proof: %w", err)
+	}
+
+	return stateProof{
+		Store:    storeName,
+		Key:      hex.EncodeToString(key),
+		Height:   height,
+		Value:    hex.EncodeToString(res.Value),
+		AppHash:  hex.EncodeToString(appHash),
+		ProofOps: res.ProofOps,
+	}, nil
+}
+
+// query returns the value of key in the store storeName at height with a
+// proof against the app hash of height.
+func (r *stateReader) query(storeName string, key []byte, height int64) (*storetypes.ResponseQuery, error) {
+	queryable, ok := r.cms.(storetypes.Queryable)
+	if !ok {
+		return nil, fmt.Errorf("commit multi-store does not support queries")
+	}
+	res, err := queryable.Query(&storetypes.RequestQuery{
+		Path:   fmt.Sprintf("/%s/key", storeName),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query key in store %s at height %d: %w", storeName, height, err)
+	}
+	return res, nil
+}
+
+// commitInfo returns the commit IDs of all stores at height.
+func (r *stateReader) commitInfo(height int64) (*storetypes.CommitInfo, error) {
+	rootStore, ok := r.cms.(interface {
+		GetCommitInfo(int64) (*storetypes.CommitInfo, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("commit multi-store does not expose commit info")
+	}
+	commitInfo, err := rootStore.GetCommitInfo(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit info at height %d: %w", height, err)
+	}
+	return commitInfo, nil
+}
+
+func writeJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
@@ -0,0 +1,284 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/v10/app"
+	fibretypes "github.com/celestiaorg/celestia-app/v10/x/fibre/types"
+	"github.com/celestiaorg/celestia-app/v10/x/signal"
+	signaltypes "github.com/celestiaorg/celestia-app/v10/x/signal/types"
+	valaddrtypes "github.com/celestiaorg/celestia-app/v10/x/valaddr/types"
+	zkismtypes "github.com/celestiaorg/celestia-app/v10/x/zkism/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// genesisCollection is the collection that every module with a genesis
+// state provides.
+const genesisCollection = "genesis"
+
+// stateEntry is an entry of a collection of module state.
+type stateEntry struct {
+	Key string `json:"key"`
+	// StoreKey is the hex encoded key the entry is stored under. It is empty
+	// for entries that are derived from several keys.
+	StoreKey string          `json:"store_key,omitempty"`
+	Value    json.RawMessage `json:"value"`
+}
+
+// stateEntriesFn reads the entries of a collection from the state in ctx.
+type stateEntriesFn func(ctx sdk.Context, capp *app.App) ([]stateEntry, error)
+
+// stateCollection is a collection of module state that the state commands
+// can dump and diff.
+type stateCollection struct {
+	module      string
+	name        string
+	description string
+	entries     stateEntriesFn
+}
+
+var stateCollections = []stateCollection{
+	{fibretypes.ModuleName, "params", "the parameters of the module", fibreParams},
+	{fibretypes.ModuleName, "escrow-accounts", "escrow accounts keyed by signer", fibreEscrowAccounts},
+	{fibretypes.ModuleName, "withdrawals", "pending withdrawals keyed by signer and request time", fibreWithdrawals},
+	{fibretypes.ModuleName, "processed-payments", "processed payment promises keyed by hash", fibreProcessedPayments},
+	{valaddrtypes.ModuleName, "hosts", "fibre provider hosts keyed by consensus address", valaddrHosts},
+	{signaltypes.ModuleName, "signals", "signalled versions keyed by validator", signalSignals},
+	{signaltypes.ModuleName, "tallies", "voting power per signalled version", signalTallies},
+	{signaltypes.ModuleName, "upgrade", "the pending upgrade", signalUpgrade},
+	{zkismtypes.ModuleName, "isms", "interchain security modules keyed by ID", zkismIsms},
+	{zkismtypes.ModuleName, "messages", "authorized message IDs keyed by ISM ID", zkismMessages},
+}
+
+// genesisExporter is implemented by modules that export their genesis state.
+type genesisExporter interface {
+	ExportGenesis(sdk.Context, codec.JSONCodec) json.RawMessage
+}
+
+// lookupCollection returns the function that reads collection of module.
+func (r *stateReader) lookupCollection(module, collection string) (stateEntriesFn, error) {
+	for _, c := range stateCollections {
+		if c.module == module && c.name == collection {
+			return c.entries, nil
+		}
+	}
+	if collection != genesisCollection {
+		return nil, fmt.Errorf("unknown collection %s of module %s", collection, module)
+	}
+	m, ok := r.app.ModuleManager.Modules[module]
+	if !ok {
+		return nil, fmt.Errorf("unknown module %s", module)
+	}
+	exporter, ok := m.(genesisExporter)
+	if !ok {
+		return nil, fmt.Errorf("module %s does not export a genesis state", module)
+	}
+	return func(ctx sdk.Context, capp *app.App) ([]stateEntry, error) {
+		return genesisEntries(exporter.ExportGenesis(ctx, capp.AppCodec()))
+	}, nil
+}
+
+// genesisEntries splits a genesis state into one entry per field.
+func genesisEntries(genesis json.RawMessage) ([]stateEntry, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(genesis, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode genesis state: %w", err)
+	}
+	entries := make([]stateEntry, 0, len(fields))
+	for field, value := range fields {
+		entries = append(entries, stateEntry{Key: field, Value: value})
+	}
+	return entries, nil
+}
+
+// protoEntry returns an entry with the JSON encoding of msg.
+func protoEntry(capp *app.App, key string, storeKey []byte, msg proto.Message) (stateEntry, error) {
+	value, err := capp.AppCodec().MarshalJSON(msg)
+	if err != nil {
+		return stateEntry{}, err
+	}
+	return stateEntry{Key: key, StoreKey: hex.EncodeToString(storeKey), Value: value}, nil
+}
+
+func fibreParams(ctx sdk.Context, capp *app.App) ([]stateEntry, error) {
+	params := capp.FibreKeeper.GetParams(ctx)
+	entry, err := protoEntry(capp, "params", []byte(fibretypes.ParamsKey), &params)
+	if err != nil {
+		return nil, err
+	}
+	return []stateEntry{entry}, nil
+}
+
+func fibreEscrowAccounts(ctx sdk.Context, capp *app.App) (entries []stateEntry, err error) {
+	capp.FibreKeeper.IterateEscrowAccounts(ctx, func(account fibretypes.EscrowAccount) bool {
+		var entry stateEntry
+		entry, err = protoEntry(capp, account.Signer, fibretypes.EscrowAccountKey(account.Signer), &account)
+		entries = append(entries, entry)
+		return err != nil
+	})
+	return entries, err
+}
+
+func fibreWithdrawals(ctx sdk.Context, capp *app.App) (entries []stateEntry, err error) {
+	capp.FibreKeeper.IterateWithdrawals(ctx, func(withdrawal fibretypes.Withdrawal) bool {
+		key := fmt.Sprintf("%s/%s", withdrawal.Signer, withdrawal.RequestedTimestamp.UTC().Format(sdk.SortableTimeFormat))
+		storeKey := fibretypes.WithdrawalsBySignerKey(withdrawal.Signer, withdrawal.RequestedTimestamp)
+		var entry stateEntry
+		entry, err = protoEntry(capp, key, storeKey, &withdrawal)
+		entries = append(entries, entry)
+		return err != nil
+	})
+	return entries, err
+}
+
+func fibreProcessedPayments(ctx sdk.Context, capp *app.App) (entries []stateEntry, err error) {
+	capp.FibreKeeper.IterateProcessedPayments(ctx, func(payment fibretypes.ProcessedPayment) bool {
+		storeKey := fibretypes.ProcessedPaymentsByHashKey(payment.PaymentPromiseHash)
+		var entry stateEntry
+		entry, err = protoEntry(capp, hex.EncodeToString(payment.PaymentPromiseHash), storeKey, &payment)
+		entries = append(entries, entry)
+		return err != nil
+	})
+	return entries, err
+}
+
+func valaddrHosts(ctx sdk.Context, capp *app.App) (entries []stateEntry, err error) {
+	iterErr := capp.ValAddrKeeper.IterateFibreProviderInfo(ctx, func(consAddr sdk.ConsAddress, info valaddrtypes.FibreProviderInfo) bool {
+		var entry stateEntry
+		entry, err = protoEntry(capp, consAddr.String(), valaddrtypes.GetFibreProviderInfoKey(consAddr), &info)
+		entries = append(entries, entry)
+		return err != nil
+	})
+	if iterErr != nil {
+		return nil, iterErr
+	}
+	return entries, err
+}
+
+// validatorSignal is the decoded signal of a validator.
+type validatorSignal struct {
+	Version   uint64 `json:"version"`
+	MinHeight int64  `json:"min_height,omitempty"`
+	MaxHeight int64  `json:"max_height,omitempty"`
+}
+
+// validatorSignals returns the decoded signals of all validators keyed by their
+// operator address in the signal store.
+func validatorSignals(ctx sdk.Context, capp *app.App) map[string]validatorSignal {
+	store := ctx.KVStore(capp.GetKey(signaltypes.StoreKey))
+	iterator := store.Iterator(signaltypes.FirstSignalKey, nil)
+	defer iterator.Close()
+
+	signals := make(map[string]validatorSignal)
+	for ; iterator.Valid(); iterator.Next() {
+		if bytes.Equal(iterator.Key(), signaltypes.UpgradeKey) {
+			continue
+		}
+		window := signal.UpgradeWindowFromBytes(iterator.Value())
+		signals[string(iterator.Key())] = validatorSignal{
+			Version:   signal.VersionFromBytes(iterator.Value()),
+			MinHeight: window.MinHeight,
+			MaxHeight: window.MaxHeight,
+		}
+	}
+	return signals
+}
+
+func signalSignals(ctx sdk.Context, capp *app.App) ([]stateEntry, error) {
+	var entries []stateEntry
+	for key, s := range validatorSignals(ctx, capp) {
+		value, err := json.Marshal(s)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, stateEntry{
+			Key:      sdk.ValAddress(key).String(),
+			StoreKey: hex.EncodeToString([]byte(key)),
+			Value:    value,
+		})
+	}
+	return entries, nil
+}
+
+func signalTallies(ctx sdk.Context, capp *app.App) ([]stateEntry, error) {
+	versions := make(map[uint64]bool)
+	for _, s := range validatorSignals(ctx, capp) {
+		versions[s.Version] = true
+	}
+	sorted := make([]uint64, 0, len(versions))
+	for version := range versions {
+		sorted = append(sorted, version)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	entries := make([]stateEntry, 0, len(sorted))
+	for _, version := range sorted {
+		tally, err := capp.SignalKeeper.VersionTally(ctx, &signaltypes.QueryVersionTallyRequest{Version: version})
+		if err != nil {
+			return nil, err
+		}
+		value, err := capp.AppCodec().MarshalJSON(tally)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, stateEntry{Key: strconv.FormatUint(version, 10), Value: value})
+	}
+	return entries, nil
+}
+
+func signalUpgrade(ctx sdk.Context, capp *app.App) ([]stateEntry, error) {
+	res, err := capp.SignalKeeper.GetUpgrade(ctx, &signaltypes.QueryGetUpgradeRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if res.Upgrade == nil {
+		return nil, nil
+	}
+	entry, err := protoEntry(capp, "upgrade", signaltypes.UpgradeKey, res.Upgrade)
+	if err != nil {
+		return nil, err
+	}
+	return []stateEntry{entry}, nil
+}
+
+func zkismIsms(ctx sdk.Context, capp *app.App) ([]stateEntry, error) {
+	genesis, err := capp.IsmKeeper.ExportGenesis(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]stateEntry, 0, len(genesis.Isms))
+	for i := range genesis.Isms {
+		ism := &genesis.Isms[i]
+		storeKey := binary.BigEndian.AppendUint64(zkismtypes.IsmsKeyPrefix.Bytes(), ism.Id.GetInternalId())
+		entry, err := protoEntry(capp, ism.Id.String(), storeKey, ism)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func zkismMessages(ctx sdk.Context, capp *app.App) ([]stateEntry, error) {
+	genesis, err := capp.IsmKeeper.ExportGenesis(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]stateEntry, 0, len(genesis.Messages))
+	for _, messages := range genesis.Messages {
+		value, err := json.Marshal(messages.Messages)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, stateEntry{Key: messages.Id.String(), Value: value})
+	}
+	return entries, nil
+}
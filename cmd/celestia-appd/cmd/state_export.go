@@ -0,0 +1,290 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v10/app"
+	"github.com/celestiaorg/celestia-app/v10/pkg/stateexport"
+	fibretypes "github.com/celestiaorg/celestia-app/v10/x/fibre/types"
+	valaddrtypes "github.com/celestiaorg/celestia-app/v10/x/valaddr/types"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagStateModules = "modules"
+	flagStateOut     = "out"
+	flagStateAppHash = "app-hash"
+)
+
+// defaultExportModules are the modules state export exports by default.
+var defaultExportModules = []string{
+	banktypes.ModuleName,
+	stakingtypes.ModuleName,
+	fibretypes.ModuleName,
+	valaddrtypes.ModuleName,
+}
+
+func stateExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the state of selected modules with proofs against the app hash",
+		Long: `Export the state of selected modules at a height so that external services can
+bootstrap from it without trusting the exporter.
+
+The export contains the commit IDs of all stores, which hash to the app hash
+in the header of the block after the height, and all entries of the store of
+every exported module, each with an IAVL proof against the root of the store,
+together with IAVL proofs of absence that show that no entry is missing. The
+genesis state of every module is included for convenience and must match the
+genesis state derived from the entries. The export is deterministic and is
+verified before it is written. Use "state verify" to verify an export against
+a trusted app hash.
+
+Examples:
+  celestia-appd state export --height 2748392 --out state-2748392.json
+  celestia-appd state export --modules bank,fibre --out state.json
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			height, err := cmd.Flags().GetInt64(flagStateHeight)
+			if err != nil {
+				return err
+			}
+			modules, err := cmd.Flags().GetStringSlice(flagStateModules)
+			if err != nil {
+				return err
+			}
+			out, err := cmd.Flags().GetString(flagStateOut)
+			if err != nil {
+				return err
+			}
+			reader, err := openStateReader(server.GetServerContextFromCmd(cmd))
+			if err != nil {
+				return err
+			}
+			defer reader.Close()
+
+			height, err = reader.resolveHeight(height)
+			if err != nil {
+				return err
+			}
+			export, err := reader.export(modules, height)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Create(out)
+			if err != nil {
+				return fmt.Errorf("failed to create export: %w", err)
+			}
+			if err := writeJSON(file, export); err != nil {
+				file.Close()
+				return fmt.Errorf("failed to write export: %w", err)
+			}
+			return file.Close()
+		},
+	}
+	cmd.Flags().Int64(flagStateHeight, 0, "Height of the state to export (defaults to the latest height)")
+	cmd.Flags().StringSlice(flagStateModules, defaultExportModules, "Modules to export")
+	cmd.Flags().String(flagStateOut, "", "Path of the export to write")
+	_ = cmd.MarkFlagRequired(flagStateOut)
+	return cmd
+}
+
+func stateVerifyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [export]",
+		Short: "Verify an export of module state against an app hash",
+		Long: `Verify an export written by "state export" against the app hash of its height.
+The app hash must be taken from the header of the block after the height of
+the export, as verified by a light client. The genesis state of every module
+is checked against the genesis state derived from its verified entries. It
+does not require a node.
+
+Examples:
+  celestia-appd state verify state-2748392.json --app-hash 5F3A...
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appHashHex, err := cmd.Flags().GetString(flagStateAppHash)
+			if err != nil {
+				return err
+			}
+			appHash, err := hex.DecodeString(strings.TrimPrefix(appHashHex, "0x"))
+			if err != nil {
+				return fmt.Errorf("invalid app hash: %w", err)
+			}
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read export: %w", err)
+			}
+			var export stateexport.Export
+			if err := json.Unmarshal(bz, &export); err != nil {
+				return fmt.Errorf("failed to decode export: %w", err)
+			}
+			if err := export.Verify(appHash); err != nil {
+				return err
+			}
+			if err := export.VerifyGenesis(genesisFromEntries); err != nil {
+				return err
+			}
+			for _, module := range export.Modules {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s: %d entries verified\n", module.Name, len(module.Entries))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(flagStateAppHash, "", "Hex encoded app hash of the header after the height of the export")
+	_ = cmd.MarkFlagRequired(flagStateAppHash)
+	return cmd
+}
+
+// export returns a verified export of modules at height.
+func (r *stateReader) export(modules []string, height int64) (*stateexport.Export, error) {
+	modules = slices.Compact(slices.Sorted(slices.Values(modules)))
+	if len(modules) == 0 {
+		return nil, fmt.Errorf("no modules to export")
+	}
+
+	ctx, err := r.context(height)
+	if err != nil {
+		return nil, err
+	}
+	genesis, err := r.app.ExportModuleStates(ctx, modules)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export module states: %w", err)
+	}
+	commitInfo, err := r.commitInfo(height)
+	if err != nil {
+		return nil, err
+	}
+	export := &stateexport.Export{
+		FormatVersion: stateexport.FormatVersion,
+		ChainID:       r.app.ChainID(),
+		Height:        height,
+		AppVersion:    ctx.BlockHeader().Version.App,
+		AppHash:       commitInfo.Hash(),
+		Stores:        stateexport.StoresFromCommitInfo(commitInfo),
+	}
+	roots := make(map[string][]byte, len(export.Stores))
+	for _, store := range export.Stores {
+		roots[store.Name] = store.Hash
+	}
+
+	for _, name := range modules {
+		key := r.app.GetKey(name)
+		if key == nil {
+			return nil, fmt.Errorf("module %s has no store", name)
+		}
+		moduleGenesis, err := stateexport.CanonicalJSON(genesis[name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode genesis state of module %s: %w", name, err)
+		}
+		module := stateexport.Module{Name: name, Store: key.Name(), Genesis: moduleGenesis, Entries: []stateexport.Entry{}}
+
+		var keys [][]byte
+		iterator := ctx.KVStore(key).Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			entry, err := r.exportEntry(key.Name(), roots[key.Name()], bytes.Clone(iterator.Key()), height)
+			if err != nil {
+				iterator.Close()
+				return nil, err
+			}
+			module.Entries = append(module.Entries, entry)
+			keys = append(keys, entry.Key)
+		}
+		if err := iterator.Close(); err != nil {
+			return nil, err
+		}
+		module.Gaps = []cmtprotocrypto.ProofOp{}
+		for _, gapKey := range stateexport.GapKeys(keys) {
+			gap, err := r.exportGap(key.Name(), gapKey, height)
+			if err != nil {
+				return nil, err
+			}
+			module.Gaps = append(module.Gaps, gap)
+		}
+		r.logger.Info("exported module", "module", name, "entries", len(module.Entries))
+		export.Modules = append(export.Modules, module)
+	}
+
+	if err := export.Verify(export.AppHash); err != nil {
+		return nil, fmt.Errorf("failed to verify export: %w", err)
+	}
+	if err := export.VerifyGenesis(genesisFromEntries); err != nil {
+		return nil, fmt.Errorf("failed to verify export: %w", err)
+	}
+	return export, nil
+}
+
+// exportEntry returns the entry of key in the store storeName at height with
+// the proof of its membership in the store.
+func (r *stateReader) exportEntry(storeName string, root, key []byte, height int64) (stateexport.Entry, error) {
+	res, err := r.query(storeName, key, height)
+	if err != nil {
+		return stateexport.Entry{}, err
+	}
+	// the first proof op proves the entry against the root of the store, the
+	// second one the root of the store against the app hash.
+	if res.Value == nil || res.ProofOps == nil || len(res.ProofOps.Ops) == 0 {
+		return stateexport.Entry{}, fmt.Errorf("no proof for key %X in store %s at height %d", key, storeName, height)
+	}
+	entry := stateexport.Entry{Key: key, Value: res.Value, Proof: res.ProofOps.Ops[0]}
+	if err := stateexport.VerifyEntry(root, entry); err != nil {
+		return stateexport.Entry{}, fmt.Errorf("invalid proof for key %X in store %s: %w", key, storeName, err)
+	}
+	return entry, nil
+}
+
+// exportGap returns the proof of absence of key from the store storeName at
+// height.
+func (r *stateReader) exportGap(storeName string, key []byte, height int64) (cmtprotocrypto.ProofOp, error) {
+	res, err := r.query(storeName, key, height)
+	if err != nil {
+		return cmtprotocrypto.ProofOp{}, err
+	}
+	if res.Value != nil || res.ProofOps == nil || len(res.ProofOps.Ops) == 0 {
+		return cmtprotocrypto.ProofOp{}, fmt.Errorf("no proof of absence for key %X in store %s at height %d", key, storeName, height)
+	}
+	return res.ProofOps.Ops[0], nil
+}
+
+// genesisFromEntries returns the genesis states of the modules of export as
+// exported by an app whose stores only contain the entries of the export.
+func genesisFromEntries(export *stateexport.Export) (map[string]json.RawMessage, error) {
+	opts := simtestutil.NewAppOptionsWithFlagHome(app.NodeHome)
+	capp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, 0, 0, opts)
+	ctx := capp.NewUncachedContext(false, cmtproto.Header{
+		ChainID: export.ChainID,
+		Height:  export.Height,
+		Version: cmtversion.Consensus{App: export.AppVersion},
+	})
+	modules := make([]string, 0, len(export.Modules))
+	for _, module := range export.Modules {
+		key := capp.GetKey(module.Name)
+		if key == nil || key.Name() != module.Store {
+			return nil, fmt.Errorf("module %s has no store %s", module.Name, module.Store)
+		}
+		store := ctx.KVStore(key)
+		for _, entry := range module.Entries {
+			store.Set(entry.Key, entry.Value)
+		}
+		modules = append(modules, module.Name)
+	}
+	return capp.ExportModuleStates(ctx, modules)
+}
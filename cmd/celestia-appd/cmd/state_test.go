@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v10/app"
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v10/pkg/stateexport"
+	"github.com/celestiaorg/celestia-app/v10/test/util"
+	fibretypes "github.com/celestiaorg/celestia-app/v10/x/fibre/types"
+	valaddrtypes "github.com/celestiaorg/celestia-app/v10/x/valaddr/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffEntries(t *testing.T) {
+	entry := func(key, value string) stateEntry {
+		return stateEntry{Key: key, Value: json.RawMessage(value)}
+	}
+	from := []stateEntry{entry("a", "1"), entry("b", "2"), entry("c", "3")}
+	to := []stateEntry{entry("b", "2"), entry("c", "4"), entry("d", "5")}
+
+	diff := diffEntries(from, to)
+	require.Equal(t, []stateEntry{entry("d", "5")}, diff.Added)
+	require.Equal(t, []stateEntry{entry("a", "1")}, diff.Removed)
+	require.Equal(t, []stateChange{{Key: "c", From: json.RawMessage("3"), To: json.RawMessage("4")}}, diff.Changed)
+
+	diff = diffEntries(from, from)
+	require.Empty(t, diff.Added)
+	require.Empty(t, diff.Removed)
+	require.Empty(t, diff.Changed)
+}
+
+func TestStateReader(t *testing.T) {
+	testApp, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	signer := sdk.AccAddress("signer").String()
+	consAddr := sdk.ConsAddress("validator")
+
+	// add an escrow account and a host at height 2.
+	height := testApp.LastBlockHeight() + 1
+	_, err := testApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: height,
+		Time:   util.GenesisTime.Add(time.Duration(height) * time.Second),
+		Hash:   testApp.LastCommitID().Hash,
+	})
+	require.NoError(t, err)
+	ctx := testApp.NewUncachedContext(false, cmtproto.Header{Height: height})
+	balance := sdk.NewInt64Coin(appconsts.BondDenom, 100)
+	testApp.FibreKeeper.SetEscrowAccount(ctx, fibretypes.EscrowAccount{Signer: signer, Balance: balance, AvailableBalance: balance})
+	require.NoError(t, testApp.ValAddrKeeper.SetFibreProviderInfo(ctx, consAddr, valaddrtypes.FibreProviderInfo{Host: "localhost:7980"}))
+	_, err = testApp.Commit()
+	require.NoError(t, err)
+
+	reader := newStateReader(testApp, log.NewNopLogger())
+
+	t.Run("should dump a collection at a height", func(t *testing.T) {
+		entries, err := reader.dump(fibretypes.ModuleName, "escrow-accounts", 1)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+
+		entries, err = reader.dump(fibretypes.ModuleName, "escrow-accounts", 2)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, signer, entries[0].Key)
+		require.Equal(t, hex.EncodeToString(fibretypes.EscrowAccountKey(signer)), entries[0].StoreKey)
+		require.Contains(t, string(entries[0].Value), `"available_balance"`)
+
+		entries, err = reader.dump(valaddrtypes.ModuleName, "hosts", 0)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, consAddr.String(), entries[0].Key)
+		require.JSONEq(t, `{"host":"localhost:7980"}`, string(entries[0].Value))
+	})
+	t.Run("should dump the genesis state of any module", func(t *testing.T) {
+		entries, err := reader.dump("staking", genesisCollection, 2)
+		require.NoError(t, err)
+		require.NotEmpty(t, entries)
+	})
+	t.Run("should diff a collection between two heights", func(t *testing.T) {
+		diff, err := reader.diff(valaddrtypes.ModuleName, "hosts", 1, 2)
+		require.NoError(t, err)
+		require.Len(t, diff.Added, 1)
+		require.Empty(t, diff.Removed)
+		require.Empty(t, diff.Changed)
+	})
+	t.Run("should prove the value and the absence of a key", func(t *testing.T) {
+		key := fibretypes.EscrowAccountKey(signer)
+		proof, err := reader.proof(fibretypes.StoreKey, key, 2)
+		require.NoError(t, err)
+		require.NotEmpty(t, proof.Value)
+		require.NotEmpty(t, proof.ProofOps.Ops)
+
+		proof, err = reader.proof(fibretypes.StoreKey, key, 1)
+		require.NoError(t, err)
+		require.Empty(t, proof.Value)
+	})
+	t.Run("should export verifiable module state", func(t *testing.T) {
+		export, err := reader.export([]string{fibretypes.ModuleName, valaddrtypes.ModuleName, "bank", fibretypes.ModuleName}, 2)
+		require.NoError(t, err)
+		require.Equal(t, testApp.LastCommitID().Hash, []byte(export.AppHash))
+		require.NoError(t, export.Verify(testApp.LastCommitID().Hash))
+		require.Len(t, export.Modules, 3)
+		require.Equal(t, "bank", export.Modules[0].Name)
+		require.True(t, slices.ContainsFunc(export.Modules[1].Entries, func(entry stateexport.Entry) bool {
+			return bytes.Equal(entry.Key, fibretypes.EscrowAccountKey(signer))
+		}))
+		require.Len(t, export.Modules[2].Entries, 1)
+
+		// exports of the same state are identical.
+		again, err := reader.export([]string{"bank", fibretypes.ModuleName, valaddrtypes.ModuleName}, 2)
+		require.NoError(t, err)
+		bz, err := json.Marshal(export)
+		require.NoError(t, err)
+		bzAgain, err := json.Marshal(again)
+		require.NoError(t, err)
+		require.Equal(t, bz, bzAgain)
+
+		require.NotEmpty(t, export.Modules[0].Gaps)
+		require.NoError(t, export.VerifyGenesis(genesisFromEntries))
+
+		// an export that omits an entry is incomplete.
+		var incomplete stateexport.Export
+		require.NoError(t, json.Unmarshal(bz, &incomplete))
+		incomplete.Modules[0].Entries = incomplete.Modules[0].Entries[1:]
+		require.ErrorContains(t, incomplete.Verify(export.AppHash), "entries of module bank are incomplete")
+
+		// the genesis state must match the entries.
+		var forged stateexport.Export
+		require.NoError(t, json.Unmarshal(bz, &forged))
+		forged.Modules[0].Genesis = json.RawMessage(`{}`)
+		require.NoError(t, forged.Verify(export.AppHash))
+		require.ErrorContains(t, forged.VerifyGenesis(genesisFromEntries), "genesis state of module bank does not match its entries")
+
+		export.Modules[2].Entries[0].Value = []byte("tampered")
+		require.ErrorContains(t, export.Verify(export.AppHash), "invalid entry")
+	})
+	t.Run("should reject unknown collections and heights", func(t *testing.T) {
+		_, err := reader.dump(fibretypes.ModuleName, "unknown", 2)
+		require.ErrorContains(t, err, "unknown collection")
+		_, err = reader.dump("unknown", genesisCollection, 2)
+		require.ErrorContains(t, err, "unknown module")
+		_, err = reader.dump(fibretypes.ModuleName, "escrow-accounts", 3)
+		require.ErrorContains(t, err, "latest height 2")
+	})
+}
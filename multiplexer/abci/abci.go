@@ -49,6 +49,7 @@ func (m *Multiplexer) Commit(context.Context, *abci.RequestCommit) (*abci.Respon
 	if err != nil {
 		return nil, fmt.Errorf("failed to commit: %w", err)
 	}
+	m.setCommittedHeight(m.finalizedHeight)
 
 	// after a successful commit, start using the app version specified in FinalizeBlock. If
 	// there is an upgrade, perform that now.
@@ -95,6 +96,7 @@ func (m *Multiplexer) FinalizeBlock(_ context.Context, req *abci.RequestFinalize
 	if err != nil {
 		return nil, fmt.Errorf("failed to finalize block: %w", err)
 	}
+	m.finalizedHeight = req.Height
 
 	// set the app version to be used in the next block.
 	if resp.ConsensusParamUpdates != nil && resp.ConsensusParamUpdates.GetVersion() != nil {
@@ -110,7 +112,13 @@ func (m *Multiplexer) Info(_ context.Context, req *abci.RequestInfo) (*abci.Resp
 		return nil, fmt.Errorf("failed to get app for version %d: %w", m.appVersion, err)
 	}
 
-	return app.Info(req)
+	resp, err := app.Info(req)
+	if err != nil {
+		return nil, err
+	}
+	// the app reports its height when the node starts or finished state sync.
+	m.setCommittedHeight(resp.LastBlockHeight)
+	return resp, nil
 }
 
 func (m *Multiplexer) InitChain(_ context.Context, req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
@@ -138,15 +146,21 @@ func (m *Multiplexer) LoadSnapshotChunk(_ context.Context, req *abci.RequestLoad
 }
 
 func (m *Multiplexer) OfferSnapshot(_ context.Context, req *abci.RequestOfferSnapshot) (*abci.ResponseOfferSnapshot, error) {
-	m.mu.Lock()
-	m.appVersion = req.AppVersion
-	m.mu.Unlock()
+	if err := m.prepareSnapshotRestore(req.AppVersion); err != nil {
+		return nil, fmt.Errorf("failed to prepare snapshot restore for version %d: %w", req.AppVersion, err)
+	}
 
 	app, err := m.getApp()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get app for version %d: %w", m.appVersion, err)
 	}
-	return app.OfferSnapshot(req)
+
+	resp, err := app.OfferSnapshot(req)
+	if err != nil {
+		return nil, err
+	}
+	m.logSnapshotResult(req.AppVersion, resp)
+	return resp, nil
 }
 
 func (m *Multiplexer) PrepareProposal(_ context.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
@@ -195,6 +209,14 @@ func (m *Multiplexer) QuerySequence(ctx context.Context, req *abci.RequestQueryS
 	return app.QuerySequence(ctx, req)
 }
 
+// setCommittedHeight records that the app committed the block at height.
+func (m *Multiplexer) setCommittedHeight(height int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// Info may report an older height than a concurrent Commit.
+	m.committedHeight = max(m.committedHeight, height)
+}
+
 // checkHaltConditions returns an error if the node should halt based on a halt-height or
 // halt-time configured in app.toml.
 func (m *Multiplexer) checkHaltConditions(req *abci.RequestFinalizeBlock) error {
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"cosmossdk.io/log"
 	"github.com/celestiaorg/celestia-app/v10/app/observability"
@@ -59,6 +60,12 @@ type Multiplexer struct {
 	appVersion uint64
 	// nextAppVersion this is updated based on the consensus params every FinalizeBlock.
 	nextAppVersion uint64
+	// finalizedHeight is the height of the last finalized block. It becomes
+	// the committed height once the block is committed.
+	finalizedHeight int64
+	// committedHeight is the height of the last block committed by the app. A
+	// restarted embedded app must resume at this height.
+	committedHeight int64
 	// started indicates if there is an embedded app or native app running
 	started bool
 	// appCreator is a function type responsible for creating a new application instance.
@@ -87,6 +94,25 @@ type Multiplexer struct {
 	// Prometheus collector registration when enableGRPCAndAPIServers is
 	// called more than once (e.g. during a version switch).
 	metrics *telemetry.Metrics
+	// prewarmed is the next embedded version whose process was pre-started
+	// ahead of a pending upgrade.
+	prewarmed Version
+	// stopServers stops the gRPC and API servers of the native app.
+	stopServers context.CancelFunc
+	// servers tracks the running gRPC and API servers of the native app.
+	servers sync.WaitGroup
+	// superviseCancel stops supervising the active embedded app.
+	superviseCancel context.CancelFunc
+	// health tracks the app that is running for the health endpoint.
+	health healthState
+	// recorder records the ABCI calls to the app if recording is enabled.
+	recorder *Recorder
+	// recordCheckTx enables recording CheckTx calls.
+	recordCheckTx bool
+	// programArgs overrides the arguments that are passed to embedded apps in
+	// addition to their start args. If nil, the arguments of this process are
+	// used.
+	programArgs []string
 }
 
 // NewMultiplexer creates a new Multiplexer.
@@ -135,6 +161,18 @@ func (m *Multiplexer) Start() error {
 		return err
 	}
 
+	if err := m.startHealthServer(); err != nil {
+		return err
+	}
+
+	if err := m.startRecorder(); err != nil {
+		return err
+	}
+
+	if len(m.versions) > 0 {
+		m.g.Go(m.watchPendingUpgrades)
+	}
+
 	if m.isGrpcOnly() {
 		m.logger.Info("starting node in gRPC only mode; CometBFT is disabled")
 		m.svrCfg.GRPC.Enable = true
@@ -145,12 +183,18 @@ func (m *Multiplexer) Start() error {
 		}
 	}
 
+	// state sync may have replaced the native app with an embedded app in the
+	// meantime.
+	m.mu.Lock()
 	if m.isEmbeddedApp() {
+		m.mu.Unlock()
 		m.logger.Debug("using embedded app, not continuing with grpc or api servers")
 		return m.g.Wait()
 	}
 
-	if err := m.enableGRPCAndAPIServers(m.nativeApp); err != nil {
+	err := m.enableGRPCAndAPIServers(m.nativeApp)
+	m.mu.Unlock()
+	if err != nil {
 		return err
 	}
 
@@ -165,6 +209,9 @@ func (m *Multiplexer) enableGRPCAndAPIServers(app servertypes.Application) error
 	if app == nil {
 		return fmt.Errorf("unable to enable grpc and api servers, app is nil")
 	}
+	ctx, cancel := context.WithCancel(m.ctx)
+	m.stopServers = cancel
+
 	// if we are running natively and have specified to enable gRPC or API servers
 	// we need to register the relevant services.
 	if m.svrCfg.API.Enable || m.svrCfg.GRPC.Enable {
@@ -178,7 +225,7 @@ func (m *Multiplexer) enableGRPCAndAPIServers(app servertypes.Application) error
 	// startGRPCServer the grpc server in the case of a native app. If using an embedded app
 	// it will use that instead.
 	if m.svrCfg.GRPC.Enable {
-		grpcServer, clientContext, err := m.startGRPCServer()
+		grpcServer, clientContext, err := m.startGRPCServer(ctx)
 		if err != nil {
 			return err
 		}
@@ -191,7 +238,7 @@ func (m *Multiplexer) enableGRPCAndAPIServers(app servertypes.Application) error
 				return err
 			}
 
-			if err := m.startAPIServer(grpcServer, m.metrics); err != nil {
+			if err := m.startAPIServer(ctx, grpcServer, m.metrics); err != nil {
 				return err
 			}
 		}
@@ -219,10 +266,11 @@ func (m *Multiplexer) startApp() error {
 	}
 
 	if currentVersion.Appd.IsStopped() {
-		programArgs := removeStart(os.Args)
+		programArgs := m.getProgramArgs()
 
 		// start an embedded app.
 		m.logger.Debug("starting embedded app", "app_version", currentVersion.AppVersion, "args", currentVersion.GetStartArgs(programArgs))
+		currentVersion.Appd.SetOutput(m.outputWriters(currentVersion))
 		if err := currentVersion.Appd.Start(currentVersion.GetStartArgs(programArgs)...); err != nil {
 			return fmt.Errorf("failed to start app: %w", err)
 		}
@@ -233,11 +281,35 @@ func (m *Multiplexer) startApp() error {
 
 		m.started = true
 		m.activeVersion = currentVersion
+		m.supervise(currentVersion)
 	}
 
 	return m.initRemoteGrpcConn()
 }
 
+// StartApp starts the app for the current app version without CometBFT and
+// without the gRPC and API servers. It is used to execute blocks outside of
+// consensus, e.g. to replay them.
+func (m *Multiplexer) StartApp() error {
+	return m.startApp()
+}
+
+// SetProgramArgs sets the arguments that are passed to embedded apps in
+// addition to their start args. By default, the arguments of this process
+// without the start command are used.
+func (m *Multiplexer) SetProgramArgs(args []string) {
+	m.programArgs = args
+}
+
+// getProgramArgs returns the arguments that are passed to embedded apps in
+// addition to their start args.
+func (m *Multiplexer) getProgramArgs() []string {
+	if m.programArgs != nil {
+		return m.programArgs
+	}
+	return removeStart(os.Args)
+}
+
 // removeStart removes the first argument (the binary name) and the start argument from args.
 func removeStart(args []string) []string {
 	if len(args) == 0 {
@@ -293,7 +365,7 @@ func (m *Multiplexer) initRemoteGrpcConn() error {
 }
 
 // startGRPCServer initializes and starts a gRPC server if enabled in the configuration, returning the server and updated context.
-func (m *Multiplexer) startGRPCServer() (*grpc.Server, client.Context, error) {
+func (m *Multiplexer) startGRPCServer(ctx context.Context) (*grpc.Server, client.Context, error) {
 	_, _, err := net.SplitHostPort(m.svrCfg.GRPC.Address)
 	if err != nil {
 		return nil, m.clientContext, err
@@ -340,14 +412,14 @@ func (m *Multiplexer) startGRPCServer() (*grpc.Server, client.Context, error) {
 	blockAPI := coregrpc.NewBlockAPI(coreEnv)
 	coregrpc.RegisterBlockAPIServer(grpcSrv, blockAPI)
 
-	m.g.Go(func() error {
-		return blockAPI.StartNewBlockEventListener(m.ctx)
+	m.goServer(func() error {
+		return blockAPI.StartNewBlockEventListener(ctx)
 	})
 
 	// Start the gRPC server in a goroutine. Note, the provided ctx will ensure
 	// that the server is gracefully shut down.
-	m.g.Go(func() error {
-		return servergrpc.StartGRPCServer(m.ctx, m.logger.With(log.ModuleKey, "grpc-server"), m.svrCfg.GRPC, grpcSrv)
+	m.goServer(func() error {
+		return servergrpc.StartGRPCServer(ctx, m.logger.With(log.ModuleKey, "grpc-server"), m.svrCfg.GRPC, grpcSrv)
 	})
 
 	m.conn = grpcClient
@@ -355,7 +427,7 @@ func (m *Multiplexer) startGRPCServer() (*grpc.Server, client.Context, error) {
 }
 
 // startAPIServer initializes and starts the API server, setting up routes, telemetry, and running it within an error group.
-func (m *Multiplexer) startAPIServer(grpcSrv *grpc.Server, metrics *telemetry.Metrics) error {
+func (m *Multiplexer) startAPIServer(ctx context.Context, grpcSrv *grpc.Server, metrics *telemetry.Metrics) error {
 	if m.isEmbeddedApp() {
 		return fmt.Errorf("cannot start api server for embedded app")
 	}
@@ -370,12 +442,21 @@ func (m *Multiplexer) startAPIServer(grpcSrv *grpc.Server, metrics *telemetry.Me
 	}
 
 	m.logger.Debug("starting api server")
-	m.g.Go(func() error {
-		return apiSrv.Start(m.ctx, m.svrCfg)
+	m.goServer(func() error {
+		return apiSrv.Start(ctx, m.svrCfg)
 	})
 	return nil
 }
 
+// goServer runs a server of the native app in the errgroup.
+func (m *Multiplexer) goServer(serve func() error) {
+	m.servers.Add(1)
+	m.g.Go(func() error {
+		defer m.servers.Done()
+		return serve()
+	})
+}
+
 // startNativeApp starts a native app.
 func (m *Multiplexer) startNativeApp() (servertypes.Application, error) {
 	traceWriter, err := getTraceWriter(m.svrCtx)
@@ -393,6 +474,7 @@ func (m *Multiplexer) startNativeApp() (servertypes.Application, error) {
 	m.logger.Debug("creating native app", "app_version", m.appVersion)
 	m.nativeApp = m.appCreator(m.logger, db, m.traceWriter, m.svrCtx.Viper)
 	m.started = true
+	m.health.setNative(m.appVersion)
 	return m.nativeApp, nil
 }
 
@@ -432,6 +514,10 @@ func (m *Multiplexer) getApp() (servertypes.ABCI, error) {
 	// get the appropriate version for the latest app version.
 	currentVersion, err := m.versions.GetForAppVersion(m.appVersion)
 	if err != nil {
+		switchStart, fromAppVersion := time.Now(), m.activeVersion.AppVersion
+		if err := m.releasePrewarmed(Version{}); err != nil {
+			return nil, err
+		}
 		// if we are switching from an embedded binary to a native one, we need to ensure that we stop it
 		// before we start the native app.
 		if err := m.stopEmbeddedApp(); err != nil {
@@ -450,24 +536,32 @@ func (m *Multiplexer) getApp() (servertypes.ABCI, error) {
 			if err := m.enableGRPCAndAPIServers(app); err != nil {
 				return nil, fmt.Errorf("failed to enable gRPC and API servers: %w", err)
 			}
+			if fromAppVersion != 0 {
+				observeSwitchover(switchStart, fromAppVersion, m.appVersion)
+			}
 		}
 
-		return m.nativeApp, nil
+		return m.record(m.nativeApp, m.appVersion), nil
 	}
 
 	// check if we need to start the app or if we have a different app running
 	if !m.started || currentVersion.AppVersion > m.activeVersion.AppVersion {
 		m.logger.Info("Using ABCI remote connection", "maximum_app_version", m.activeVersion.AppVersion, "abci_version", m.activeVersion.ABCIVersion.String(), "chain_id", m.chainID)
+		switchStart, fromAppVersion := time.Now(), m.activeVersion.AppVersion
 		if err := m.startEmbeddedApp(currentVersion); err != nil {
 			return nil, fmt.Errorf("failed to start embedded app: %w", err)
 		}
+		m.waitForEmbeddedApp(currentVersion)
+		if fromAppVersion != 0 {
+			observeSwitchover(switchStart, fromAppVersion, currentVersion.AppVersion)
+		}
 	}
 
 	switch m.activeVersion.ABCIVersion {
 	case ABCIClientVersion1:
-		return NewRemoteABCIClientV1(m.conn, m.chainID, m.appVersion), nil
+		return m.record(NewRemoteABCIClientV1(m.conn, m.chainID, m.appVersion), m.appVersion), nil
 	case ABCIClientVersion2:
-		return NewRemoteABCIClientV2(m.conn), nil
+		return m.record(NewRemoteABCIClientV2(m.conn), m.appVersion), nil
 	}
 
 	return nil, fmt.Errorf("unknown ABCI client version %d", m.activeVersion.ABCIVersion)
@@ -480,12 +574,18 @@ func (m *Multiplexer) startEmbeddedApp(version Version) error {
 		return fmt.Errorf("appd is nil for version %d", m.activeVersion.AppVersion)
 	}
 
+	// a prewarmed process of another version is not needed anymore.
+	if err := m.releasePrewarmed(version); err != nil {
+		return err
+	}
+
 	// stop the existing app version if one is currently running.
 	if err := m.stopEmbeddedApp(); err != nil {
 		return fmt.Errorf("failed to stop active version: %w", err)
 	}
 
-	if version.Appd.IsStopped() {
+	// a prewarmed process is released by Start.
+	if version.Appd.IsStopped() || version.Appd.IsPrestarted() {
 		for _, preHandler := range version.PreHandlers {
 			preCmd := version.Appd.CreateExecCommand(preHandler)
 			if err := preCmd.Run(); err != nil {
@@ -495,9 +595,10 @@ func (m *Multiplexer) startEmbeddedApp(version Version) error {
 		}
 
 		// start the new app
-		programArgs := removeStart(os.Args)
+		programArgs := m.getProgramArgs()
 
 		m.logger.Info("Starting app for version", "app_version", version.AppVersion, "args", version.GetStartArgs(programArgs))
+		version.Appd.SetOutput(m.outputWriters(version))
 		if err := version.Appd.Start(version.GetStartArgs(programArgs)...); err != nil {
 			return fmt.Errorf("failed to start app for version %d: %w", m.appVersion, err)
 		}
@@ -508,6 +609,7 @@ func (m *Multiplexer) startEmbeddedApp(version Version) error {
 
 		m.activeVersion = version
 		m.started = true
+		m.supervise(version)
 	}
 	return nil
 }
@@ -561,12 +663,18 @@ func (m *Multiplexer) Stop() error {
 	if err := m.stopEmbeddedApp(); err != nil {
 		fmt.Println(err)
 	}
+	if err := m.releasePrewarmed(Version{}); err != nil {
+		fmt.Println(err)
+	}
 	if err := m.stopGRPCConnection(); err != nil {
 		fmt.Println(err)
 	}
 	if err := m.stopTraceWriter(); err != nil {
 		fmt.Println(err)
 	}
+	if err := m.stopRecorder(); err != nil {
+		fmt.Println(err)
+	}
 	return nil
 }
 
@@ -595,8 +703,33 @@ func (m *Multiplexer) stopNativeApp() error {
 	return nil
 }
 
+// replaceNativeApp stops the native app together with its gRPC and API servers
+// so that an embedded app can use its database and addresses, and connects to
+// the ABCI address of embedded apps. It must be called with m.mu held.
+func (m *Multiplexer) replaceNativeApp() error {
+	if m.stopServers != nil {
+		m.stopServers()
+		m.stopServers = nil
+		m.servers.Wait()
+	}
+	if err := m.stopNativeApp(); err != nil {
+		return err
+	}
+	m.nativeApp = nil
+	m.started = false
+
+	// the connection belonged to the gRPC server of the native app.
+	if err := m.stopGRPCConnection(); err != nil {
+		return err
+	}
+	return m.initRemoteGrpcConn()
+}
+
 // stopEmbeddedApp stops any embedded app versions if they are currently running.
 func (m *Multiplexer) stopEmbeddedApp() error {
+	// stop supervising first so that the app is not restarted after it was
+	// stopped on purpose or after it exited before a version switch.
+	m.stopSupervising()
 	if !m.embeddedVersionRunning() {
 		return nil
 	}
@@ -0,0 +1,163 @@
+package abci
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	signaltypes "github.com/celestiaorg/celestia-app/v10/x/signal/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/hashicorp/go-metrics"
+)
+
+const (
+	// getUpgradeQueryPath is the path of the x/signal query for a pending upgrade.
+	getUpgradeQueryPath = "/celestia.signal.v1.Query/GetUpgrade"
+	// upgradeCheckInterval is how often the running app is queried for a
+	// pending upgrade.
+	upgradeCheckInterval = 30 * time.Second
+	// prewarmTimeout bounds preparing the next version in the background.
+	prewarmTimeout = 2 * time.Minute
+)
+
+// watchPendingUpgrades checks for a pending upgrade every upgradeCheckInterval
+// until the multiplexer stops. It runs in the background so that the query
+// doesn't delay Commit.
+func (m *Multiplexer) watchPendingUpgrades() error {
+	ticker := time.NewTicker(upgradeCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return nil
+		case <-ticker.C:
+			m.checkPendingUpgrade()
+		}
+	}
+}
+
+// checkPendingUpgrade queries x/signal of the running embedded app for a
+// pending upgrade. If there is one, the embedded version that runs after the
+// upgrade is prewarmed.
+func (m *Multiplexer) checkPendingUpgrade() {
+	m.mu.Lock()
+	app := m.embeddedApp()
+	if app == nil || !m.hasNextEmbeddedVersion() || (m.prewarmed.Appd != nil && m.prewarmed.Appd.IsPrestarted()) {
+		m.mu.Unlock()
+		return
+	}
+	appVersion := m.appVersion
+	m.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(m.ctx, upgradeCheckInterval)
+	upgrade, err := queryPendingUpgrade(ctx, app)
+	cancel()
+	if err != nil {
+		m.logger.Debug("failed to query pending upgrade", "err", err)
+		return
+	}
+	if upgrade == nil || upgrade.AppVersion <= appVersion {
+		return
+	}
+
+	version, err := m.versions.GetForAppVersion(upgrade.AppVersion)
+	if err != nil {
+		m.logger.Info("pending upgrade to native app detected", "app_version", upgrade.AppVersion, "upgrade_height", upgrade.UpgradeHeight)
+		return
+	}
+	m.logger.Info("pending upgrade detected, preparing embedded app", "app_version", upgrade.AppVersion, "upgrade_height", upgrade.UpgradeHeight)
+	m.prewarm(version)
+}
+
+// prewarm decompresses and health-checks the binary of version and pre-starts
+// its process so that getApp only has to release it at the upgrade height.
+func (m *Multiplexer) prewarm(version Version) {
+	ctx, cancel := context.WithTimeout(m.ctx, prewarmTimeout)
+	defer cancel()
+
+	start := time.Now()
+	labels := []metrics.Label{telemetry.NewLabel("app_version", strconv.FormatUint(version.AppVersion, 10))}
+	if err := version.Appd.Prepare(ctx); err != nil {
+		m.logger.Error("failed to prepare embedded app, retrying later", "app_version", version.AppVersion, "err", err)
+		telemetry.IncrCounterWithLabels([]string{"multiplexer", "prewarm", "failures"}, 1, labels)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// the multiplexer may have switched versions or stopped in the meantime.
+	if m.ctx.Err() != nil || m.activeVersion.AppVersion >= version.AppVersion || version.Appd.IsRunning() {
+		return
+	}
+	version.Appd.SetOutput(m.outputWriters(version))
+	if err := version.Appd.Prestart(version.GetStartArgs(m.getProgramArgs())...); err != nil {
+		m.logger.Error("failed to pre-start embedded app, retrying later", "app_version", version.AppVersion, "err", err)
+		telemetry.IncrCounterWithLabels([]string{"multiplexer", "prewarm", "failures"}, 1, labels)
+		return
+	}
+	m.prewarmed = version
+
+	metrics.MeasureSinceWithLabels([]string{"multiplexer", "prewarm"}, start, labels)
+	m.logger.Info("embedded app prepared", "app_version", version.AppVersion, "pid", version.Appd.PID(), "duration", time.Since(start))
+}
+
+// releasePrewarmed stops the prewarmed app unless it is the app of version,
+// which is about to be started. It must be called with m.mu held.
+func (m *Multiplexer) releasePrewarmed(version Version) error {
+	prewarmed := m.prewarmed
+	m.prewarmed = Version{}
+	if prewarmed.Appd == nil || prewarmed.Appd == version.Appd {
+		return nil
+	}
+	if err := prewarmed.Appd.Stop(); err != nil {
+		return fmt.Errorf("failed to stop prewarmed app for version %d: %w", prewarmed.AppVersion, err)
+	}
+	return nil
+}
+
+// hasNextEmbeddedVersion returns true if an embedded version exists for an app
+// version higher than the current one.
+func (m *Multiplexer) hasNextEmbeddedVersion() bool {
+	return len(m.versions) > 0 && m.versions[len(m.versions)-1].AppVersion > m.appVersion
+}
+
+// embeddedApp returns a client of the running embedded app that doesn't
+// record its calls, or nil if no embedded app is running. It must be called
+// with m.mu held.
+func (m *Multiplexer) embeddedApp() servertypes.ABCI {
+	if m.isNativeApp() || !m.embeddedVersionRunning() || m.conn == nil {
+		return nil
+	}
+	switch m.activeVersion.ABCIVersion {
+	case ABCIClientVersion1:
+		return NewRemoteABCIClientV1(m.conn, m.chainID, m.appVersion)
+	case ABCIClientVersion2:
+		return NewRemoteABCIClientV2(m.conn)
+	}
+	return nil
+}
+
+// queryPendingUpgrade returns the upgrade that x/signal of the app has
+// scheduled or nil if there is none.
+func queryPendingUpgrade(ctx context.Context, app servertypes.ABCI) (*signaltypes.Upgrade, error) {
+	data, err := (&signaltypes.QueryGetUpgradeRequest{}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := app.Query(ctx, &abci.RequestQuery{Path: getUpgradeQueryPath, Data: data})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != abci.CodeTypeOK {
+		return nil, fmt.Errorf("query failed with code %d: %s", resp.Code, resp.Log)
+	}
+
+	var upgradeResp signaltypes.QueryGetUpgradeResponse
+	if err := upgradeResp.Unmarshal(resp.Value); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal upgrade: %w", err)
+	}
+	return upgradeResp.Upgrade, nil
+}
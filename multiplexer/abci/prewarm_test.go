@@ -0,0 +1,95 @@
+package abci
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/log"
+	signaltypes "github.com/celestiaorg/celestia-app/v10/x/signal/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/stretchr/testify/require"
+)
+
+// upgradeQueryApp is an ABCI app that only answers the x/signal upgrade query.
+type upgradeQueryApp struct {
+	servertypes.ABCI
+	upgrade *signaltypes.Upgrade
+	code    uint32
+}
+
+func (a upgradeQueryApp) Query(_ context.Context, req *abci.RequestQuery) (*abci.ResponseQuery, error) {
+	if req.Path != getUpgradeQueryPath {
+		return &abci.ResponseQuery{Code: 1, Log: "unknown query path"}, nil
+	}
+	if a.code != abci.CodeTypeOK {
+		return &abci.ResponseQuery{Code: a.code, Log: "query failed"}, nil
+	}
+	value, err := (&signaltypes.QueryGetUpgradeResponse{Upgrade: a.upgrade}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &abci.ResponseQuery{Value: value}, nil
+}
+
+func TestQueryPendingUpgrade(t *testing.T) {
+	t.Run("returns the pending upgrade", func(t *testing.T) {
+		want := &signaltypes.Upgrade{AppVersion: 4, UpgradeHeight: 100}
+		got, err := queryPendingUpgrade(context.Background(), upgradeQueryApp{upgrade: want})
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	})
+	t.Run("returns nil if there is no pending upgrade", func(t *testing.T) {
+		got, err := queryPendingUpgrade(context.Background(), upgradeQueryApp{})
+		require.NoError(t, err)
+		require.Nil(t, got)
+	})
+	t.Run("returns an error if the query fails", func(t *testing.T) {
+		_, err := queryPendingUpgrade(context.Background(), upgradeQueryApp{code: 2})
+		require.Error(t, err)
+	})
+}
+
+func TestHasNextEmbeddedVersion(t *testing.T) {
+	m := &Multiplexer{versions: Versions{{AppVersion: 3}, {AppVersion: 4}}}
+
+	m.appVersion = 3
+	require.True(t, m.hasNextEmbeddedVersion())
+
+	m.appVersion = 4
+	require.False(t, m.hasNextEmbeddedVersion())
+
+	m.versions = nil
+	require.False(t, m.hasNextEmbeddedVersion())
+}
+
+func TestPrewarm(t *testing.T) {
+	app := newScriptAppd(t, `if [ "$1" = version ]; then exit 0; fi; sleep 10`)
+	version := Version{AppVersion: 4, Appd: app}
+	m := &Multiplexer{logger: log.NewNopLogger(), ctx: context.Background(), versions: Versions{version}, programArgs: []string{}}
+
+	m.prewarm(version)
+	require.True(t, app.IsPrestarted())
+	pid := app.PID()
+
+	// the switch to the next version reuses the pre-started process.
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	require.NoError(t, m.startEmbeddedApp(version))
+	require.False(t, app.IsPrestarted())
+	require.True(t, app.IsRunning())
+	require.Equal(t, pid, app.PID())
+	require.NoError(t, m.stopEmbeddedApp())
+}
+
+func TestReleasePrewarmed(t *testing.T) {
+	app := newScriptAppd(t, "sleep 10")
+	version := Version{AppVersion: 4, Appd: app}
+	m := &Multiplexer{logger: log.NewNopLogger(), prewarmed: version}
+	require.NoError(t, app.Prestart())
+
+	// a switch to another version stops the pre-started process.
+	require.NoError(t, m.releasePrewarmed(Version{}))
+	require.True(t, app.IsStopped())
+	require.Nil(t, m.prewarmed.Appd)
+}
@@ -0,0 +1,292 @@
+package abci
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// recordFileKey is the app config key of the file ABCI requests and responses
+// are recorded to. Recording is disabled if it is empty. Like the health
+// address, it is not a flag because flags are passed down to embedded apps.
+const recordFileKey = "multiplexer.record-file"
+
+// recordCheckTxKey is the app config key that enables recording CheckTx calls.
+// They are not recorded by default because a node receives many more of them
+// than blocks and they are not needed to replay blocks.
+const recordCheckTxKey = "multiplexer.record-check-tx"
+
+// recordingMagic is written at the start of every recording.
+var recordingMagic = []byte("ABCIREC1")
+
+// RecordedCall is an ABCI request that was sent to the app of AppVersion
+// together with the response of the app. Errors returned by the app are
+// recorded as a ResponseException.
+type RecordedCall struct {
+	AppVersion uint64
+	Request    *abci.Request
+	Response   *abci.Response
+}
+
+// Recorder writes ABCI requests and their responses to a file. A recording is
+// a magic header followed by one entry per call: the app version as a uvarint
+// followed by the length-delimited Request and Response.
+type Recorder struct {
+	mu   sync.Mutex
+	file *os.File
+	w    *bufio.Writer
+}
+
+// NewRecorder creates or appends to the recording at path.
+func NewRecorder(path string) (*Recorder, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create directory for recording: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open recording %s: %w", path, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to stat recording %s: %w", path, err)
+	}
+
+	r := &Recorder{file: file, w: bufio.NewWriter(file)}
+	if info.Size() == 0 {
+		if _, err := r.w.Write(recordingMagic); err != nil {
+			file.Close()
+			return nil, fmt.Errorf("failed to write recording header: %w", err)
+		}
+	}
+	return r, nil
+}
+
+// Record appends a call to the recording. The recording is flushed after
+// every Commit so that a crash loses at most the calls of one block.
+func (r *Recorder) Record(call RecordedCall) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.w.Write(binary.AppendUvarint(nil, call.AppVersion)); err != nil {
+		return err
+	}
+	if err := abci.WriteMessage(call.Request, r.w); err != nil {
+		return err
+	}
+	if err := abci.WriteMessage(call.Response, r.w); err != nil {
+		return err
+	}
+	if _, ok := call.Request.Value.(*abci.Request_Commit); ok {
+		return r.w.Flush()
+	}
+	return nil
+}
+
+// Close flushes and closes the recording.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.w.Flush(); err != nil {
+		r.file.Close()
+		return err
+	}
+	return r.file.Close()
+}
+
+// RecordingReader reads the calls of a recording.
+type RecordingReader struct {
+	r *bufio.Reader
+}
+
+// NewRecordingReader returns a reader for the recording in r.
+func NewRecordingReader(r io.Reader) (*RecordingReader, error) {
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(recordingMagic))
+	if _, err := io.ReadFull(br, magic); err != nil {
+		return nil, fmt.Errorf("failed to read recording header: %w", err)
+	}
+	if string(magic) != string(recordingMagic) {
+		return nil, fmt.Errorf("not an ABCI recording")
+	}
+	return &RecordingReader{r: br}, nil
+}
+
+// Next returns the next call of the recording or io.EOF at the end of it.
+func (r *RecordingReader) Next() (RecordedCall, error) {
+	appVersion, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return RecordedCall{}, err
+	}
+
+	call := RecordedCall{AppVersion: appVersion, Request: &abci.Request{}, Response: &abci.Response{}}
+	if err := abci.ReadMessage(r.r, call.Request); err != nil {
+		return RecordedCall{}, fmt.Errorf("failed to read request: %w", truncated(err))
+	}
+	if err := abci.ReadMessage(r.r, call.Response); err != nil {
+		return RecordedCall{}, fmt.Errorf("failed to read response: %w", truncated(err))
+	}
+	return call, nil
+}
+
+// truncated turns an unexpected end of the recording into io.ErrUnexpectedEOF.
+func truncated(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// startRecorder starts recording if a record file is configured.
+func (m *Multiplexer) startRecorder() error {
+	path := m.svrCtx.Viper.GetString(recordFileKey)
+	if path == "" {
+		return nil
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(m.svrCtx.Config.RootDir, path)
+	}
+
+	recorder, err := NewRecorder(path)
+	if err != nil {
+		return err
+	}
+	m.recordCheckTx = m.svrCtx.Viper.GetBool(recordCheckTxKey)
+	m.logger.Info("recording ABCI requests and responses", "file", path, "check_tx", m.recordCheckTx)
+	m.recorder = recorder
+	return nil
+}
+
+func (m *Multiplexer) stopRecorder() error {
+	if m.recorder == nil {
+		return nil
+	}
+	m.logger.Info("stopping ABCI recorder")
+	return m.recorder.Close()
+}
+
+// record wraps app so that its calls are recorded if recording is enabled.
+func (m *Multiplexer) record(app servertypes.ABCI, appVersion uint64) servertypes.ABCI {
+	if m.recorder == nil {
+		return app
+	}
+	return &recordingApp{ABCI: app, recorder: m.recorder, logger: m.logger, appVersion: appVersion, recordCheckTx: m.recordCheckTx}
+}
+
+// recordingApp records the calls to an app. QuerySequence is not recorded
+// because it has no ABCI request type. CheckTx is only recorded if
+// recordCheckTx is set.
+type recordingApp struct {
+	servertypes.ABCI
+	recorder      *Recorder
+	logger        log.Logger
+	appVersion    uint64
+	recordCheckTx bool
+}
+
+func (a *recordingApp) record(req *abci.Request, resp *abci.Response, err error) {
+	if err != nil {
+		resp = abci.ToResponseException(err.Error())
+	}
+	if err := a.recorder.Record(RecordedCall{AppVersion: a.appVersion, Request: req, Response: resp}); err != nil {
+		a.logger.Error("failed to record ABCI call", "err", err)
+	}
+}
+
+func (a *recordingApp) Info(req *abci.RequestInfo) (*abci.ResponseInfo, error) {
+	resp, err := a.ABCI.Info(req)
+	a.record(abci.ToRequestInfo(req), abci.ToResponseInfo(resp), err)
+	return resp, err
+}
+
+func (a *recordingApp) Query(ctx context.Context, req *abci.RequestQuery) (*abci.ResponseQuery, error) {
+	resp, err := a.ABCI.Query(ctx, req)
+	a.record(abci.ToRequestQuery(req), abci.ToResponseQuery(resp), err)
+	return resp, err
+}
+
+func (a *recordingApp) CheckTx(req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
+	if !a.recordCheckTx {
+		return a.ABCI.CheckTx(req)
+	}
+	resp, err := a.ABCI.CheckTx(req)
+	a.record(abci.ToRequestCheckTx(req), abci.ToResponseCheckTx(resp), err)
+	return resp, err
+}
+
+func (a *recordingApp) InitChain(req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
+	resp, err := a.ABCI.InitChain(req)
+	a.record(abci.ToRequestInitChain(req), abci.ToResponseInitChain(resp), err)
+	return resp, err
+}
+
+func (a *recordingApp) PrepareProposal(req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+	resp, err := a.ABCI.PrepareProposal(req)
+	a.record(abci.ToRequestPrepareProposal(req), abci.ToResponsePrepareProposal(resp), err)
+	return resp, err
+}
+
+func (a *recordingApp) ProcessProposal(req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+	resp, err := a.ABCI.ProcessProposal(req)
+	a.record(abci.ToRequestProcessProposal(req), abci.ToResponseProcessProposal(resp), err)
+	return resp, err
+}
+
+func (a *recordingApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	resp, err := a.ABCI.FinalizeBlock(req)
+	a.record(abci.ToRequestFinalizeBlock(req), abci.ToResponseFinalizeBlock(resp), err)
+	return resp, err
+}
+
+func (a *recordingApp) ExtendVote(ctx context.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+	resp, err := a.ABCI.ExtendVote(ctx, req)
+	a.record(abci.ToRequestExtendVote(req), abci.ToResponseExtendVote(resp), err)
+	return resp, err
+}
+
+func (a *recordingApp) VerifyVoteExtension(req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+	resp, err := a.ABCI.VerifyVoteExtension(req)
+	a.record(abci.ToRequestVerifyVoteExtension(req), abci.ToResponseVerifyVoteExtension(resp), err)
+	return resp, err
+}
+
+func (a *recordingApp) Commit() (*abci.ResponseCommit, error) {
+	resp, err := a.ABCI.Commit()
+	a.record(abci.ToRequestCommit(), abci.ToResponseCommit(resp), err)
+	return resp, err
+}
+
+func (a *recordingApp) ListSnapshots(req *abci.RequestListSnapshots) (*abci.ResponseListSnapshots, error) {
+	resp, err := a.ABCI.ListSnapshots(req)
+	a.record(abci.ToRequestListSnapshots(req), abci.ToResponseListSnapshots(resp), err)
+	return resp, err
+}
+
+func (a *recordingApp) OfferSnapshot(req *abci.RequestOfferSnapshot) (*abci.ResponseOfferSnapshot, error) {
+	resp, err := a.ABCI.OfferSnapshot(req)
+	a.record(abci.ToRequestOfferSnapshot(req), abci.ToResponseOfferSnapshot(resp), err)
+	return resp, err
+}
+
+func (a *recordingApp) LoadSnapshotChunk(req *abci.RequestLoadSnapshotChunk) (*abci.ResponseLoadSnapshotChunk, error) {
+	resp, err := a.ABCI.LoadSnapshotChunk(req)
+	a.record(abci.ToRequestLoadSnapshotChunk(req), abci.ToResponseLoadSnapshotChunk(resp), err)
+	return resp, err
+}
+
+func (a *recordingApp) ApplySnapshotChunk(req *abci.RequestApplySnapshotChunk) (*abci.ResponseApplySnapshotChunk, error) {
+	resp, err := a.ABCI.ApplySnapshotChunk(req)
+	a.record(abci.ToRequestApplySnapshotChunk(req), abci.ToResponseApplySnapshotChunk(resp), err)
+	return resp, err
+}
@@ -0,0 +1,138 @@
+package abci
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeABCI responds to FinalizeBlock and Commit and fails CheckTx.
+type fakeABCI struct {
+	servertypes.ABCI
+}
+
+func (fakeABCI) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	return &abci.ResponseFinalizeBlock{AppHash: []byte("app hash")}, nil
+}
+
+func (fakeABCI) Commit() (*abci.ResponseCommit, error) {
+	return &abci.ResponseCommit{RetainHeight: 1}, nil
+}
+
+func (fakeABCI) CheckTx(*abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
+	return nil, errors.New("check tx failed")
+}
+
+func TestRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recordings", "abci.rec")
+
+	recorder, err := NewRecorder(path)
+	require.NoError(t, err)
+	m := &Multiplexer{logger: log.NewNopLogger(), recorder: recorder, recordCheckTx: true}
+	app := m.record(fakeABCI{}, 3)
+
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 10})
+	require.NoError(t, err)
+	_, err = app.CheckTx(&abci.RequestCheckTx{Tx: []byte("tx")})
+	require.Error(t, err)
+	require.NoError(t, recorder.Close())
+
+	// calls are appended to an existing recording.
+	recorder, err = NewRecorder(path)
+	require.NoError(t, err)
+	m.recorder = recorder
+	_, err = m.record(fakeABCI{}, 4).Commit()
+	require.NoError(t, err)
+	require.NoError(t, recorder.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	reader, err := NewRecordingReader(file)
+	require.NoError(t, err)
+
+	call, err := reader.Next()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), call.AppVersion)
+	require.Equal(t, int64(10), call.Request.GetFinalizeBlock().Height)
+	require.Equal(t, []byte("app hash"), call.Response.GetFinalizeBlock().AppHash)
+
+	call, err = reader.Next()
+	require.NoError(t, err)
+	require.Equal(t, []byte("tx"), call.Request.GetCheckTx().Tx)
+	require.Equal(t, "check tx failed", call.Response.GetException().Error)
+
+	call, err = reader.Next()
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), call.AppVersion)
+	require.NotNil(t, call.Request.GetCommit())
+	require.Equal(t, int64(1), call.Response.GetCommit().RetainHeight)
+
+	_, err = reader.Next()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestRecorderSkipsCheckTx(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "abci.rec")
+	recorder, err := NewRecorder(path)
+	require.NoError(t, err)
+	m := &Multiplexer{logger: log.NewNopLogger(), recorder: recorder}
+	app := m.record(fakeABCI{}, 3)
+
+	_, err = app.CheckTx(&abci.RequestCheckTx{Tx: []byte("tx")})
+	require.Error(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+	require.NoError(t, recorder.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	reader, err := NewRecordingReader(file)
+	require.NoError(t, err)
+
+	call, err := reader.Next()
+	require.NoError(t, err)
+	require.NotNil(t, call.Request.GetCommit())
+	_, err = reader.Next()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestRecordingReader(t *testing.T) {
+	t.Run("should reject files that are not recordings", func(t *testing.T) {
+		_, err := NewRecordingReader(bytes.NewReader([]byte("not a recording")))
+		require.Error(t, err)
+	})
+	t.Run("should report a truncated recording", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "abci.rec")
+		recorder, err := NewRecorder(path)
+		require.NoError(t, err)
+		require.NoError(t, recorder.Record(RecordedCall{
+			AppVersion: 3,
+			Request:    abci.ToRequestCommit(),
+			Response:   abci.ToResponseCommit(&abci.ResponseCommit{}),
+		}))
+		require.NoError(t, recorder.Close())
+
+		bz, err := os.ReadFile(path)
+		require.NoError(t, err)
+		reader, err := NewRecordingReader(bytes.NewReader(bz[:len(bz)-1]))
+		require.NoError(t, err)
+		_, err = reader.Next()
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+}
+
+func TestRecordDisabled(t *testing.T) {
+	m := &Multiplexer{logger: log.NewNopLogger()}
+	app := fakeABCI{}
+	require.Equal(t, app, m.record(app, 3))
+}
@@ -0,0 +1,56 @@
+package abci
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+// prepareSnapshotRestore switches the multiplexer to the app that serves
+// appVersion so that a snapshot offered during state sync is restored by the
+// app version that created it. Snapshots may be offered for different app
+// versions one after another if earlier ones are rejected, so a running app of
+// another version is stopped. A running native app is replaced by the embedded
+// app because it has no state yet before a snapshot was restored.
+func (m *Multiplexer) prepareSnapshotRestore(appVersion uint64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.appVersion = appVersion
+	// the snapshot determines the app version of the first block after the
+	// restore until FinalizeBlock reports otherwise.
+	m.nextAppVersion = appVersion
+
+	version, err := m.versions.GetForAppVersion(appVersion)
+	if err != nil {
+		// the native app restores the snapshot. getApp stops a running
+		// embedded app before it starts the native app.
+		return nil
+	}
+
+	if m.isNativeApp() {
+		m.logger.Info("replacing native app with embedded app to restore snapshot", "app_version", version.AppVersion)
+		if err := m.replaceNativeApp(); err != nil {
+			return fmt.Errorf("failed to stop native app: %w", err)
+		}
+		return m.startEmbeddedApp(version)
+	}
+
+	if m.embeddedVersionRunning() && m.activeVersion.AppVersion != version.AppVersion {
+		m.logger.Info("switching embedded app to restore snapshot", "from_app_version", m.activeVersion.AppVersion, "to_app_version", version.AppVersion)
+		if err := m.stopEmbeddedApp(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// logSnapshotResult logs the result of offering a snapshot to the app that
+// serves appVersion.
+func (m *Multiplexer) logSnapshotResult(appVersion uint64, resp *abci.ResponseOfferSnapshot) {
+	if resp.Result == abci.ResponseOfferSnapshot_ACCEPT {
+		m.logger.Info("snapshot accepted", "app_version", appVersion, "embedded", m.isEmbeddedApp())
+		return
+	}
+	m.logger.Info("snapshot not accepted", "app_version", appVersion, "result", resp.Result.String())
+}
@@ -0,0 +1,208 @@
+//go:build multiplexer
+
+package abci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v10/app"
+	"github.com/celestiaorg/celestia-app/v10/internal/embedding"
+	"github.com/celestiaorg/celestia-app/v10/multiplexer/appd"
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	abci "github.com/cometbft/cometbft/abci/types"
+	db "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStateSyncAcrossAppVersions offers snapshots of the app versions of the
+// testdata genesis files to a multiplexer, in the order in which a state
+// syncing node may receive them, and verifies that each snapshot is offered
+// to the app version that created it before handing over to the native app.
+func TestStateSyncAcrossAppVersions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test which expects embedded binaries")
+	}
+
+	v3Genesis := filepath.Join("..", "internal", "testdata", "genesis.v3.json")
+	v4Genesis := filepath.Join("..", "internal", "testdata", "genesis.v4.json")
+	v3AppVersion := genesisAppVersion(t, v3Genesis)
+	v4AppVersion := genesisAppVersion(t, v4Genesis)
+	require.Equal(t, uint64(3), v3AppVersion)
+	require.Equal(t, uint64(4), v4AppVersion)
+
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+	genesis, err := os.ReadFile(v3Genesis)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "genesis.json"), genesis, 0o644))
+
+	address := "tcp://" + freeAddress(t)
+	originalArgs := os.Args
+	os.Args = []string{"celestia-appd", "start", "--home", home, "--address", address, "--proxy_app", address}
+	defer func() { os.Args = originalArgs }()
+
+	serverContext := server.NewDefaultContext()
+	serverContext.Config.SetRoot(home)
+	serverContext.Viper.Set("address", address)
+	serverContext.Viper.Set("proxy_app", address)
+
+	versions := getEmbeddedVersions(t)
+	multiplexer, err := NewMultiplexer(serverContext, serverconfig.Config{}, client.Context{}, nativeAppCreator(), versions, appconsts.TestChainID, v3AppVersion)
+	require.NoError(t, err)
+	require.NoError(t, multiplexer.startApp())
+	defer func() { require.NoError(t, multiplexer.Stop()) }()
+	require.Equal(t, v3AppVersion, multiplexer.activeVersion.AppVersion)
+
+	// A snapshot of the next version is restored by the next embedded app.
+	offerSnapshot(t, multiplexer, v4AppVersion)
+	require.Equal(t, v4AppVersion, multiplexer.activeVersion.AppVersion)
+	require.True(t, multiplexer.activeVersion.Appd.IsRunning())
+	require.False(t, multiplexer.isNativeApp())
+
+	// If that snapshot is rejected, a snapshot of an older version is restored
+	// by the older embedded app.
+	v4 := multiplexer.activeVersion.Appd
+	offerSnapshot(t, multiplexer, v3AppVersion)
+	require.Equal(t, v3AppVersion, multiplexer.activeVersion.AppVersion)
+	require.True(t, v4.IsStopped())
+
+	// A snapshot of the current version is restored by the native app.
+	v3 := multiplexer.activeVersion.Appd
+	offerSnapshot(t, multiplexer, appconsts.Version)
+	require.True(t, multiplexer.isNativeApp())
+	require.True(t, v3.IsStopped())
+	require.False(t, multiplexer.embeddedVersionRunning())
+
+	// If that snapshot is rejected as well, the native app is replaced by the
+	// embedded app of an older snapshot.
+	offerSnapshot(t, multiplexer, v4AppVersion)
+	require.False(t, multiplexer.isNativeApp())
+	require.Equal(t, v4AppVersion, multiplexer.activeVersion.AppVersion)
+	require.True(t, multiplexer.activeVersion.Appd.IsRunning())
+}
+
+// offerSnapshot offers a snapshot of appVersion to the multiplexer. The
+// snapshot is not valid so the app is expected to respond without accepting
+// it.
+func offerSnapshot(t *testing.T, multiplexer *Multiplexer, appVersion uint64) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		resp, err := multiplexer.OfferSnapshot(ctx, snapshotRequest(appVersion))
+		if err == nil && resp.Result == abci.ResponseOfferSnapshot_ACCEPT {
+			err = fmt.Errorf("invalid snapshot of app version %d was accepted", appVersion)
+		}
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-ctx.Done():
+		t.Fatalf("timed out offering snapshot of app version %d", appVersion)
+	}
+	require.Equal(t, appVersion, multiplexer.appVersion)
+}
+
+func snapshotRequest(appVersion uint64) *abci.RequestOfferSnapshot {
+	return &abci.RequestOfferSnapshot{
+		Snapshot: &abci.Snapshot{
+			Height:   10,
+			Format:   3,
+			Chunks:   1,
+			Hash:     []byte("hash"),
+			Metadata: []byte("metadata"),
+		},
+		AppHash:    []byte("app hash"),
+		AppVersion: appVersion,
+	}
+}
+
+// genesisAppVersion returns the app version of a genesis file in either the
+// v1 or the v2 genesis format.
+func genesisAppVersion(t *testing.T, path string) uint64 {
+	t.Helper()
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var genesis struct {
+		ConsensusParams struct {
+			Version struct {
+				AppVersion string `json:"app_version"`
+			} `json:"version"`
+		} `json:"consensus_params"`
+		Consensus struct {
+			Params struct {
+				Version struct {
+					App string `json:"app"`
+				} `json:"version"`
+			} `json:"params"`
+		} `json:"consensus"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &genesis))
+
+	version := genesis.ConsensusParams.Version.AppVersion
+	if version == "" {
+		version = genesis.Consensus.Params.Version.App
+	}
+	appVersion, err := strconv.ParseUint(version, 10, 64)
+	require.NoError(t, err)
+	return appVersion
+}
+
+func getEmbeddedVersions(t *testing.T) Versions {
+	t.Helper()
+
+	v3Tag, v3Binary, err := embedding.CelestiaAppV3()
+	require.NoError(t, err)
+	v3, err := appd.New(v3Tag, v3Binary)
+	require.NoError(t, err)
+
+	v4Tag, v4Binary, err := embedding.CelestiaAppV4()
+	require.NoError(t, err)
+	v4, err := appd.New(v4Tag, v4Binary)
+	require.NoError(t, err)
+
+	args := []string{"--with-tendermint=false", "--transport=grpc"}
+	versions, err := NewVersions(
+		Version{Appd: v3, ABCIVersion: ABCIClientVersion1, AppVersion: 3, StartArgs: args},
+		Version{Appd: v4, ABCIVersion: ABCIClientVersion2, AppVersion: 4, StartArgs: append([]string{fmt.Sprintf("--minimum-gas-prices=%v%s", appconsts.LegacyDefaultMinGasPrice, appconsts.BondDenom)}, args...)},
+	)
+	require.NoError(t, err)
+	return versions
+}
+
+func nativeAppCreator() servertypes.AppCreator {
+	return func(logger log.Logger, db db.DB, traceStore io.Writer, appOpts servertypes.AppOptions) servertypes.Application {
+		return app.New(logger, db, traceStore, 0, 0, appOpts)
+	}
+}
+
+// freeAddress returns a local address with a free port.
+func freeAddress(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	return listener.Addr().String()
+}
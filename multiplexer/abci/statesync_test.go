@@ -0,0 +1,93 @@
+package abci
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v10/multiplexer/appd"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNativeApp is a stand-in for a running native app.
+type fakeNativeApp struct {
+	servertypes.Application
+	closed bool
+}
+
+func (a *fakeNativeApp) Close() error {
+	a.closed = true
+	return nil
+}
+
+func TestPrepareSnapshotRestore(t *testing.T) {
+	t.Run("should use the native app for versions without an embedded binary", func(t *testing.T) {
+		m := &Multiplexer{logger: log.NewNopLogger(), versions: Versions{{AppVersion: 3}}}
+
+		require.NoError(t, m.prepareSnapshotRestore(5))
+		require.Equal(t, uint64(5), m.appVersion)
+		require.Equal(t, uint64(5), m.nextAppVersion)
+	})
+	t.Run("should replace the native app with the embedded app", func(t *testing.T) {
+		v3 := newSleepingAppd(t)
+		versions := Versions{{AppVersion: 3, Appd: v3}}
+		svrCtx := server.NewDefaultContext()
+		svrCtx.Viper.Set("address", "tcp://127.0.0.1:26658")
+		svrCtx.Viper.Set("proxy_app", "tcp://127.0.0.1:26658")
+		native := &fakeNativeApp{}
+		m := &Multiplexer{logger: log.NewNopLogger(), svrCtx: svrCtx, versions: versions, started: true, nativeApp: native, programArgs: []string{}}
+
+		require.NoError(t, m.prepareSnapshotRestore(3))
+		require.True(t, native.closed)
+		require.False(t, m.isNativeApp())
+		require.True(t, v3.IsRunning())
+		require.True(t, m.started)
+		require.Equal(t, uint64(3), m.activeVersion.AppVersion)
+		require.NotNil(t, m.conn)
+		require.NoError(t, m.stopEmbeddedApp())
+		require.NoError(t, m.stopGRPCConnection())
+	})
+	t.Run("should stop an embedded app of another version", func(t *testing.T) {
+		v3 := newSleepingAppd(t)
+		require.NoError(t, v3.Start())
+
+		versions := Versions{{AppVersion: 3, Appd: v3}, {AppVersion: 4, Appd: newSleepingAppd(t)}}
+		m := &Multiplexer{logger: log.NewNopLogger(), versions: versions, started: true, activeVersion: versions[0]}
+
+		require.NoError(t, m.prepareSnapshotRestore(4))
+		require.True(t, v3.IsStopped())
+		require.False(t, m.started)
+	})
+	t.Run("should keep an embedded app of the same version", func(t *testing.T) {
+		v3 := newSleepingAppd(t)
+		require.NoError(t, v3.Start())
+		defer func() { require.NoError(t, v3.Stop()) }()
+
+		versions := Versions{{AppVersion: 3, Appd: v3}}
+		m := &Multiplexer{logger: log.NewNopLogger(), versions: versions, started: true, activeVersion: versions[0]}
+
+		require.NoError(t, m.prepareSnapshotRestore(3))
+		require.True(t, v3.IsRunning())
+		require.True(t, m.started)
+	})
+}
+
+// newSleepingAppd returns an external Appd for a script that sleeps when it is
+// started.
+func newSleepingAppd(t *testing.T) *appd.Appd {
+	t.Helper()
+
+	script := []byte("#!/bin/sh\nsleep 10\n")
+	path := filepath.Join(t.TempDir(), "celestia-appd")
+	require.NoError(t, os.WriteFile(path, script, 0o755))
+
+	checksum := sha256.Sum256(script)
+	app, err := appd.NewExternal(path, hex.EncodeToString(checksum[:]))
+	require.NoError(t, err)
+	return app
+}
@@ -0,0 +1,363 @@
+package abci
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/hashicorp/go-metrics"
+	abciv1 "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+)
+
+const (
+	// maxRestarts is the number of times an embedded app that exited
+	// unexpectedly is restarted within restartWindow before the multiplexer
+	// gives up on it.
+	maxRestarts = 5
+	// restartWindow is the period after which a restart no longer counts
+	// towards maxRestarts.
+	restartWindow = 10 * time.Minute
+	// initialRestartBackoff is the delay before the first restart. It doubles
+	// with every restart within restartWindow up to maxRestartBackoff.
+	initialRestartBackoff = time.Second
+	maxRestartBackoff     = 30 * time.Second
+
+	// healthAddressKey is the app config key of the address the health
+	// endpoint listens on, e.g. "localhost:26662". The endpoint is disabled if
+	// it is empty. It can be set in the [multiplexer] section of app.toml or
+	// via the CELESTIA_APP_MULTIPLEXER_HEALTH_ADDRESS environment variable. It
+	// is not a flag because flags are passed down to embedded apps.
+	healthAddressKey = "multiplexer.health-address"
+	healthPath       = "/health"
+)
+
+// HealthStatus describes the app the multiplexer is running.
+type HealthStatus struct {
+	AppVersion uint64 `json:"app_version"`
+	// Embedded is true if an embedded app is used and false if the native app
+	// is used.
+	Embedded bool `json:"embedded"`
+	Running  bool `json:"running"`
+	// PID and Uptime describe the process of the embedded app.
+	PID               int        `json:"pid,omitempty"`
+	Uptime            string     `json:"uptime,omitempty"`
+	Restarts          int        `json:"restarts"`
+	LastRestartReason string     `json:"last_restart_reason,omitempty"`
+	LastRestartAt     *time.Time `json:"last_restart_at,omitempty"`
+}
+
+// healthState tracks the app the multiplexer is running separately from the
+// multiplexer so that it can be reported without waiting for a version switch.
+type healthState struct {
+	mu         sync.Mutex
+	appVersion uint64
+	native     bool
+	pid        int
+	startedAt  time.Time
+	// done is closed once the process of the embedded app has exited.
+	done <-chan struct{}
+	// restarts are the times of the restarts within restartWindow.
+	restarts          []time.Time
+	lastRestartReason string
+	lastRestartAt     time.Time
+}
+
+func (h *healthState) setEmbedded(version Version) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.appVersion = version.AppVersion
+	h.native = false
+	h.pid = version.Appd.PID()
+	h.startedAt = version.Appd.StartedAt()
+	h.done = version.Appd.Done()
+}
+
+func (h *healthState) setNative(appVersion uint64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.appVersion = appVersion
+	h.native = true
+	h.pid = 0
+	h.startedAt = time.Time{}
+	h.done = nil
+}
+
+// recordRestart records a restart for reason and returns how many restarts
+// happened within restartWindow including this one. It returns false if the
+// app was restarted too often to be restarted again.
+func (h *healthState) recordRestart(reason string, now time.Time) (int, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	recent := h.restarts[:0]
+	for _, restart := range h.restarts {
+		if now.Sub(restart) < restartWindow {
+			recent = append(recent, restart)
+		}
+	}
+	h.restarts = recent
+	h.lastRestartReason = reason
+
+	if len(h.restarts) >= maxRestarts {
+		return len(h.restarts), false
+	}
+	h.restarts = append(h.restarts, now)
+	h.lastRestartAt = now
+	return len(h.restarts), true
+}
+
+func (h *healthState) status(now time.Time) HealthStatus {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	status := HealthStatus{
+		AppVersion:        h.appVersion,
+		Embedded:          !h.native,
+		Running:           h.native,
+		Restarts:          len(h.restarts),
+		LastRestartReason: h.lastRestartReason,
+	}
+	if !h.lastRestartAt.IsZero() {
+		lastRestartAt := h.lastRestartAt
+		status.LastRestartAt = &lastRestartAt
+	}
+	if h.native || h.done == nil {
+		return status
+	}
+
+	select {
+	case <-h.done:
+	default:
+		status.Running = true
+		status.PID = h.pid
+		status.Uptime = now.Sub(h.startedAt).Round(time.Second).String()
+	}
+	return status
+}
+
+// Health returns the status of the app the multiplexer is running.
+func (m *Multiplexer) Health() HealthStatus {
+	return m.health.status(time.Now())
+}
+
+// restartBackoff returns the delay before the given restart.
+func restartBackoff(restart int) time.Duration {
+	backoff := initialRestartBackoff
+	for i := 1; i < restart && backoff < maxRestartBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxRestartBackoff)
+}
+
+// supervise restarts the embedded app of version if its process exits before
+// supervision is stopped. It must be called with m.mu held after the app was
+// started.
+func (m *Multiplexer) supervise(version Version) {
+	m.stopSupervising()
+	m.health.setEmbedded(version)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.superviseCancel = cancel
+	done := version.Appd.Done()
+	go func() {
+		select {
+		case <-ctx.Done():
+			return
+		case <-done:
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		m.restartEmbeddedApp(ctx, version)
+	}()
+}
+
+// stopSupervising stops supervising the active embedded app. It must be
+// called before the app is stopped on purpose.
+func (m *Multiplexer) stopSupervising() {
+	if m.superviseCancel != nil {
+		m.superviseCancel()
+		m.superviseCancel = nil
+	}
+}
+
+// restartEmbeddedApp restarts the embedded app of version after its process
+// exited unexpectedly. Restarts are delayed by an increasing backoff and
+// given up after maxRestarts within restartWindow.
+func (m *Multiplexer) restartEmbeddedApp(ctx context.Context, version Version) {
+	labels := []metrics.Label{telemetry.NewLabel("app_version", strconv.FormatUint(version.AppVersion, 10))}
+	reason := "exited"
+	if err := version.Appd.ExitErr(); err != nil {
+		reason = err.Error()
+	}
+	m.logger.Error("embedded app exited unexpectedly", "app_version", version.AppVersion, "reason", reason)
+
+	for {
+		restart, ok := m.health.recordRestart(reason, time.Now())
+		if !ok {
+			m.logger.Error("embedded app exited too often, not restarting it", "app_version", version.AppVersion, "restarts", restart, "window", restartWindow)
+			telemetry.IncrCounterWithLabels([]string{"multiplexer", "restart", "exhausted"}, 1, labels)
+			return
+		}
+
+		backoff := restartBackoff(restart)
+		m.logger.Info("restarting embedded app", "app_version", version.AppVersion, "restart", restart, "backoff", backoff)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		m.mu.Lock()
+		// the multiplexer may have switched versions or stopped in the meantime.
+		if ctx.Err() != nil || m.activeVersion.Appd != version.Appd {
+			m.mu.Unlock()
+			return
+		}
+		version.Appd.SetOutput(m.outputWriters(version))
+		err := version.Appd.Start(version.GetStartArgs(m.getProgramArgs())...)
+		if err == nil {
+			// requests are only routed to the app once it passed the check
+			// because they wait for m.mu.
+			err = m.checkRestartedApp(version)
+			if err == nil {
+				m.supervise(version)
+				m.mu.Unlock()
+				telemetry.IncrCounterWithLabels([]string{"multiplexer", "restart"}, 1, labels)
+				m.logger.Info("restarted embedded app", "app_version", version.AppVersion, "pid", version.Appd.PID())
+				return
+			}
+			if stopErr := version.Appd.Stop(); stopErr != nil {
+				m.logger.Error("failed to stop restarted embedded app", "app_version", version.AppVersion, "err", stopErr)
+			}
+		}
+		m.mu.Unlock()
+
+		reason = err.Error()
+		m.logger.Error("failed to restart embedded app", "app_version", version.AppVersion, "err", err)
+	}
+}
+
+// checkRestartedApp reconnects to the restarted embedded app of version and
+// checks that it resumed at the height the multiplexer last committed. It must
+// be called with m.mu held.
+func (m *Multiplexer) checkRestartedApp(version Version) error {
+	if m.conn == nil {
+		return nil
+	}
+	// the connection backs off while the app is down so reconnect right away.
+	m.conn.ResetConnectBackoff()
+	m.conn.Connect()
+
+	ctx, cancel := context.WithTimeout(context.Background(), readinessTimeout)
+	defer cancel()
+	var height int64
+	switch version.ABCIVersion {
+	case ABCIClientVersion1:
+		resp, err := abciv1.NewABCIApplicationClient(m.conn).Info(ctx, &abciv1.RequestInfo{}, grpc.WaitForReady(true))
+		if err != nil {
+			return fmt.Errorf("failed to get info of restarted app: %w", err)
+		}
+		height = resp.LastBlockHeight
+	case ABCIClientVersion2:
+		resp, err := abci.NewABCIClient(m.conn).Info(ctx, &abci.RequestInfo{}, grpc.WaitForReady(true))
+		if err != nil {
+			return fmt.Errorf("failed to get info of restarted app: %w", err)
+		}
+		height = resp.LastBlockHeight
+	}
+	if height != m.committedHeight {
+		return fmt.Errorf("restarted app is at height %d but the last committed height is %d", height, m.committedHeight)
+	}
+	return nil
+}
+
+// outputWriters returns writers that write the standard output and standard
+// error of the embedded app of version to the node log.
+func (m *Multiplexer) outputWriters(version Version) (stdout, stderr *logWriter) {
+	logger := m.logger.With("app_version", version.AppVersion)
+	return &logWriter{logger: logger.With("stream", "stdout")}, &logWriter{logger: logger.With("stream", "stderr")}
+}
+
+// logWriter writes every line written to it to a logger.
+type logWriter struct {
+	logger log.Logger
+	mu     sync.Mutex
+	buf    []byte
+}
+
+func (w *logWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		line := strings.TrimRight(string(w.buf[:i]), "\r")
+		w.buf = w.buf[i+1:]
+		if line != "" {
+			w.logger.Info(line)
+		}
+	}
+	return len(p), nil
+}
+
+// startHealthServer serves the health endpoint if an address is configured.
+func (m *Multiplexer) startHealthServer() error {
+	address := m.svrCtx.Viper.GetString(healthAddressKey)
+	if address == "" {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on health address %s: %w", address, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(healthPath, m.handleHealth)
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+
+	m.logger.Info("serving multiplexer health endpoint", "address", listener.Addr().String()+healthPath)
+	m.g.Go(func() error {
+		if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("health server failed: %w", err)
+		}
+		return nil
+	})
+	m.g.Go(func() error {
+		<-m.ctx.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return srv.Shutdown(ctx)
+	})
+	return nil
+}
+
+// handleHealth responds with the HealthStatus. The status code is 503 if the
+// app is not running.
+func (m *Multiplexer) handleHealth(w http.ResponseWriter, _ *http.Request) {
+	status := m.Health()
+	w.Header().Set("Content-Type", "application/json")
+	if !status.Running {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		m.logger.Error("failed to write health status", "err", err)
+	}
+}
@@ -0,0 +1,154 @@
+package abci
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v10/multiplexer/appd"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestRestartBackoff(t *testing.T) {
+	require.Equal(t, time.Second, restartBackoff(1))
+	require.Equal(t, 2*time.Second, restartBackoff(2))
+	require.Equal(t, 4*time.Second, restartBackoff(3))
+	require.Equal(t, maxRestartBackoff, restartBackoff(10))
+}
+
+func TestRecordRestart(t *testing.T) {
+	var health healthState
+	now := time.Now()
+
+	for i := 1; i <= maxRestarts; i++ {
+		restart, ok := health.recordRestart("exit status 1", now)
+		require.True(t, ok)
+		require.Equal(t, i, restart)
+	}
+
+	_, ok := health.recordRestart("exit status 2", now)
+	require.False(t, ok, "restarts should be exhausted")
+	require.Equal(t, "exit status 2", health.status(now).LastRestartReason)
+
+	// restarts outside the window no longer count.
+	restart, ok := health.recordRestart("exit status 3", now.Add(restartWindow))
+	require.True(t, ok)
+	require.Equal(t, 1, restart)
+}
+
+func TestLogWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := &logWriter{logger: log.NewLogger(&buf, log.OutputJSONOption()).With("app_version", 3)}
+
+	_, err := w.Write([]byte("first line\nsecond "))
+	require.NoError(t, err)
+	_, err = w.Write([]byte("line\r\n\n"))
+	require.NoError(t, err)
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+	for i, want := range []string{"first line", "second line"} {
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(lines[i], &entry))
+		require.Equal(t, want, entry["message"])
+		require.EqualValues(t, 3, entry["app_version"])
+	}
+}
+
+func TestSupervise(t *testing.T) {
+	// the app exits shortly after it is started for the first time and is
+	// expected to be restarted.
+	marker := filepath.Join(t.TempDir(), "started")
+	app := newScriptAppd(t, fmt.Sprintf("if [ -f %[1]s ]; then sleep 10; else touch %[1]s; sleep 0.2; fi", marker))
+	version := Version{AppVersion: 3, Appd: app}
+	m := &Multiplexer{logger: log.NewNopLogger(), versions: Versions{version}, programArgs: []string{}}
+
+	m.mu.Lock()
+	require.NoError(t, app.Start())
+	m.activeVersion = version
+	m.started = true
+	m.supervise(version)
+	firstPID := app.PID()
+	m.mu.Unlock()
+
+	require.Eventually(t, func() bool {
+		return m.Health().Restarts == 1 && m.Health().Running
+	}, 10*time.Second, 50*time.Millisecond)
+
+	m.mu.Lock()
+	require.NotEqual(t, firstPID, app.PID())
+	require.NoError(t, m.stopEmbeddedApp())
+	m.mu.Unlock()
+
+	// the app was stopped on purpose so it is not restarted again.
+	time.Sleep(2 * time.Second)
+	status := m.Health()
+	require.Equal(t, 1, status.Restarts)
+	require.False(t, status.Running)
+	require.Equal(t, uint64(3), status.AppVersion)
+	require.True(t, status.Embedded)
+
+	recorder := httptest.NewRecorder()
+	m.handleHealth(recorder, httptest.NewRequest(http.MethodGet, healthPath, nil))
+	require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+}
+
+func TestCheckRestartedApp(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	abci.RegisterABCIServer(srv, &infoServer{height: 10})
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, conn.Close()) })
+
+	version := Version{AppVersion: 5, ABCIVersion: ABCIClientVersion2}
+	m := &Multiplexer{logger: log.NewNopLogger(), conn: conn, committedHeight: 10}
+	require.NoError(t, m.checkRestartedApp(version))
+
+	// the app lost a committed block so it must not be used.
+	m.committedHeight = 11
+	require.ErrorContains(t, m.checkRestartedApp(version), "restarted app is at height 10 but the last committed height is 11")
+}
+
+// infoServer is an ABCI server that reports height.
+type infoServer struct {
+	abci.UnimplementedABCIServer
+	height int64
+}
+
+func (s *infoServer) Info(context.Context, *abci.RequestInfo) (*abci.ResponseInfo, error) {
+	return &abci.ResponseInfo{LastBlockHeight: s.height}, nil
+}
+
+// newScriptAppd returns an external Appd for a script that runs command when
+// it is started.
+func newScriptAppd(t *testing.T, command string) *appd.Appd {
+	t.Helper()
+
+	script := []byte("#!/bin/sh\n" + command + "\n")
+	path := filepath.Join(t.TempDir(), "celestia-appd")
+	require.NoError(t, os.WriteFile(path, script, 0o755))
+
+	checksum := sha256.Sum256(script)
+	app, err := appd.NewExternal(path, hex.EncodeToString(checksum[:]))
+	require.NoError(t, err)
+	return app
+}
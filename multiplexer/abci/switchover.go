@@ -0,0 +1,48 @@
+package abci
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/hashicorp/go-metrics"
+	abciv1 "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+)
+
+// readinessTimeout bounds waiting for a newly started embedded app to accept
+// ABCI requests.
+const readinessTimeout = time.Minute
+
+// waitForEmbeddedApp waits until the embedded app of version accepts ABCI
+// requests. A timeout is logged rather than returned because ABCI requests
+// wait for the app anyway.
+func (m *Multiplexer) waitForEmbeddedApp(version Version) {
+	if m.conn == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), readinessTimeout)
+	defer cancel()
+
+	var err error
+	switch version.ABCIVersion {
+	case ABCIClientVersion1:
+		_, err = abciv1.NewABCIApplicationClient(m.conn).Echo(ctx, &abciv1.RequestEcho{}, grpc.WaitForReady(true))
+	case ABCIClientVersion2:
+		_, err = abci.NewABCIClient(m.conn).Echo(ctx, &abci.RequestEcho{}, grpc.WaitForReady(true))
+	}
+	if err != nil {
+		m.logger.Warn("embedded app is not ready", "app_version", version.AppVersion, "err", err)
+	}
+}
+
+// observeSwitchover records the time it took to switch from one app version to
+// another.
+func observeSwitchover(start time.Time, fromAppVersion, toAppVersion uint64) {
+	metrics.MeasureSinceWithLabels([]string{"multiplexer", "switchover"}, start, []metrics.Label{
+		telemetry.NewLabel("from_app_version", strconv.FormatUint(fromAppVersion, 10)),
+		telemetry.NewLabel("to_app_version", strconv.FormatUint(toAppVersion, 10)),
+	})
+}
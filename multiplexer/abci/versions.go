@@ -2,17 +2,32 @@ package abci
 
 import (
 	"fmt"
+	"slices"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/celestiaorg/celestia-app/v10/multiplexer/appd"
 )
 
-// NewVersions returns a list of versions sorted by app version.
+// NewVersions returns a list of versions sorted by app version. Versions that
+// reference an external binary instead of an Appd are resolved and verified
+// against their pinned checksum.
 func NewVersions(v ...Version) (Versions, error) {
 	versions := Versions(v)
 	if err := versions.Validate(); err != nil {
 		return nil, err
 	}
+	for i, version := range versions {
+		if !version.isExternal() {
+			continue
+		}
+		resolved, err := version.resolveAppd()
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve binary for app version %d: %w", version.AppVersion, err)
+		}
+		versions[i].Appd = resolved
+	}
 	return versions.Sorted(), nil
 }
 
@@ -21,6 +36,13 @@ type Version struct {
 	AppVersion  uint64
 	ABCIVersion ABCIClientVersion
 	Appd        *appd.Appd
+	// BinaryPath is the path to an external celestia-appd binary. It must
+	// not be set together with Appd. If it is empty but SHA256 is set, the binary is
+	// looked up in the content-addressed binary cache instead.
+	BinaryPath string
+	// SHA256 is the hex encoded SHA-256 checksum an external binary must
+	// match. It is required if BinaryPath is set.
+	SHA256      string
 	PreHandlers []string // Commands to run before starting the app
 	StartArgs   []string // Extra arguments to pass to the app
 }
@@ -75,6 +97,57 @@ func (v Versions) ShouldUseLatestApp(appVersion uint64) bool {
 	return err != nil
 }
 
+// WithExternalBinaries returns a copy of the versions in which the embedded
+// binary of an app version is replaced by an external binary. Each binary is
+// specified as <app version>:<sha256>[:<path>]. Without a path the binary is
+// looked up in the content-addressed binary cache. Only app versions that are
+// already in v can be replaced because their ABCI version and start args are
+// kept.
+func (v Versions) WithExternalBinaries(binaries []string) (Versions, error) {
+	if len(binaries) == 0 {
+		return v, nil
+	}
+
+	versions := make(Versions, len(v))
+	copy(versions, v)
+	for _, binary := range binaries {
+		parts := strings.SplitN(binary, ":", 3)
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("invalid external binary %q: expected <app version>:<sha256>[:<path>]", binary)
+		}
+		appVersion, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid app version of external binary %q: %w", binary, err)
+		}
+
+		i := slices.IndexFunc(versions, func(version Version) bool { return version.AppVersion == appVersion })
+		if i < 0 {
+			return nil, fmt.Errorf("%w: %d", ErrNoVersionFound, appVersion)
+		}
+		versions[i].Appd = nil
+		versions[i].SHA256 = parts[1]
+		versions[i].BinaryPath = ""
+		if len(parts) == 3 {
+			versions[i].BinaryPath = parts[2]
+		}
+	}
+	return NewVersions(versions...)
+}
+
+// isExternal returns true if the version references a binary that is not
+// embedded in the build.
+func (v Version) isExternal() bool {
+	return v.BinaryPath != "" || v.SHA256 != ""
+}
+
+// resolveAppd returns the Appd of the external binary the version references.
+func (v Version) resolveAppd() (*appd.Appd, error) {
+	if v.BinaryPath != "" {
+		return appd.NewExternal(v.BinaryPath, v.SHA256)
+	}
+	return appd.NewFromCache(v.SHA256)
+}
+
 // GetStartArgs returns the appropriate args.
 func (v Version) GetStartArgs(args []string) []string {
 	if len(v.StartArgs) > 0 {
@@ -103,6 +176,13 @@ func (v Versions) Validate() error {
 			return fmt.Errorf("version %d specified multiple times", ver.AppVersion)
 		}
 		seen[ver.AppVersion] = struct{}{}
+
+		if ver.Appd != nil && ver.isExternal() {
+			return fmt.Errorf("version %d specifies both an embedded binary and an external binary", ver.AppVersion)
+		}
+		if ver.BinaryPath != "" && ver.SHA256 == "" {
+			return fmt.Errorf("version %d specifies binary path %s without a sha256 checksum", ver.AppVersion, ver.BinaryPath)
+		}
 	}
 
 	return nil
@@ -1,10 +1,15 @@
 package abci
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/celestiaorg/celestia-app/v10/multiplexer/appd"
 	"github.com/stretchr/testify/require"
 )
 
@@ -154,6 +159,21 @@ func TestEnsureUniqueVersions(t *testing.T) {
 			versions:    []Version{{AppVersion: 1}, {AppVersion: 2}, {AppVersion: 1}, {AppVersion: 3}, {AppVersion: 2}},
 			expectedErr: errors.New("version 1 specified multiple times"),
 		},
+		{
+			name:        "binary path without checksum",
+			versions:    []Version{{AppVersion: 1, BinaryPath: "/usr/local/bin/celestia-appd"}},
+			expectedErr: errors.New("version 1 specifies binary path /usr/local/bin/celestia-appd without a sha256 checksum"),
+		},
+		{
+			name:        "embedded binary with binary path",
+			versions:    []Version{{AppVersion: 1, Appd: &appd.Appd{}, BinaryPath: "/usr/local/bin/celestia-appd", SHA256: "abcd"}},
+			expectedErr: errors.New("version 1 specifies both an embedded binary and an external binary"),
+		},
+		{
+			name:        "embedded binary with checksum",
+			versions:    []Version{{AppVersion: 1, Appd: &appd.Appd{}, SHA256: "abcd"}},
+			expectedErr: errors.New("version 1 specifies both an embedded binary and an external binary"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -169,3 +189,50 @@ func TestEnsureUniqueVersions(t *testing.T) {
 		})
 	}
 }
+
+func TestWithExternalBinaries(t *testing.T) {
+	script := []byte("#!/bin/sh\necho v1.0.0\n")
+	path := filepath.Join(t.TempDir(), "celestia-appd")
+	require.NoError(t, os.WriteFile(path, script, 0o755))
+	sum := sha256.Sum256(script)
+	checksum := hex.EncodeToString(sum[:])
+
+	embedded := &appd.Appd{}
+	versions := Versions{
+		{AppVersion: 3, ABCIVersion: ABCIClientVersion1, Appd: embedded, StartArgs: []string{"--v2-upgrade-height=1"}},
+		{AppVersion: 4, ABCIVersion: ABCIClientVersion2, Appd: embedded},
+	}
+
+	t.Run("should replace the embedded binary of an app version", func(t *testing.T) {
+		got, err := versions.WithExternalBinaries([]string{fmt.Sprintf("3:%s:%s", checksum, path)})
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		require.Equal(t, checksum, got[0].Appd.Checksum())
+		require.Equal(t, path, got[0].BinaryPath)
+		require.Equal(t, ABCIClientVersion1, got[0].ABCIVersion)
+		require.Equal(t, []string{"--v2-upgrade-height=1"}, got[0].StartArgs)
+		require.Same(t, embedded, got[1].Appd)
+		require.Same(t, embedded, versions[0].Appd, "the original versions must not be modified")
+	})
+	t.Run("should return the versions unchanged without external binaries", func(t *testing.T) {
+		got, err := versions.WithExternalBinaries(nil)
+		require.NoError(t, err)
+		require.Equal(t, versions, got)
+	})
+	t.Run("should reject a binary that doesn't match the checksum", func(t *testing.T) {
+		other := sha256.Sum256([]byte("other"))
+		_, err := versions.WithExternalBinaries([]string{fmt.Sprintf("3:%s:%s", hex.EncodeToString(other[:]), path)})
+		require.ErrorContains(t, err, "checksum mismatch")
+	})
+	t.Run("should reject an app version that is not in the versions", func(t *testing.T) {
+		_, err := versions.WithExternalBinaries([]string{fmt.Sprintf("5:%s:%s", checksum, path)})
+		require.ErrorIs(t, err, ErrNoVersionFound)
+	})
+	t.Run("should reject a malformed external binary", func(t *testing.T) {
+		_, err := versions.WithExternalBinaries([]string{checksum})
+		require.ErrorContains(t, err, "invalid external binary")
+
+		_, err = versions.WithExternalBinaries([]string{"v3:" + checksum})
+		require.ErrorContains(t, err, "invalid app version")
+	})
+}
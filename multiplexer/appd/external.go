@@ -0,0 +1,121 @@
+package appd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// NewExternal returns a new Appd instance for a celestia-appd binary that is
+// not embedded in the build. The binary at path must match the hex encoded
+// SHA-256 checksum. It is verified again every time it is started.
+func NewExternal(path string, checksum string) (*Appd, error) {
+	checksum, err := parseChecksum(checksum)
+	if err != nil {
+		return nil, err
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve path %s: %w", path, err)
+	}
+
+	appd := &Appd{
+		version:  checksumVersion(checksum),
+		path:     absPath,
+		checksum: checksum,
+		stdin:    os.Stdin,
+		stdout:   os.Stdout,
+		stderr:   os.Stderr,
+	}
+	if err := appd.Verify(); err != nil {
+		return nil, err
+	}
+	return appd, nil
+}
+
+// NewFromCache returns a new Appd instance for the binary with the given hex
+// encoded SHA-256 checksum in the content-addressed binary cache. Binaries are
+// stored in the cache under their checksum, see CachePath.
+func NewFromCache(checksum string) (*Appd, error) {
+	checksum, err := parseChecksum(checksum)
+	if err != nil {
+		return nil, err
+	}
+	return NewExternal(CachePath(checksum), checksum)
+}
+
+// CachePath returns the path at which the binary with the given hex encoded
+// SHA-256 checksum is stored in the content-addressed binary cache.
+func CachePath(checksum string) string {
+	return filepath.Join(getDirectoryForCelestiaAppBinaries(), "sha256", strings.ToLower(checksum))
+}
+
+// Checksum returns the hex encoded SHA-256 checksum the binary is pinned to or
+// an empty string for embedded binaries.
+func (a *Appd) Checksum() string {
+	return a.checksum
+}
+
+// Verify checks that the binary is executable and matches its pinned checksum.
+// Embedded binaries are not pinned so only the former is checked.
+func (a *Appd) Verify() error {
+	info, err := os.Stat(a.path)
+	if err != nil {
+		return fmt.Errorf("failed to stat binary %s: %w", a.path, err)
+	}
+	if info.IsDir() || info.Mode()&0o111 == 0 {
+		return fmt.Errorf("binary %s is not executable", a.path)
+	}
+
+	if a.checksum == "" {
+		return nil
+	}
+
+	got, err := fileChecksum(a.path)
+	if err != nil {
+		return err
+	}
+	if got != a.checksum {
+		return fmt.Errorf("checksum mismatch for binary %s: expected %s, got %s", a.path, a.checksum, got)
+	}
+	return nil
+}
+
+// fileChecksum returns the hex encoded SHA-256 checksum of the file at path.
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open binary %s: %w", path, err)
+	}
+	defer f.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, f); err != nil {
+		return "", fmt.Errorf("failed to hash binary %s: %w", path, err)
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// parseChecksum validates a hex encoded SHA-256 checksum and returns it in
+// lower case.
+func parseChecksum(checksum string) (string, error) {
+	decoded, err := hex.DecodeString(checksum)
+	if err != nil {
+		return "", fmt.Errorf("invalid sha256 checksum %q: %w", checksum, err)
+	}
+	if len(decoded) != sha256.Size {
+		return "", fmt.Errorf("invalid sha256 checksum %q: expected %d bytes, got %d", checksum, sha256.Size, len(decoded))
+	}
+	return hex.EncodeToString(decoded), nil
+}
+
+// checksumVersion returns the version used for an external binary. External
+// binaries are identified by their checksum rather than a release tag.
+func checksumVersion(checksum string) string {
+	return "sha256-" + checksum[:12]
+}
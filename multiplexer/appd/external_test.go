@@ -0,0 +1,80 @@
+package appd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExternal(t *testing.T) {
+	script := []byte("#!/bin/sh\necho v1.0.0\n")
+	path := filepath.Join(t.TempDir(), "celestia-appd")
+	require.NoError(t, os.WriteFile(path, script, 0o755))
+	checksum := sha256.Sum256(script)
+
+	t.Run("should accept a binary that matches the checksum", func(t *testing.T) {
+		appdInstance, err := NewExternal(path, hex.EncodeToString(checksum[:]))
+		require.NoError(t, err)
+		require.Equal(t, path, appdInstance.path)
+		require.Equal(t, hex.EncodeToString(checksum[:]), appdInstance.Checksum())
+	})
+	t.Run("should accept an upper case checksum", func(t *testing.T) {
+		_, err := NewExternal(path, strings.ToUpper(hex.EncodeToString(checksum[:])))
+		require.NoError(t, err)
+	})
+	t.Run("should reject a binary that doesn't match the checksum", func(t *testing.T) {
+		other := sha256.Sum256([]byte("other"))
+		_, err := NewExternal(path, hex.EncodeToString(other[:]))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "checksum mismatch")
+	})
+	t.Run("should reject an invalid checksum", func(t *testing.T) {
+		_, err := NewExternal(path, "abcd")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid sha256 checksum")
+	})
+	t.Run("should reject a binary that is not executable", func(t *testing.T) {
+		nonExecutable := filepath.Join(t.TempDir(), "celestia-appd")
+		require.NoError(t, os.WriteFile(nonExecutable, script, 0o644))
+		_, err := NewExternal(nonExecutable, hex.EncodeToString(checksum[:]))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "is not executable")
+	})
+	t.Run("should refuse to start a binary that was modified", func(t *testing.T) {
+		modified := filepath.Join(t.TempDir(), "celestia-appd")
+		require.NoError(t, os.WriteFile(modified, script, 0o755))
+		appdInstance, err := NewExternal(modified, hex.EncodeToString(checksum[:]))
+		require.NoError(t, err)
+
+		require.NoError(t, os.WriteFile(modified, []byte("#!/bin/sh\nsleep 10\n"), 0o755))
+		err = appdInstance.Start()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "checksum mismatch")
+		require.True(t, appdInstance.IsStopped())
+	})
+}
+
+func TestNewFromCache(t *testing.T) {
+	originalNodeHome := nodeHome
+	nodeHome = t.TempDir()
+	defer func() { nodeHome = originalNodeHome }()
+
+	script := []byte("#!/bin/sh\necho v1.0.0\n")
+	sum := sha256.Sum256(script)
+	checksum := hex.EncodeToString(sum[:])
+
+	_, err := NewFromCache(checksum)
+	require.Error(t, err, "binary is not in the cache yet")
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(CachePath(checksum)), 0o755))
+	require.NoError(t, os.WriteFile(CachePath(checksum), script, 0o755))
+
+	appdInstance, err := NewFromCache(checksum)
+	require.NoError(t, err)
+	require.Equal(t, CachePath(checksum), appdInstance.path)
+}
@@ -11,6 +11,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 	"syscall"
 	"time"
@@ -28,6 +29,23 @@ type Appd struct {
 	stdout io.Writer
 	// cmd is the started celestia-appd binary.
 	cmd *exec.Cmd
+	// compressedBinary is the archive the binary was decompressed from.
+	compressedBinary []byte
+	// gate releases a pre-started process into the start command. It is nil
+	// unless the process was pre-started and not released yet.
+	gate io.WriteCloser
+	// gateArgs are the arguments the process was pre-started with.
+	gateArgs []string
+	// checksum is the hex encoded SHA-256 checksum an external binary is
+	// pinned to. It is empty for embedded binaries.
+	checksum string
+	// done is closed once the started process has exited.
+	done chan struct{}
+	// exitErr is the error the started process exited with. It must only be
+	// read after done is closed.
+	exitErr error
+	// startedAt is the time at which the process was started.
+	startedAt time.Time
 }
 
 // New returns a new Appd instance.
@@ -46,15 +64,47 @@ func New(version string, compressedBinary []byte) (*Appd, error) {
 	}
 
 	appd := &Appd{
-		version: version,
-		path:    pathToBinary,
-		stdin:   os.Stdin,
-		stdout:  os.Stdout,
-		stderr:  os.Stderr,
+		version:          version,
+		path:             pathToBinary,
+		compressedBinary: compressedBinary,
+		stdin:            os.Stdin,
+		stdout:           os.Stdout,
+		stderr:           os.Stderr,
 	}
 	return appd, nil
 }
 
+// Version returns the version of the celestia-appd binary.
+func (a *Appd) Version() string {
+	return a.version
+}
+
+// Prepare makes sure the binary can be started without delay. It decompresses
+// the binary again if it was removed since New, verifies it and checks that it
+// can be executed by running its version command.
+func (a *Appd) Prepare(ctx context.Context) error {
+	if len(a.compressedBinary) > 0 {
+		if err := ensureBinaryDecompressed(a.version, a.compressedBinary); err != nil {
+			return fmt.Errorf("failed to decompress binary: %w", err)
+		}
+		pathToBinary, err := getPathToBinary(a.version)
+		if err != nil {
+			return fmt.Errorf("failed to get path to binary: %w", err)
+		}
+		a.path = pathToBinary
+	}
+	if err := a.Verify(); err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, a.path, "version")
+	cmd.Env = a.getEnv()
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to run %s version: %w: %s", a.path, err, output)
+	}
+	return nil
+}
+
 // telemetryDisableEnv returns environment variables that disable the
 // Prometheus telemetry sink in the child process. This prevents
 // "duplicate metrics collector registration attempted" errors.
@@ -75,13 +125,90 @@ func (a *Appd) getEnv() []string {
 	return append(os.Environ(), a.telemetryDisableEnv()...)
 }
 
-// Start starts the appd binary with the given arguments.
+// Start starts the appd binary with the given arguments. A process that was
+// pre-started with the same arguments is released instead.
 func (a *Appd) Start(args ...string) error {
+	if a.gate != nil {
+		if a.IsRunning() && slices.Equal(a.gateArgs, args) {
+			return a.release()
+		}
+		// the pre-started process can't be used for these arguments.
+		if err := a.Stop(); err != nil {
+			return fmt.Errorf("failed to stop pre-started %s: %w", a.path, err)
+		}
+	}
+	if a.checksum != "" {
+		if err := a.Verify(); err != nil {
+			return fmt.Errorf("failed to start %s: %w", a.path, err)
+		}
+	}
+
 	cmd := exec.Command(a.path, append([]string{"start"}, args...)...)
+	cmd.Stdin = a.stdin
+	return a.start(cmd)
+}
+
+// Prestart starts a process for the appd binary that waits until Start is
+// called with the same arguments before it runs the start command, so that
+// the process exists ahead of time without opening the application database.
+func (a *Appd) Prestart(args ...string) error {
+	if a.IsRunning() {
+		return fmt.Errorf("%s is already running", a.path)
+	}
+	if a.checksum != "" {
+		if err := a.Verify(); err != nil {
+			return fmt.Errorf("failed to pre-start %s: %w", a.path, err)
+		}
+	}
+
+	// the shell waits for a line on the gate and then replaces itself with the
+	// binary so that the process keeps its PID.
+	script := `read -r _ || exit 1; exec "$0" start "$@"`
+	cmd := exec.Command("/bin/sh", append([]string{"-c", script, a.path}, args...)...)
+	gate, err := cmd.StdinPipe()
+	if err != nil {
+		return fmt.Errorf("failed to create gate for %s: %w", a.path, err)
+	}
+	if err := a.start(cmd); err != nil {
+		gate.Close()
+		return err
+	}
+	a.gate = gate
+	a.gateArgs = args
+	return nil
+}
+
+// IsPrestarted returns true if a pre-started process is waiting to be
+// released by Start.
+func (a *Appd) IsPrestarted() bool {
+	return a.gate != nil && a.IsRunning()
+}
+
+// release lets the pre-started process run the start command.
+func (a *Appd) release() error {
+	_, err := io.WriteString(a.gate, "\n")
+	a.closeGate()
+	if err != nil {
+		return fmt.Errorf("failed to release pre-started %s: %w", a.path, err)
+	}
+	a.startedAt = time.Now()
+	return nil
+}
+
+func (a *Appd) closeGate() {
+	if a.gate == nil {
+		return
+	}
+	a.gate.Close()
+	a.gate = nil
+	a.gateArgs = nil
+}
+
+// start starts cmd as the process of the appd binary.
+func (a *Appd) start(cmd *exec.Cmd) error {
 	cmd.Env = a.getEnv()
 
 	// Set up I/O
-	cmd.Stdin = a.stdin
 	cmd.Stdout = a.stdout
 	cmd.Stderr = a.stderr
 
@@ -97,6 +224,16 @@ func (a *Appd) Start(args ...string) error {
 		return fmt.Errorf("failed to start %s: %w", a.path, err)
 	}
 	a.cmd = cmd
+	a.startedAt = time.Now()
+
+	// wait for the process in the background so that an exit is noticed even
+	// if the process was not stopped via Stop.
+	done := make(chan struct{})
+	a.done = done
+	go func() {
+		a.exitErr = cmd.Wait()
+		close(done)
+	}()
 	return nil
 }
 
@@ -106,30 +243,71 @@ func (a *Appd) IsRunning() bool {
 
 func (a *Appd) IsStopped() bool {
 	// Never started or failed to start
-	if a.cmd == nil || a.cmd.Process == nil {
+	if a.cmd == nil || a.cmd.Process == nil || a.done == nil {
 		return true
 	}
 
-	// If ProcessState is not nil, it means Wait() was called and the process has finished
-	// (either by exiting normally or being terminated by a signal)
-	if a.cmd.ProcessState != nil {
+	// done is closed once the process has finished (either by exiting
+	// normally or being terminated by a signal)
+	select {
+	case <-a.done:
 		return true
+	default:
+		return false
 	}
+}
 
-	// ProcessState is nil, which means the process is still running
-	return false
+// Done returns a channel that is closed once the started process has exited.
+// It returns nil if the process was never started.
+func (a *Appd) Done() <-chan struct{} {
+	return a.done
+}
+
+// ExitErr returns the error the process exited with. It returns nil if the
+// process exited successfully or is still running.
+func (a *Appd) ExitErr() error {
+	if !a.IsStopped() {
+		return nil
+	}
+	return a.exitErr
+}
+
+// PID returns the process ID of the started process or 0 if it was never
+// started.
+func (a *Appd) PID() int {
+	if a.cmd == nil || a.cmd.Process == nil {
+		return 0
+	}
+	return a.cmd.Process.Pid
+}
+
+// StartedAt returns the time at which the process was last started.
+func (a *Appd) StartedAt() time.Time {
+	return a.startedAt
+}
+
+// SetOutput sets the writers that the standard output and standard error of
+// the process are written to the next time it is started.
+func (a *Appd) SetOutput(stdout, stderr io.Writer) {
+	a.stdout = stdout
+	a.stderr = stderr
 }
 
 // Stop interrupts and then kills the running appd process if it exists and
 // waits for it to fully exit. If the process is not running, it returns nil.
 // The method will wait up to 6 seconds for graceful shutdown before force killing.
 func (a *Appd) Stop() error {
+	// a pre-started process exits once its gate is closed.
+	a.closeGate()
 	if a.cmd == nil {
 		return nil
 	}
 	if a.cmd.Process == nil {
 		return nil
 	}
+	if a.IsStopped() {
+		return nil
+	}
 
 	err := a.cmd.Process.Signal(os.Interrupt)
 	if err != nil {
@@ -138,8 +316,9 @@ func (a *Appd) Stop() error {
 			return fmt.Errorf("failed to kill process with PID %d: %w", a.cmd.Process.Pid, err)
 		}
 
-		if err := a.cmd.Wait(); err != nil {
-			log.Printf("Process finished with error: %v\n", err)
+		<-a.done
+		if a.exitErr != nil {
+			log.Printf("Process finished with error: %v\n", a.exitErr)
 		}
 		return nil
 	}
@@ -147,15 +326,10 @@ func (a *Appd) Stop() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 6*time.Second)
 	defer cancel()
 
-	done := make(chan error, 1)
-	go func() {
-		done <- a.cmd.Wait()
-	}()
-
 	select {
-	case err := <-done:
-		if err != nil {
-			log.Printf("Process finished with error: %v\n", err)
+	case <-a.done:
+		if a.exitErr != nil {
+			log.Printf("Process finished with error: %v\n", a.exitErr)
 		} else {
 			log.Printf("Process finished with no error\n")
 		}
@@ -166,8 +340,9 @@ func (a *Appd) Stop() error {
 			return fmt.Errorf("failed to kill process with PID %d after timeout: %w", a.cmd.Process.Pid, err)
 		}
 
-		if err := <-done; err != nil {
-			log.Printf("Process finished with error after force kill: %v\n", err)
+		<-a.done
+		if a.exitErr != nil {
+			log.Printf("Process finished with error after force kill: %v\n", a.exitErr)
 		} else {
 			log.Printf("Process finished after force kill\n")
 		}
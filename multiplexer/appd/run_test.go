@@ -4,10 +4,13 @@ package appd
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v10/internal/embedding"
 	"github.com/stretchr/testify/assert"
@@ -137,6 +140,74 @@ func TestStop(t *testing.T) {
 	})
 }
 
+func TestPrepare(t *testing.T) {
+	t.Run("should succeed if the binary can be executed", func(t *testing.T) {
+		mockBinary := createMockExecutable(t, "echo v1.0.0")
+		defer os.Remove(mockBinary) // Cleanup after test
+
+		appdInstance := &Appd{path: mockBinary}
+		require.NoError(t, appdInstance.Prepare(context.Background()))
+		require.True(t, appdInstance.IsStopped())
+	})
+	t.Run("should return an error if the binary fails", func(t *testing.T) {
+		mockBinary := createMockExecutable(t, "exit 1")
+		defer os.Remove(mockBinary) // Cleanup after test
+
+		appdInstance := &Appd{path: mockBinary}
+		err := appdInstance.Prepare(context.Background())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to run")
+	})
+}
+
+func TestPrestart(t *testing.T) {
+	t.Run("should run the start command once it is released", func(t *testing.T) {
+		marker := filepath.Join(t.TempDir(), "started")
+		mockBinary := createMockExecutable(t, fmt.Sprintf(`echo "$@" > %s; sleep 10`, marker))
+		defer os.Remove(mockBinary) // Cleanup after test
+
+		appdInstance := &Appd{path: mockBinary, stdout: os.Stdout, stderr: os.Stderr}
+		require.NoError(t, appdInstance.Prestart("--home", "node"))
+		defer func() { require.NoError(t, appdInstance.Stop()) }()
+		require.True(t, appdInstance.IsPrestarted())
+		pid := appdInstance.PID()
+
+		time.Sleep(200 * time.Millisecond)
+		require.NoFileExists(t, marker)
+
+		require.NoError(t, appdInstance.Start("--home", "node"))
+		require.False(t, appdInstance.IsPrestarted())
+		require.Equal(t, pid, appdInstance.PID())
+		require.Eventually(t, func() bool {
+			args, err := os.ReadFile(marker)
+			return err == nil && string(args) == "start --home node\n"
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+	t.Run("should replace the process if the arguments differ", func(t *testing.T) {
+		mockBinary := createMockExecutable(t, "sleep 10")
+		defer os.Remove(mockBinary) // Cleanup after test
+
+		appdInstance := &Appd{path: mockBinary, stdout: os.Stdout, stderr: os.Stderr}
+		require.NoError(t, appdInstance.Prestart("--home", "node"))
+		pid := appdInstance.PID()
+
+		require.NoError(t, appdInstance.Start("--home", "other"))
+		defer func() { require.NoError(t, appdInstance.Stop()) }()
+		require.True(t, appdInstance.IsRunning())
+		require.NotEqual(t, pid, appdInstance.PID())
+	})
+	t.Run("should exit when stopped before it is released", func(t *testing.T) {
+		mockBinary := createMockExecutable(t, "sleep 10")
+		defer os.Remove(mockBinary) // Cleanup after test
+
+		appdInstance := &Appd{path: mockBinary, stdout: os.Stdout, stderr: os.Stderr}
+		require.NoError(t, appdInstance.Prestart())
+		require.NoError(t, appdInstance.Stop())
+		require.True(t, appdInstance.IsStopped())
+		require.False(t, appdInstance.IsPrestarted())
+	})
+}
+
 // createMockExecutable creates a temporary mock binary that can be executed in tests.
 func createMockExecutable(t *testing.T, bashCommand string) string {
 	t.Helper()
@@ -0,0 +1,287 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/celestiaorg/celestia-app/v10/multiplexer/abci"
+	"github.com/celestiaorg/celestia-app/v10/multiplexer/internal"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cobra"
+)
+
+const flagIgnoreFields = "ignore-fields"
+
+// NewReplayCmd creates a command that feeds a recording of ABCI calls to the
+// apps of the multiplexer and diffs their responses against the recorded ones.
+func NewReplayCmd(versions abci.Versions, appCreator types.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay [recording]",
+		Short: "Replay a recording of ABCI calls and diff the responses",
+		Long: `Replay a recording of ABCI calls through the app version (embedded or native)
+that handled them and diff the responses against the recorded ones.
+
+Recordings are written by a node with multiplexer.record-file set in app.toml.
+Calls are replayed against the application state in --replay-home which must
+be the state the node had when the recording started. Leave it empty if the
+recording starts at genesis.`,
+		Example: `multiplexer replay abci.rec --replay-home /tmp/replay --ignore-fields log,info`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			svrCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			ignoreFields, err := cmd.Flags().GetStringSlice(flagIgnoreFields)
+			if err != nil {
+				return err
+			}
+			replayHome, err := cmd.Flags().GetString(flagReplayHome)
+			if err != nil {
+				return err
+			}
+			if replayHome == "" {
+				replayHome, err = os.MkdirTemp("", "multiplexer-replay-*")
+				if err != nil {
+					return fmt.Errorf("failed to create replay home: %w", err)
+				}
+				defer os.RemoveAll(replayHome)
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to open recording: %w", err)
+			}
+			defer file.Close()
+
+			return replay(cmd.Context(), cmd.OutOrStdout(), versions, svrCtx, clientCtx, appCreator, replayHome, file, ignoreFields)
+		},
+	}
+
+	cmd.Flags().String(flagReplayHome, "", "Home directory with the application state at the start of the recording (defaults to an empty temporary directory)")
+	cmd.Flags().StringSlice(flagIgnoreFields, nil, "Response fields to ignore when diffing, e.g. log,info")
+	return cmd
+}
+
+// replay feeds the calls of the recording in r to a multiplexer and writes the
+// differences between the recorded and the replayed responses to out.
+func replay(ctx context.Context, out io.Writer, versions abci.Versions, svrCtx *server.Context, clientCtx client.Context, appCreator types.AppCreator, replayHome string, r io.Reader, ignoreFields []string) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	recording, err := abci.NewRecordingReader(r)
+	if err != nil {
+		return err
+	}
+	call, err := recording.Next()
+	if errors.Is(err, io.EOF) {
+		return fmt.Errorf("recording is empty")
+	} else if err != nil {
+		return err
+	}
+
+	genDoc, err := internal.GetGenDocProvider(svrCtx.Config)()
+	if err != nil {
+		return fmt.Errorf("failed to load genesis: %w", err)
+	}
+
+	multiplexer, err := newReplayMultiplexer(svrCtx, clientCtx, appCreator, versions, replayHome, genDoc.ChainID, call.AppVersion)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := multiplexer.Stop(); err != nil {
+			svrCtx.Logger.Error("failed to stop multiplexer", "err", err)
+		}
+	}()
+
+	ignore := make(map[string]bool, len(ignoreFields))
+	for _, field := range ignoreFields {
+		ignore[strings.ToLower(field)] = true
+	}
+
+	var calls, differing int
+	for ; err == nil; call, err = recording.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		calls++
+
+		resp, replayErr := replayCall(ctx, multiplexer, call.Request)
+		if replayErr != nil {
+			resp = abcitypes.ToResponseException(replayErr.Error())
+		}
+
+		diffs, diffErr := diffResponses(call.Response, resp, ignore)
+		if diffErr != nil {
+			return fmt.Errorf("failed to diff responses of call %d: %w", calls, diffErr)
+		}
+		if len(diffs) == 0 {
+			continue
+		}
+		differing++
+		fmt.Fprintf(out, "call %d (%s, app version %d):\n", calls, requestName(call.Request), call.AppVersion)
+		for _, diff := range diffs {
+			fmt.Fprintf(out, "  %s\n", diff)
+		}
+	}
+	if !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to read call %d: %w", calls+1, err)
+	}
+
+	if differing > 0 {
+		return fmt.Errorf("responses of %d of %d calls differ", differing, calls)
+	}
+	fmt.Fprintf(out, "replayed %d calls without differences\n", calls)
+	return nil
+}
+
+// replayCall sends req to the multiplexer and returns the response.
+func replayCall(ctx context.Context, m *abci.Multiplexer, req *abcitypes.Request) (*abcitypes.Response, error) {
+	switch r := req.Value.(type) {
+	case *abcitypes.Request_Info:
+		resp, err := m.Info(ctx, r.Info)
+		return abcitypes.ToResponseInfo(resp), err
+	case *abcitypes.Request_Query:
+		resp, err := m.Query(ctx, r.Query)
+		return abcitypes.ToResponseQuery(resp), err
+	case *abcitypes.Request_CheckTx:
+		resp, err := m.CheckTx(ctx, r.CheckTx)
+		return abcitypes.ToResponseCheckTx(resp), err
+	case *abcitypes.Request_InitChain:
+		resp, err := m.InitChain(ctx, r.InitChain)
+		return abcitypes.ToResponseInitChain(resp), err
+	case *abcitypes.Request_PrepareProposal:
+		resp, err := m.PrepareProposal(ctx, r.PrepareProposal)
+		return abcitypes.ToResponsePrepareProposal(resp), err
+	case *abcitypes.Request_ProcessProposal:
+		resp, err := m.ProcessProposal(ctx, r.ProcessProposal)
+		return abcitypes.ToResponseProcessProposal(resp), err
+	case *abcitypes.Request_FinalizeBlock:
+		resp, err := m.FinalizeBlock(ctx, r.FinalizeBlock)
+		return abcitypes.ToResponseFinalizeBlock(resp), err
+	case *abcitypes.Request_ExtendVote:
+		resp, err := m.ExtendVote(ctx, r.ExtendVote)
+		return abcitypes.ToResponseExtendVote(resp), err
+	case *abcitypes.Request_VerifyVoteExtension:
+		resp, err := m.VerifyVoteExtension(ctx, r.VerifyVoteExtension)
+		return abcitypes.ToResponseVerifyVoteExtension(resp), err
+	case *abcitypes.Request_Commit:
+		resp, err := m.Commit(ctx, r.Commit)
+		return abcitypes.ToResponseCommit(resp), err
+	case *abcitypes.Request_ListSnapshots:
+		resp, err := m.ListSnapshots(ctx, r.ListSnapshots)
+		return abcitypes.ToResponseListSnapshots(resp), err
+	case *abcitypes.Request_OfferSnapshot:
+		resp, err := m.OfferSnapshot(ctx, r.OfferSnapshot)
+		return abcitypes.ToResponseOfferSnapshot(resp), err
+	case *abcitypes.Request_LoadSnapshotChunk:
+		resp, err := m.LoadSnapshotChunk(ctx, r.LoadSnapshotChunk)
+		return abcitypes.ToResponseLoadSnapshotChunk(resp), err
+	case *abcitypes.Request_ApplySnapshotChunk:
+		resp, err := m.ApplySnapshotChunk(ctx, r.ApplySnapshotChunk)
+		return abcitypes.ToResponseApplySnapshotChunk(resp), err
+	default:
+		return nil, fmt.Errorf("unsupported request %T", req.Value)
+	}
+}
+
+// requestName returns the name of the ABCI method of req, e.g. FinalizeBlock.
+func requestName(req *abcitypes.Request) string {
+	return strings.TrimPrefix(reflect.TypeOf(req.Value).Elem().Name(), "Request_")
+}
+
+// diffResponses returns the differences between the expected and the actual
+// response as "path: expected != actual". Fields named in ignore are skipped
+// at any depth.
+func diffResponses(expected, got *abcitypes.Response, ignore map[string]bool) ([]string, error) {
+	expectedValue, err := toJSONValue(expected)
+	if err != nil {
+		return nil, err
+	}
+	gotValue, err := toJSONValue(got)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []string
+	diffJSON("", expectedValue, gotValue, ignore, &diffs)
+	return diffs, nil
+}
+
+// toJSONValue returns the oneof value of resp decoded from its JSON encoding
+// so that paths start at the method, e.g. finalize_block.app_hash.
+func toJSONValue(resp *abcitypes.Response) (any, error) {
+	bz, err := json.Marshal(resp.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	if err := json.Unmarshal(bz, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func diffJSON(path string, expected, got any, ignore map[string]bool, diffs *[]string) {
+	expectedMap, expectedIsMap := expected.(map[string]any)
+	gotMap, gotIsMap := got.(map[string]any)
+	if expectedIsMap && gotIsMap {
+		keys := make(map[string]struct{}, len(expectedMap)+len(gotMap))
+		for key := range expectedMap {
+			keys[key] = struct{}{}
+		}
+		for key := range gotMap {
+			keys[key] = struct{}{}
+		}
+		sorted := make([]string, 0, len(keys))
+		for key := range keys {
+			if !ignore[strings.ToLower(key)] {
+				sorted = append(sorted, key)
+			}
+		}
+		sort.Strings(sorted)
+		for _, key := range sorted {
+			diffJSON(joinPath(path, key), expectedMap[key], gotMap[key], ignore, diffs)
+		}
+		return
+	}
+
+	expectedSlice, expectedIsSlice := expected.([]any)
+	gotSlice, gotIsSlice := got.([]any)
+	if expectedIsSlice && gotIsSlice && len(expectedSlice) == len(gotSlice) {
+		for i := range expectedSlice {
+			diffJSON(fmt.Sprintf("%s[%d]", path, i), expectedSlice[i], gotSlice[i], ignore, diffs)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(expected, got) {
+		*diffs = append(*diffs, fmt.Sprintf("%s: %s != %s", path, jsonString(expected), jsonString(got)))
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func jsonString(value any) string {
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(bz)
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffResponses(t *testing.T) {
+	newResponse := func() *abcitypes.Response {
+		return abcitypes.ToResponseFinalizeBlock(&abcitypes.ResponseFinalizeBlock{
+			TxResults: []*abcitypes.ExecTxResult{
+				{Code: abcitypes.CodeTypeOK, GasWanted: 100, GasUsed: 80, Log: "ok"},
+				{Code: 11, GasWanted: 100, GasUsed: 100, Log: "out of gas"},
+			},
+			AppHash: []byte("app hash"),
+		})
+	}
+
+	t.Run("should not report equal responses", func(t *testing.T) {
+		diffs, err := diffResponses(newResponse(), newResponse(), nil)
+		require.NoError(t, err)
+		require.Empty(t, diffs)
+	})
+	t.Run("should report the path of a different field", func(t *testing.T) {
+		got := newResponse()
+		got.GetFinalizeBlock().TxResults[1].Code = abcitypes.CodeTypeOK
+		diffs, err := diffResponses(newResponse(), got, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"finalize_block.tx_results[1].code: 11 != null"}, diffs)
+	})
+	t.Run("should skip ignored fields", func(t *testing.T) {
+		got := newResponse()
+		got.GetFinalizeBlock().TxResults[0].Log = "different"
+		diffs, err := diffResponses(newResponse(), got, map[string]bool{"log": true})
+		require.NoError(t, err)
+		require.Empty(t, diffs)
+	})
+	t.Run("should report a different number of results", func(t *testing.T) {
+		got := newResponse()
+		got.GetFinalizeBlock().TxResults = got.GetFinalizeBlock().TxResults[:1]
+		diffs, err := diffResponses(newResponse(), got, nil)
+		require.NoError(t, err)
+		require.Len(t, diffs, 1)
+		require.Contains(t, diffs[0], "finalize_block.tx_results: ")
+	})
+	t.Run("should report an error instead of a response", func(t *testing.T) {
+		diffs, err := diffResponses(newResponse(), abcitypes.ToResponseException("failed"), nil)
+		require.NoError(t, err)
+		require.Len(t, diffs, 2)
+		require.Contains(t, diffs[0], "exception: null != ")
+		require.Contains(t, diffs[1], "finalize_block: ")
+	})
+}
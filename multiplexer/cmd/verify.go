@@ -0,0 +1,393 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/celestiaorg/celestia-app/v10/multiplexer/abci"
+	"github.com/celestiaorg/celestia-app/v10/multiplexer/internal"
+	dbm "github.com/cometbft/cometbft-db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagFrom       = "from"
+	flagTo         = "to"
+	flagReplayHome = "replay-home"
+)
+
+// NewMultiplexerCmd creates a command that groups the multiplexer tools.
+func NewMultiplexerCmd(versions abci.Versions, appCreator types.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multiplexer",
+		Short: "Tools to inspect and replay the history of a node across app versions",
+	}
+
+	cmd.AddCommand(
+		NewVerifyCmd(versions, appCreator),
+		NewReplayCmd(versions, appCreator),
+	)
+	return cmd
+}
+
+// NewVerifyCmd creates a command that replays historical blocks through the
+// app version that executed them and compares the results with the ones the
+// node stored.
+func NewVerifyCmd(versions abci.Versions, appCreator types.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Replay blocks from the block store and verify their results",
+		Long: `Replay blocks from the block store of a stopped node through the app version
+(embedded or native) that executed them, without running consensus. The app hash
+and the results of every block are compared against the state the node stored.
+
+Blocks are executed against the application state in --replay-home which must
+be at height --from - 1. Copy the application state of the node at that height
+into it, or leave it empty to replay from genesis.`,
+		Example: `multiplexer verify --from 1 --to 1000
+multiplexer verify --from 2000001 --to 2000100 --replay-home /tmp/replay`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			svrCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			from, err := cmd.Flags().GetInt64(flagFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetInt64(flagTo)
+			if err != nil {
+				return err
+			}
+			replayHome, err := cmd.Flags().GetString(flagReplayHome)
+			if err != nil {
+				return err
+			}
+			if replayHome == "" {
+				replayHome, err = os.MkdirTemp("", "multiplexer-verify-*")
+				if err != nil {
+					return fmt.Errorf("failed to create replay home: %w", err)
+				}
+				defer os.RemoveAll(replayHome)
+			}
+
+			return verify(cmd.Context(), cmd.OutOrStdout(), versions, svrCtx, clientCtx, appCreator, replayHome, from, to)
+		},
+	}
+
+	cmd.Flags().Int64(flagFrom, 1, "First height to replay")
+	cmd.Flags().Int64(flagTo, 0, "Last height to replay (defaults to the height of the block store)")
+	cmd.Flags().String(flagReplayHome, "", "Home directory with the application state at height --from - 1 (defaults to an empty temporary directory)")
+	return cmd
+}
+
+// mismatch describes a difference between the result of replaying a block and
+// the result the node stored.
+type mismatch struct {
+	height   int64
+	field    string
+	expected string
+	got      string
+}
+
+func (m mismatch) String() string {
+	return fmt.Sprintf("height %d: %s mismatch: expected %s, got %s", m.height, m.field, m.expected, m.got)
+}
+
+// verify replays the blocks from to to of the node in svrCtx through the
+// multiplexer and writes all mismatches to out.
+func verify(ctx context.Context, out io.Writer, versions abci.Versions, svrCtx *server.Context, clientCtx client.Context, appCreator types.AppCreator, replayHome string, from, to int64) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	cfg := svrCtx.Config
+
+	blockDB, err := dbm.NewDB("blockstore", dbm.BackendType(cfg.DBBackend), cfg.DBDir())
+	if err != nil {
+		return fmt.Errorf("failed to open block store: %w", err)
+	}
+	defer blockDB.Close()
+	blockStore := store.NewBlockStore(blockDB)
+
+	stateDB, err := openDBM(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to open state store: %w", err)
+	}
+	defer stateDB.Close()
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{DiscardABCIResponses: false})
+
+	if to == 0 {
+		to = blockStore.Height()
+	}
+	if from < blockStore.Base() || to > blockStore.Height() || from > to {
+		return fmt.Errorf("invalid range %d to %d: the block store contains heights %d to %d", from, to, blockStore.Base(), blockStore.Height())
+	}
+
+	genDoc, err := internal.GetGenDocProvider(cfg)()
+	if err != nil {
+		return fmt.Errorf("failed to load genesis: %w", err)
+	}
+
+	first := blockStore.LoadBlock(from)
+	if first == nil {
+		return fmt.Errorf("block %d not found", from)
+	}
+
+	multiplexer, err := newReplayMultiplexer(svrCtx, clientCtx, appCreator, versions, replayHome, genDoc.ChainID, first.Version.App)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := multiplexer.Stop(); err != nil {
+			svrCtx.Logger.Error("failed to stop multiplexer", "err", err)
+		}
+	}()
+
+	if err := prepareReplay(ctx, multiplexer, genDoc, from); err != nil {
+		return err
+	}
+
+	var mismatches int
+	for height := from; height <= to; height++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		found, err := replayBlock(ctx, multiplexer, blockStore, stateStore, genDoc.InitialHeight, height)
+		if err != nil {
+			return fmt.Errorf("failed to replay block %d: %w", height, err)
+		}
+		for _, m := range found {
+			fmt.Fprintln(out, m)
+		}
+		mismatches += len(found)
+	}
+
+	if mismatches > 0 {
+		return fmt.Errorf("found %d mismatches replaying heights %d to %d", mismatches, from, to)
+	}
+	fmt.Fprintf(out, "verified heights %d to %d\n", from, to)
+	return nil
+}
+
+// newReplayMultiplexer returns a multiplexer that executes blocks against the
+// application state in replayHome.
+func newReplayMultiplexer(svrCtx *server.Context, clientCtx client.Context, appCreator types.AppCreator, versions abci.Versions, replayHome, chainID string, appVersion uint64) (*abci.Multiplexer, error) {
+	if err := os.MkdirAll(filepath.Join(replayHome, "config"), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create replay home: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Join(replayHome, "data"), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create replay home: %w", err)
+	}
+	genesis, err := os.ReadFile(svrCtx.Config.GenesisFile())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read genesis: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(replayHome, "config", "genesis.json"), genesis, 0o644); err != nil {
+		return nil, fmt.Errorf("failed to copy genesis: %w", err)
+	}
+
+	address := svrCtx.Viper.GetString("proxy_app")
+	replayCtx := server.NewDefaultContext()
+	replayCtx.Logger = svrCtx.Logger
+	replayCtx.Config.SetRoot(replayHome)
+	replayCtx.Viper.Set(flags.FlagHome, replayHome)
+	replayCtx.Viper.Set("address", address)
+	replayCtx.Viper.Set("proxy_app", address)
+
+	// the gRPC and API servers stay disabled while replaying.
+	multiplexer, err := abci.NewMultiplexer(replayCtx, serverconfig.Config{}, clientCtx, appCreator, versions, chainID, appVersion)
+	if err != nil {
+		return nil, err
+	}
+	multiplexer.SetProgramArgs([]string{"--home", replayHome, "--address", address})
+
+	if err := multiplexer.StartApp(); err != nil {
+		return nil, fmt.Errorf("failed to start app: %w", err)
+	}
+	return multiplexer, nil
+}
+
+// prepareReplay makes sure that the application state is at height from - 1.
+// An empty application state is initialized from genesis.
+func prepareReplay(ctx context.Context, multiplexer *abci.Multiplexer, genDoc *cmttypes.GenesisDoc, from int64) error {
+	info, err := multiplexer.Info(ctx, &abcitypes.RequestInfo{})
+	if err != nil {
+		return fmt.Errorf("failed to get app info: %w", err)
+	}
+
+	if info.LastBlockHeight == 0 && from == genDoc.InitialHeight {
+		validators := make([]*cmttypes.Validator, len(genDoc.Validators))
+		for i, val := range genDoc.Validators {
+			validators[i] = cmttypes.NewValidator(val.PubKey, val.Power)
+		}
+		consensusParams := genDoc.ConsensusParams.ToProto()
+		_, err := multiplexer.InitChain(ctx, &abcitypes.RequestInitChain{
+			ChainId:         genDoc.ChainID,
+			Time:            genDoc.GenesisTime,
+			ConsensusParams: &consensusParams,
+			Validators:      cmttypes.TM2PB.ValidatorUpdates(cmttypes.NewValidatorSet(validators)),
+			AppStateBytes:   genDoc.AppState,
+			InitialHeight:   genDoc.InitialHeight,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to init chain: %w", err)
+		}
+		return nil
+	}
+
+	if info.LastBlockHeight != from-1 {
+		return fmt.Errorf("application state in the replay home is at height %d but replaying from height %d requires height %d", info.LastBlockHeight, from, from-1)
+	}
+	return nil
+}
+
+// replayBlock executes and commits the block at height and returns how its
+// results differ from the ones the node stored.
+func replayBlock(ctx context.Context, multiplexer *abci.Multiplexer, blockStore *store.BlockStore, stateStore sm.Store, initialHeight, height int64) ([]mismatch, error) {
+	block := blockStore.LoadBlock(height)
+	if block == nil {
+		return nil, fmt.Errorf("block not found")
+	}
+
+	var commitInfo abcitypes.CommitInfo
+	if height > initialHeight {
+		lastValidators, err := stateStore.LoadValidators(height - 1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load validators: %w", err)
+		}
+		commitInfo = sm.BuildLastCommitInfo(block, lastValidators, initialHeight)
+	}
+
+	resp, err := multiplexer.FinalizeBlock(ctx, &abcitypes.RequestFinalizeBlock{
+		Txs:                block.Txs.ToSliceOfBytes(),
+		DecidedLastCommit:  commitInfo,
+		Misbehavior:        block.Evidence.Evidence.ToABCI(),
+		Hash:               block.Hash(),
+		Height:             block.Height,
+		Time:               block.Time,
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+		Header:             block.Header.ToProto(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := multiplexer.Commit(ctx, &abcitypes.RequestCommit{}); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	// the node only stores results if it doesn't discard them.
+	stored, err := stateStore.LoadFinalizeBlockResponse(height)
+	if err != nil {
+		stored = nil
+	}
+
+	var next *cmttypes.Header
+	if nextBlock := blockStore.LoadBlock(height + 1); nextBlock != nil {
+		next = &nextBlock.Header
+	}
+
+	return compareFinalizeBlock(height, resp, stored, next), nil
+}
+
+// compareFinalizeBlock compares the response of replaying the block at height
+// with the response the node stored and with the header of the next block
+// which commits to the app hash and the results. Either may be nil if the
+// node doesn't have it. Only the deterministic fields of tx results are
+// compared.
+func compareFinalizeBlock(height int64, got, stored *abcitypes.ResponseFinalizeBlock, next *cmttypes.Header) []mismatch {
+	var mismatches []mismatch
+	add := func(field string, expected, got any) {
+		mismatches = append(mismatches, mismatch{height: height, field: field, expected: fmt.Sprint(expected), got: fmt.Sprint(got)})
+	}
+
+	if next != nil {
+		if !bytes.Equal(next.AppHash, got.AppHash) {
+			add("app hash", next.AppHash, cmtbytes.HexBytes(got.AppHash))
+		}
+		if resultsHash := sm.TxResultsHash(got.TxResults); !bytes.Equal(next.LastResultsHash, resultsHash) {
+			add("results hash", next.LastResultsHash, cmtbytes.HexBytes(resultsHash))
+		}
+	} else if stored != nil && len(stored.AppHash) > 0 && !bytes.Equal(stored.AppHash, got.AppHash) {
+		add("app hash", cmtbytes.HexBytes(stored.AppHash), cmtbytes.HexBytes(got.AppHash))
+	}
+
+	if stored == nil {
+		return mismatches
+	}
+
+	if len(stored.TxResults) != len(got.TxResults) {
+		add("number of tx results", len(stored.TxResults), len(got.TxResults))
+	} else {
+		for i, expected := range stored.TxResults {
+			result := got.TxResults[i]
+			if expected.Code != result.Code {
+				add(fmt.Sprintf("tx %d code", i), expected.Code, result.Code)
+			}
+			if expected.GasWanted != result.GasWanted {
+				add(fmt.Sprintf("tx %d gas wanted", i), expected.GasWanted, result.GasWanted)
+			}
+			if expected.GasUsed != result.GasUsed {
+				add(fmt.Sprintf("tx %d gas used", i), expected.GasUsed, result.GasUsed)
+			}
+			if !bytes.Equal(expected.Data, result.Data) {
+				add(fmt.Sprintf("tx %d data", i), cmtbytes.HexBytes(expected.Data), cmtbytes.HexBytes(result.Data))
+			}
+		}
+	}
+
+	if !equalValidatorUpdates(stored.ValidatorUpdates, got.ValidatorUpdates) {
+		add("validator updates", stored.ValidatorUpdates, got.ValidatorUpdates)
+	}
+	if !equalConsensusParams(stored, got) {
+		add("consensus param updates", stored.ConsensusParamUpdates, got.ConsensusParamUpdates)
+	}
+	return mismatches
+}
+
+func equalValidatorUpdates(a, b []abcitypes.ValidatorUpdate) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !equalProto(&a[i], &b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func equalConsensusParams(a, b *abcitypes.ResponseFinalizeBlock) bool {
+	if a.ConsensusParamUpdates == nil || b.ConsensusParamUpdates == nil {
+		return a.ConsensusParamUpdates == nil && b.ConsensusParamUpdates == nil
+	}
+	return equalProto(a.ConsensusParamUpdates, b.ConsensusParamUpdates)
+}
+
+// equalProto returns true if a and b have the same encoding.
+func equalProto(a, b interface{ Marshal() ([]byte, error) }) bool {
+	abz, err := a.Marshal()
+	if err != nil {
+		return false
+	}
+	bbz, err := b.Marshal()
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(abz, bbz)
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sm "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareFinalizeBlock(t *testing.T) {
+	newResponse := func() *abcitypes.ResponseFinalizeBlock {
+		return &abcitypes.ResponseFinalizeBlock{
+			TxResults: []*abcitypes.ExecTxResult{
+				{Code: abcitypes.CodeTypeOK, GasWanted: 100, GasUsed: 80, Data: []byte("data"), Log: "ok"},
+				{Code: 11, GasWanted: 100, GasUsed: 100, Log: "out of gas"},
+			},
+			ConsensusParamUpdates: &cmtproto.ConsensusParams{Version: &cmtproto.VersionParams{App: 4}},
+			AppHash:               []byte("app hash"),
+		}
+	}
+	nextHeader := func(resp *abcitypes.ResponseFinalizeBlock) *cmttypes.Header {
+		return &cmttypes.Header{AppHash: resp.AppHash, LastResultsHash: sm.TxResultsHash(resp.TxResults)}
+	}
+
+	t.Run("should not report matching results", func(t *testing.T) {
+		stored := newResponse()
+		require.Empty(t, compareFinalizeBlock(10, newResponse(), stored, nextHeader(stored)))
+	})
+	t.Run("should ignore non deterministic fields", func(t *testing.T) {
+		stored := newResponse()
+		got := newResponse()
+		got.TxResults[1].Log = "out of gas in location: txSize"
+		require.Empty(t, compareFinalizeBlock(10, got, stored, nextHeader(stored)))
+	})
+	t.Run("should report a different app hash", func(t *testing.T) {
+		stored := newResponse()
+		got := newResponse()
+		got.AppHash = []byte("other app hash")
+
+		mismatches := compareFinalizeBlock(10, got, stored, nextHeader(stored))
+		require.Len(t, mismatches, 1)
+		require.Equal(t, "app hash", mismatches[0].field)
+		require.Equal(t, int64(10), mismatches[0].height)
+	})
+	t.Run("should compare the app hash with the stored response without a next block", func(t *testing.T) {
+		got := newResponse()
+		got.AppHash = []byte("other app hash")
+
+		mismatches := compareFinalizeBlock(10, got, newResponse(), nil)
+		require.Len(t, mismatches, 1)
+		require.Equal(t, "app hash", mismatches[0].field)
+	})
+	t.Run("should report different tx results", func(t *testing.T) {
+		stored := newResponse()
+		got := newResponse()
+		got.TxResults[0].GasUsed = 90
+		got.TxResults[1].Code = abcitypes.CodeTypeOK
+
+		mismatches := compareFinalizeBlock(10, got, stored, nil)
+		require.Len(t, mismatches, 2)
+		require.Equal(t, "tx 0 gas used", mismatches[0].field)
+		require.Equal(t, "tx 1 code", mismatches[1].field)
+
+		mismatches = compareFinalizeBlock(10, got, stored, nextHeader(stored))
+		require.Len(t, mismatches, 3)
+		require.Equal(t, "results hash", mismatches[0].field)
+	})
+	t.Run("should report different consensus param updates", func(t *testing.T) {
+		stored := newResponse()
+		got := newResponse()
+		got.ConsensusParamUpdates = nil
+
+		mismatches := compareFinalizeBlock(10, got, stored, nil)
+		require.Len(t, mismatches, 1)
+		require.Equal(t, "consensus param updates", mismatches[0].field)
+	})
+}
@@ -0,0 +1,297 @@
+// Package blockarchive implements a portable archive of blocks, the commits
+// for them and the shares of their original data square. Archives are written
+// by `celestia-appd blockstore export` before blocks are pruned and can be
+// imported into the block store of another node or read by archival indexers.
+//
+// An archive is a gzip stream that contains a magic string, a length-prefixed
+// JSON Header and one Entry per height in ascending order. An entry is the
+// length-prefixed protobuf encoding of the block and of the commit followed by
+// the number of shares and the length-prefixed shares. All lengths are
+// uvarints.
+package blockarchive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v10/pkg/da"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// magic is written at the start of every archive. The trailing digit is the
+// version of the format.
+var magic = []byte("CELESTIA-BLOCKARCHIVE-1")
+
+// maxMessageSize bounds the size of a single encoded block, commit or share
+// that is read from an archive.
+const maxMessageSize = 512 << 20
+
+// Header describes the contents of an archive.
+type Header struct {
+	ChainID    string `json:"chain_id"`
+	FromHeight int64  `json:"from_height"`
+	ToHeight   int64  `json:"to_height"`
+}
+
+// Entry is the archived data of a single height.
+type Entry struct {
+	Block *types.Block
+	// Commit is the commit for Block, i.e. the last commit of the next block
+	// or the commit the node has seen if there is no next block.
+	Commit *types.Commit
+	// Shares are the shares of the original data square of Block in row-major
+	// order.
+	Shares [][]byte
+}
+
+// NewEntry returns the entry of block and its commit. The shares are computed
+// from the transactions of the block and verified against its data hash.
+func NewEntry(block *types.Block, commit *types.Commit) (Entry, error) {
+	eds, err := da.ConstructEDS(block.Txs.ToSliceOfBytes(), block.Version.App, -1)
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to construct data square of block %d: %w", block.Height, err)
+	}
+	entry := Entry{Block: block, Commit: commit, Shares: eds.FlattenedODS()}
+	if err := entry.Validate(); err != nil {
+		return Entry{}, err
+	}
+	return entry, nil
+}
+
+// Validate checks that the commit is for the block and that the shares are
+// the ones the data hash of the block commits to.
+func (e Entry) Validate() error {
+	if e.Block == nil || e.Commit == nil {
+		return errors.New("entry is missing the block or the commit")
+	}
+	height := e.Block.Height
+	if e.Commit.Height != height {
+		return fmt.Errorf("commit height %d does not match block height %d", e.Commit.Height, height)
+	}
+	if !bytes.Equal(e.Commit.BlockID.Hash, e.Block.Hash()) {
+		return fmt.Errorf("commit of height %d is for block %X, not %X", height, e.Commit.BlockID.Hash, e.Block.Hash())
+	}
+
+	eds, err := da.ExtendShares(e.Shares)
+	if err != nil {
+		return fmt.Errorf("failed to extend shares of block %d: %w", height, err)
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return fmt.Errorf("failed to compute data availability header of block %d: %w", height, err)
+	}
+	if !bytes.Equal(dah.Hash(), e.Block.DataHash) {
+		return fmt.Errorf("shares of block %d do not match its data hash: expected %X, got %X", height, e.Block.DataHash, dah.Hash())
+	}
+	return nil
+}
+
+// VerifyCommit checks that vals is the validator set of the block and that
+// the commit is signed by more than two thirds of its voting power.
+func (e Entry) VerifyCommit(chainID string, vals *types.ValidatorSet) error {
+	height := e.Block.Height
+	if !bytes.Equal(vals.Hash(), e.Block.ValidatorsHash) {
+		return fmt.Errorf("validator set does not match the validators hash of block %d: expected %X, got %X", height, e.Block.ValidatorsHash, vals.Hash())
+	}
+	if err := types.VerifyCommitLight(chainID, vals, e.Commit.BlockID, height, e.Commit); err != nil {
+		return fmt.Errorf("invalid commit of block %d: %w", height, err)
+	}
+	return nil
+}
+
+// Follows checks that the block of the entry is the child of the block with
+// lastBlockID.
+func (e Entry) Follows(lastBlockID types.BlockID) error {
+	if !e.Block.LastBlockID.Equals(lastBlockID) {
+		return fmt.Errorf("block %d does not follow block %X: its last block is %X", e.Block.Height, lastBlockID.Hash, e.Block.LastBlockID.Hash)
+	}
+	return nil
+}
+
+// Writer writes an archive.
+type Writer struct {
+	gz *gzip.Writer
+	w  *bufio.Writer
+}
+
+// NewWriter writes the magic string and header to w and returns a writer for
+// the entries of the archive. Close must be called to complete the archive.
+func NewWriter(w io.Writer, header Header) (*Writer, error) {
+	gz := gzip.NewWriter(w)
+	aw := &Writer{gz: gz, w: bufio.NewWriter(gz)}
+	if _, err := aw.w.Write(magic); err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	if err := aw.writeBytes(bz); err != nil {
+		return nil, err
+	}
+	return aw, nil
+}
+
+// Write appends entry to the archive.
+func (w *Writer) Write(entry Entry) error {
+	block, err := entry.Block.ToProto()
+	if err != nil {
+		return fmt.Errorf("failed to convert block %d to protobuf: %w", entry.Block.Height, err)
+	}
+	bz, err := block.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal block %d: %w", entry.Block.Height, err)
+	}
+	if err := w.writeBytes(bz); err != nil {
+		return err
+	}
+
+	bz, err = entry.Commit.ToProto().Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal commit %d: %w", entry.Commit.Height, err)
+	}
+	if err := w.writeBytes(bz); err != nil {
+		return err
+	}
+
+	if err := w.writeUvarint(uint64(len(entry.Shares))); err != nil {
+		return err
+	}
+	for _, share := range entry.Shares {
+		if err := w.writeBytes(share); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close completes the archive. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	if err := w.w.Flush(); err != nil {
+		return err
+	}
+	return w.gz.Close()
+}
+
+func (w *Writer) writeUvarint(v uint64) error {
+	_, err := w.w.Write(binary.AppendUvarint(nil, v))
+	return err
+}
+
+func (w *Writer) writeBytes(bz []byte) error {
+	if err := w.writeUvarint(uint64(len(bz))); err != nil {
+		return err
+	}
+	_, err := w.w.Write(bz)
+	return err
+}
+
+// Reader reads an archive.
+type Reader struct {
+	r      *bufio.Reader
+	header Header
+}
+
+// NewReader reads the magic string and header from r and returns a reader for
+// the entries of the archive.
+func NewReader(r io.Reader) (*Reader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("not a block archive: %w", err)
+	}
+	ar := &Reader{r: bufio.NewReader(gz)}
+
+	got := make([]byte, len(magic))
+	if _, err := io.ReadFull(ar.r, got); err != nil || !bytes.Equal(got, magic) {
+		return nil, errors.New("not a block archive")
+	}
+	bz, err := ar.readBytes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive header: %w", truncated(err))
+	}
+	if err := json.Unmarshal(bz, &ar.header); err != nil {
+		return nil, fmt.Errorf("failed to decode archive header: %w", err)
+	}
+	return ar, nil
+}
+
+// Header returns the header of the archive.
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// Next returns the next entry of the archive or io.EOF at the end of it. The
+// entry is not validated.
+func (r *Reader) Next() (Entry, error) {
+	bz, err := r.readBytes()
+	if err != nil {
+		return Entry{}, err
+	}
+	var blockProto cmtproto.Block
+	if err := blockProto.Unmarshal(bz); err != nil {
+		return Entry{}, fmt.Errorf("failed to unmarshal block: %w", err)
+	}
+	block, err := types.BlockFromProto(&blockProto)
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to convert block from protobuf: %w", err)
+	}
+
+	bz, err = r.readBytes()
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to read commit of block %d: %w", block.Height, truncated(err))
+	}
+	var commitProto cmtproto.Commit
+	if err := commitProto.Unmarshal(bz); err != nil {
+		return Entry{}, fmt.Errorf("failed to unmarshal commit of block %d: %w", block.Height, err)
+	}
+	commit, err := types.CommitFromProto(&commitProto)
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to convert commit of block %d from protobuf: %w", block.Height, err)
+	}
+
+	count, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to read shares of block %d: %w", block.Height, truncated(err))
+	}
+	if count > appconsts.SquareSizeUpperBound*appconsts.SquareSizeUpperBound {
+		return Entry{}, fmt.Errorf("block %d has too many shares: %d", block.Height, count)
+	}
+	shares := make([][]byte, count)
+	for i := range shares {
+		if shares[i], err = r.readBytes(); err != nil {
+			return Entry{}, fmt.Errorf("failed to read shares of block %d: %w", block.Height, truncated(err))
+		}
+	}
+	return Entry{Block: block, Commit: commit, Shares: shares}, nil
+}
+
+func (r *Reader) readBytes() ([]byte, error) {
+	size, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxMessageSize {
+		return nil, fmt.Errorf("message of %d bytes exceeds the maximum of %d bytes", size, maxMessageSize)
+	}
+	bz := make([]byte, size)
+	if _, err := io.ReadFull(r.r, bz); err != nil {
+		return nil, truncated(err)
+	}
+	return bz, nil
+}
+
+// truncated turns an unexpected end of the archive into io.ErrUnexpectedEOF.
+func truncated(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
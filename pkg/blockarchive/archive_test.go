@@ -0,0 +1,193 @@
+package blockarchive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v10/pkg/da"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveRoundTrip(t *testing.T) {
+	blocks, commits, _ := newChain(t, 3)
+	header := Header{ChainID: "test-chain", FromHeight: 1, ToHeight: 3}
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, header)
+	require.NoError(t, err)
+	for i, block := range blocks {
+		entry, err := NewEntry(block, commits[i])
+		require.NoError(t, err)
+		require.NoError(t, w.Write(entry))
+	}
+	require.NoError(t, w.Close())
+
+	r, err := NewReader(&buf)
+	require.NoError(t, err)
+	require.Equal(t, header, r.Header())
+	for i, block := range blocks {
+		entry, err := r.Next()
+		require.NoError(t, err)
+		require.NoError(t, entry.Validate())
+		require.Equal(t, block.Height, entry.Block.Height)
+		require.Equal(t, block.Hash(), entry.Block.Hash())
+		require.Equal(t, commits[i].BlockID, entry.Commit.BlockID)
+		require.NotEmpty(t, entry.Shares)
+	}
+	_, err = r.Next()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestEntryValidate(t *testing.T) {
+	blocks, commits, _ := newChain(t, 2)
+
+	t.Run("should reject a commit for another block", func(t *testing.T) {
+		_, err := NewEntry(blocks[0], commits[1])
+		require.Error(t, err)
+	})
+	t.Run("should reject shares that do not match the data hash", func(t *testing.T) {
+		entry, err := NewEntry(blocks[0], commits[0])
+		require.NoError(t, err)
+		entry.Shares[0] = bytes.Repeat([]byte{0xff}, len(entry.Shares[0]))
+		require.ErrorContains(t, entry.Validate(), "do not match its data hash")
+	})
+}
+
+func TestEntryVerifyCommit(t *testing.T) {
+	blocks, commits, vals := newChain(t, 2)
+	entry, err := NewEntry(blocks[1], commits[1])
+	require.NoError(t, err)
+	require.NoError(t, entry.VerifyCommit("test-chain", vals))
+
+	t.Run("should reject a validator set that did not sign the block", func(t *testing.T) {
+		_, _, other := newChain(t, 1)
+		require.ErrorContains(t, entry.VerifyCommit("test-chain", other), "does not match the validators hash")
+	})
+	t.Run("should reject a commit with an invalid signature", func(t *testing.T) {
+		tampered := *entry.Commit
+		tampered.Signatures = []types.CommitSig{entry.Commit.Signatures[0]}
+		tampered.Signatures[0].Signature = bytes.Repeat([]byte{0xff}, len(tampered.Signatures[0].Signature))
+		tamperedEntry := entry
+		tamperedEntry.Commit = &tampered
+		require.ErrorContains(t, tamperedEntry.VerifyCommit("test-chain", vals), "invalid commit")
+	})
+	t.Run("should reject a commit for another chain", func(t *testing.T) {
+		require.ErrorContains(t, entry.VerifyCommit("other-chain", vals), "invalid commit")
+	})
+}
+
+func TestEntryFollows(t *testing.T) {
+	blocks, commits, _ := newChain(t, 3)
+	entry, err := NewEntry(blocks[1], commits[1])
+	require.NoError(t, err)
+	require.NoError(t, entry.Follows(commits[0].BlockID))
+	require.ErrorContains(t, entry.Follows(commits[2].BlockID), "does not follow")
+}
+
+func TestNewReader(t *testing.T) {
+	t.Run("should reject data that is not gzipped", func(t *testing.T) {
+		_, err := NewReader(bytes.NewReader([]byte("not an archive")))
+		require.Error(t, err)
+	})
+	t.Run("should reject gzipped data without the magic string", func(t *testing.T) {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		_, err := gz.Write([]byte("not a block archive at all"))
+		require.NoError(t, err)
+		require.NoError(t, gz.Close())
+
+		_, err = NewReader(&buf)
+		require.ErrorContains(t, err, "not a block archive")
+	})
+	t.Run("should report a truncated archive", func(t *testing.T) {
+		blocks, commits, _ := newChain(t, 3)
+		var buf bytes.Buffer
+		w, err := NewWriter(&buf, Header{ChainID: "test-chain", FromHeight: 1, ToHeight: 3})
+		require.NoError(t, err)
+		for i, block := range blocks {
+			entry, err := NewEntry(block, commits[i])
+			require.NoError(t, err)
+			require.NoError(t, w.Write(entry))
+		}
+		require.NoError(t, w.Close())
+
+		r, err := NewReader(bytes.NewReader(buf.Bytes()[:buf.Len()-4]))
+		require.NoError(t, err)
+		for err == nil {
+			_, err = r.Next()
+		}
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+}
+
+// newChain returns n consecutive empty blocks starting at height 1, the
+// commit for each of them and the validator set that signed the commits.
+func newChain(t *testing.T, n int) ([]*types.Block, []*types.Commit, *types.ValidatorSet) {
+	t.Helper()
+
+	eds, err := da.ConstructEDS(nil, appconsts.Version, -1)
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	data, err := types.DataFromProto(&cmtproto.Data{Hash: dah.Hash(), SquareSize: uint64(eds.Width() / 2)})
+	require.NoError(t, err)
+
+	key := ed25519.GenPrivKey()
+	vals := types.NewValidatorSet([]*types.Validator{types.NewValidator(key.PubKey(), 10)})
+	address := key.PubKey().Address()
+	blocks := make([]*types.Block, n)
+	commits := make([]*types.Commit, n)
+	lastCommit := &types.Commit{}
+	for i := range n {
+		block := &types.Block{
+			Header: types.Header{
+				Version:            cmtversion.Consensus{Block: version.BlockProtocol, App: appconsts.Version},
+				ChainID:            "test-chain",
+				Height:             int64(i + 1),
+				Time:               time.Unix(int64(i), 0).UTC(),
+				LastBlockID:        lastCommit.BlockID,
+				DataHash:           dah.Hash(),
+				ValidatorsHash:     vals.Hash(),
+				NextValidatorsHash: vals.Hash(),
+				ProposerAddress:    address,
+			},
+			Data:       data,
+			LastCommit: lastCommit,
+		}
+		hash := block.Hash()
+		parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: hash, PartSetHeader: parts.Header()}
+
+		vote := &cmtproto.Vote{
+			Type:             cmtproto.PrecommitType,
+			Height:           block.Height,
+			BlockID:          blockID.ToProto(),
+			Timestamp:        block.Time,
+			ValidatorAddress: address,
+		}
+		signature, err := key.Sign(types.VoteSignBytes("test-chain", vote))
+		require.NoError(t, err)
+		lastCommit = &types.Commit{
+			Height:  block.Height,
+			BlockID: blockID,
+			Signatures: []types.CommitSig{{
+				BlockIDFlag:      types.BlockIDFlagCommit,
+				ValidatorAddress: address,
+				Timestamp:        block.Time,
+				Signature:        signature,
+			}},
+		}
+		blocks[i], commits[i] = block, lastCommit
+	}
+	return blocks, commits, vals
+}
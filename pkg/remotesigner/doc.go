@@ -0,0 +1,7 @@
+// Package remotesigner keeps signing keys off the hosts that submit
+// transactions. A signing service, implemented by Server, signs with the keys
+// of a local keyring on behalf of clients connected over gRPC with mutual
+// TLS. Keyring implements keyring.Keyring on top of that service, so it can
+// be used wherever a keyring is expected, e.g. by user.TxClient to sign
+// transactions and by fibre.Client to sign payment promises.
+package remotesigner
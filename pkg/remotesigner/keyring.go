@@ -0,0 +1,95 @@
+package remotesigner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"google.golang.org/grpc"
+)
+
+// signTimeout is the timeout of a request to the signing service.
+const signTimeout = 10 * time.Second
+
+// Keyring is a keyring.Keyring that signs with the keys of a remote signing
+// service. The public keys of the service are cached in a local in-memory
+// keyring when the Keyring is created, and every method other than Sign and
+// SignByAddress is served by that local keyring. Keys created or imported
+// through the Keyring are therefore local and can't sign.
+type Keyring struct {
+	keyring.Keyring
+	client SignerClient
+}
+
+var _ keyring.Keyring = (*Keyring)(nil)
+
+// NewKeyring returns a Keyring that signs with the keys of the signing
+// service reachable through conn. Use Dial to connect to the service with
+// mutual TLS.
+func NewKeyring(ctx context.Context, conn *grpc.ClientConn, cdc codec.Codec) (*Keyring, error) {
+	client := NewSignerClient(conn)
+	resp, err := client.Keys(ctx, &KeysRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("listing remote keys: %w", err)
+	}
+
+	local := keyring.NewInMemory(cdc)
+	for _, key := range resp.Keys {
+		var pubKey cryptotypes.PubKey
+		if err := cdc.UnpackAny(key.PubKey, &pubKey); err != nil {
+			return nil, fmt.Errorf("unpacking public key of key %s: %w", key.Name, err)
+		}
+		if _, err := local.SaveOfflineKey(key.Name, pubKey); err != nil {
+			return nil, fmt.Errorf("caching key %s: %w", key.Name, err)
+		}
+	}
+
+	return &Keyring{
+		Keyring: local,
+		client:  client,
+	}, nil
+}
+
+// Sign signs msg with the remote key uid. The signature is verified against
+// the cached public key so that a misbehaving signing service can't make the
+// caller use an invalid signature.
+func (k *Keyring) Sign(uid string, msg []byte, signMode signing.SignMode) ([]byte, cryptotypes.PubKey, error) {
+	record, err := k.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), signTimeout)
+	defer cancel()
+
+	resp, err := k.client.Sign(ctx, &SignRequest{
+		Name:     uid,
+		Msg:      msg,
+		SignMode: signMode,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("remote signing with key %s: %w", uid, err)
+	}
+	if !pubKey.VerifySignature(msg, resp.Signature) {
+		return nil, nil, fmt.Errorf("remote signer returned an invalid signature for key %s", uid)
+	}
+	return resp.Signature, pubKey, nil
+}
+
+// SignByAddress signs msg with the remote key of address.
+func (k *Keyring) SignByAddress(address sdk.Address, msg []byte, signMode signing.SignMode) ([]byte, cryptotypes.PubKey, error) {
+	record, err := k.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+	return k.Sign(record.Name, msg, signMode)
+}
@@ -0,0 +1,190 @@
+package remotesigner_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v10/app"
+	"github.com/celestiaorg/celestia-app/v10/app/encoding"
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v10/pkg/remotesigner"
+	"github.com/celestiaorg/celestia-app/v10/pkg/user"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testPKI holds the TLS configs of a signing service and a client whose
+// certificates are issued by the same CA.
+type testPKI struct {
+	server remotesigner.TLSConfig
+	client remotesigner.TLSConfig
+}
+
+func newTestPKI(t *testing.T) testPKI {
+	t.Helper()
+	dir := t.TempDir()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+	caFile := writePEM(t, dir, "ca.pem", "CERTIFICATE", caDER)
+
+	issue := func(name string, serial int64, usage x509.ExtKeyUsage) remotesigner.TLSConfig {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: name},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+		require.NoError(t, err)
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+		return remotesigner.TLSConfig{
+			CertFile: writePEM(t, dir, name+".pem", "CERTIFICATE", der),
+			KeyFile:  writePEM(t, dir, name+"-key.pem", "EC PRIVATE KEY", keyDER),
+			CAFile:   caFile,
+		}
+	}
+
+	return testPKI{
+		server: issue("server", 2, x509.ExtKeyUsageServerAuth),
+		client: issue("client", 3, x509.ExtKeyUsageClientAuth),
+	}
+}
+
+func writePEM(t *testing.T, dir, name, blockType string, der []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+	return path
+}
+
+// startSigner starts a signing service backed by a keyring with the given
+// keys and returns its address.
+func startSigner(t *testing.T, tlsConfig remotesigner.TLSConfig, kr keyring.Keyring, allowed ...string) string {
+	t.Helper()
+	grpcServer, err := remotesigner.NewGRPCServer(remotesigner.NewServer(kr, allowed...), tlsConfig)
+	require.NoError(t, err)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = grpcServer.Serve(lis) }()
+	t.Cleanup(grpcServer.Stop)
+	return lis.Addr().String()
+}
+
+func newTestKeyring(t *testing.T, names ...string) keyring.Keyring {
+	t.Helper()
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := keyring.NewInMemory(encCfg.Codec)
+	for _, name := range names {
+		_, _, err := kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+	}
+	return kr
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	pki := newTestPKI(t)
+	serverKeyring := newTestKeyring(t, "alice", "bob")
+	addr := startSigner(t, pki.server, serverKeyring, "alice")
+
+	conn, err := remotesigner.Dial(addr, pki.client)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr, err := remotesigner.NewKeyring(context.Background(), conn, encCfg.Codec)
+	require.NoError(t, err)
+
+	// Only the allowed key is exposed.
+	records, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "alice", records[0].Name)
+	_, err = remotesigner.NewSignerClient(conn).Sign(context.Background(), &remotesigner.SignRequest{Name: "bob", Msg: []byte("msg")})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Signatures match the ones of the local keyring.
+	msg := []byte("payment promise")
+	signature, pubKey, err := kr.Sign("alice", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	localSignature, localPubKey, err := serverKeyring.Sign("alice", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, localPubKey.Equals(pubKey))
+	require.Equal(t, localSignature, signature)
+
+	signature, _, err = kr.SignByAddress(sdk.AccAddress(pubKey.Address()), msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, signature))
+
+	// The keyring can be used to sign transactions.
+	signer, err := user.NewSigner(kr, encCfg.TxConfig, "test-chain", user.NewAccount("alice", 1, 0))
+	require.NoError(t, err)
+	aliceAddr := sdk.AccAddress(pubKey.Address())
+	sendMsg := bank.NewMsgSend(aliceAddr, aliceAddr, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1)))
+	_, tx, err := signer.CreateTx([]sdk.Msg{sendMsg}, user.SetGasLimit(100_000), user.SetFee(1000))
+	require.NoError(t, err)
+	sigs, err := tx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.True(t, sigs[0].PubKey.Equals(pubKey))
+}
+
+func TestRemoteKeyringRequiresClientCertificate(t *testing.T) {
+	pki := newTestPKI(t)
+	addr := startSigner(t, pki.server, newTestKeyring(t, "alice"))
+
+	// A client with a certificate of another CA is rejected.
+	other := newTestPKI(t)
+	clientConfig := other.client
+	clientConfig.CAFile = pki.client.CAFile
+	conn, err := remotesigner.Dial(addr, clientConfig)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	_, err = remotesigner.NewKeyring(context.Background(), conn, encCfg.Codec)
+	require.Error(t, err)
+}
+
+func TestTLSConfigValidate(t *testing.T) {
+	require.Error(t, remotesigner.TLSConfig{}.Validate())
+	require.Error(t, remotesigner.TLSConfig{CertFile: "cert.pem", KeyFile: "key.pem"}.Validate())
+	require.NoError(t, remotesigner.TLSConfig{CertFile: "cert.pem", KeyFile: "key.pem", CAFile: "ca.pem"}.Validate())
+}
@@ -0,0 +1,95 @@
+package remotesigner
+
+import (
+	"context"
+	"errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server is the reference implementation of the signing service. It signs
+// with the keys of a local keyring.
+type Server struct {
+	keys keyring.Keyring
+	// allowed are the names of the keys clients may sign with. Clients may
+	// sign with all keys if it is empty.
+	allowed map[string]bool
+}
+
+var _ SignerServer = (*Server)(nil)
+
+// NewServer returns a server that signs with the keys of kr. If keyNames are
+// given, clients may only sign with those keys.
+func NewServer(kr keyring.Keyring, keyNames ...string) *Server {
+	allowed := make(map[string]bool, len(keyNames))
+	for _, name := range keyNames {
+		allowed[name] = true
+	}
+	return &Server{
+		keys:    kr,
+		allowed: allowed,
+	}
+}
+
+// NewGRPCServer returns a gRPC server that serves the signing service and
+// requires clients to authenticate with mutual TLS.
+func NewGRPCServer(server *Server, tlsConfig TLSConfig) (*grpc.Server, error) {
+	creds, err := tlsConfig.ServerCredentials()
+	if err != nil {
+		return nil, err
+	}
+	grpcServer := grpc.NewServer(grpc.Creds(creds))
+	RegisterSignerServer(grpcServer, server)
+	return grpcServer, nil
+}
+
+// Keys returns the keys of the keyring that clients may sign with. Keys that
+// can't sign, i.e. offline and multisig keys, are omitted.
+func (s *Server) Keys(_ context.Context, _ *KeysRequest) (*KeysResponse, error) {
+	records, err := s.keys.List()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "listing keys: %v", err)
+	}
+
+	resp := &KeysResponse{}
+	for _, record := range records {
+		if !s.isAllowed(record.Name) || (record.GetLocal() == nil && record.GetLedger() == nil) {
+			continue
+		}
+		pubKey, err := record.GetPubKey()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "getting public key of key %s: %v", record.Name, err)
+		}
+		anyPubKey, err := codectypes.NewAnyWithValue(pubKey)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "packing public key of key %s: %v", record.Name, err)
+		}
+		resp.Keys = append(resp.Keys, &Key{Name: record.Name, PubKey: anyPubKey})
+	}
+	return resp, nil
+}
+
+// Sign signs the bytes with the requested key.
+func (s *Server) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	if !s.isAllowed(req.Name) {
+		return nil, status.Errorf(codes.PermissionDenied, "signing with key %s is not allowed", req.Name)
+	}
+	signature, _, err := s.keys.Sign(req.Name, req.Msg, req.SignMode)
+	if errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return nil, status.Errorf(codes.NotFound, "key %s not found", req.Name)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "signing with key %s: %v", req.Name, err)
+	}
+	return &SignResponse{Signature: signature}, nil
+}
+
+// isAllowed returns true if clients may sign with the key.
+func (s *Server) isAllowed(name string) bool {
+	return len(s.allowed) == 0 || s.allowed[name]
+}
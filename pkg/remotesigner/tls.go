@@ -0,0 +1,103 @@
+package remotesigner
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// TLSConfig configures mutual TLS between the signing service and its
+// clients. Both sides present a certificate and only accept certificates
+// issued by the CA in CAFile.
+type TLSConfig struct {
+	// CertFile is the path to the PEM encoded certificate presented to the
+	// other side.
+	CertFile string
+	// KeyFile is the path to the PEM encoded private key of the certificate.
+	KeyFile string
+	// CAFile is the path to the PEM encoded certificate of the CA that issues
+	// the other side's certificates.
+	CAFile string
+}
+
+// Validate returns an error if a file is missing from the config.
+func (c TLSConfig) Validate() error {
+	if c.CertFile == "" {
+		return errors.New("TLS certificate file is required")
+	}
+	if c.KeyFile == "" {
+		return errors.New("TLS key file is required")
+	}
+	if c.CAFile == "" {
+		return errors.New("TLS CA file is required")
+	}
+	return nil
+}
+
+// ServerCredentials returns the transport credentials of the signing service.
+// Clients must present a certificate issued by the CA.
+func (c TLSConfig) ServerCredentials() (credentials.TransportCredentials, error) {
+	cert, pool, err := c.load()
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	}), nil
+}
+
+// ClientCredentials returns the transport credentials of a client. The
+// signing service must present a certificate issued by the CA.
+func (c TLSConfig) ClientCredentials() (credentials.TransportCredentials, error) {
+	cert, pool, err := c.load()
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS13,
+	}), nil
+}
+
+// load reads the key pair and the CA certificate.
+func (c TLSConfig) load() (tls.Certificate, *x509.CertPool, error) {
+	if err := c.Validate(); err != nil {
+		return tls.Certificate{}, nil, err
+	}
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("loading TLS key pair: %w", err)
+	}
+	caPEM, err := os.ReadFile(c.CAFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("reading CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return tls.Certificate{}, nil, fmt.Errorf("no certificates found in CA file %s", c.CAFile)
+	}
+	return cert, pool, nil
+}
+
+// Dial returns a connection to the signing service at addr secured with
+// mutual TLS.
+func Dial(addr string, tlsConfig TLSConfig) (*grpc.ClientConn, error) {
+	creds, err := tlsConfig.ClientCredentials()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("dialing remote signer at %s: %w", addr, err)
+	}
+	return conn, nil
+}
@@ -0,0 +1,287 @@
+// Package stateexport implements a verifiable export of the state of selected
+// modules at a height. Exports are written by `celestia-appd state export` and
+// let external services, such as light or indexing nodes, bootstrap from the
+// state of a chain without trusting the exporter.
+//
+// An export is a JSON document. It contains the commit IDs of all stores at
+// the height, which hash to the app hash in the header of the next block, and
+// for every exported module the raw entries of its store, each with an IAVL
+// proof against the root of the store, and IAVL proofs of absence of the keys
+// between them, which show that no entry is missing. A consumer that obtained
+// the app hash from a verified header checks an export with Verify.
+//
+// The decoded genesis state of every module is included for convenience. It
+// is derived from the raw entries by the exporter. VerifyGenesis checks that
+// it matches the genesis state the consumer derives from the verified entries.
+// Exports are deterministic: the same state produces the same bytes.
+package stateexport
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+)
+
+// FormatVersion is the version of the format of exports written by this
+// package.
+const FormatVersion = 2
+
+// emptyStoreHash is the root of an empty IAVL store.
+var emptyStoreHash = sha256.New().Sum(nil)
+
+// Export is the state of selected modules at a height.
+type Export struct {
+	FormatVersion int    `json:"format_version"`
+	ChainID       string `json:"chain_id"`
+	Height        int64  `json:"height"`
+	AppVersion    uint64 `json:"app_version"`
+	// AppHash is the hash of the state at Height. It is the app hash in the
+	// header of the block at Height+1.
+	AppHash cmtbytes.HexBytes `json:"app_hash"`
+	// Stores are the commit IDs of all stores at Height sorted by name.
+	Stores  []Store  `json:"stores"`
+	Modules []Module `json:"modules"`
+}
+
+// Store is the commit ID of a store.
+type Store struct {
+	Name string            `json:"name"`
+	Hash cmtbytes.HexBytes `json:"hash"`
+}
+
+// Module is the exported state of a module.
+type Module struct {
+	Name string `json:"name"`
+	// Store is the name of the store of the module.
+	Store string `json:"store"`
+	// Genesis is the genesis state the module exports for Height.
+	Genesis json.RawMessage `json:"genesis"`
+	// Entries are all entries of the store sorted by key.
+	Entries []Entry `json:"entries"`
+	// Gaps are proofs of absence of the keys returned by GapKeys for the keys
+	// of the entries. Each proves that two entries are adjacent in the store
+	// or that an entry is the first or last one.
+	Gaps []cmtprotocrypto.ProofOp `json:"gaps"`
+}
+
+// Entry is an entry of a store with the proof of its membership in the
+// store.
+type Entry struct {
+	Key   cmtbytes.HexBytes      `json:"key"`
+	Value cmtbytes.HexBytes      `json:"value"`
+	Proof cmtprotocrypto.ProofOp `json:"proof"`
+}
+
+// Verify checks that the stores of e hash to appHash, which must be obtained
+// from a verified header, and that the entries of every module are all
+// entries of its store.
+func (e *Export) Verify(appHash []byte) error {
+	if e.FormatVersion != FormatVersion {
+		return fmt.Errorf("unsupported format version %d", e.FormatVersion)
+	}
+	if !bytes.Equal(e.AppHash, appHash) {
+		return fmt.Errorf("export is for app hash %X, not %X", e.AppHash, appHash)
+	}
+
+	commitInfo := storetypes.CommitInfo{Version: e.Height}
+	roots := make(map[string][]byte, len(e.Stores))
+	for _, store := range e.Stores {
+		if _, ok := roots[store.Name]; ok {
+			return fmt.Errorf("duplicate store %s", store.Name)
+		}
+		roots[store.Name] = store.Hash
+		commitInfo.StoreInfos = append(commitInfo.StoreInfos, storetypes.StoreInfo{
+			Name:     store.Name,
+			CommitId: storetypes.CommitID{Version: e.Height, Hash: store.Hash},
+		})
+	}
+	if got := commitInfo.Hash(); !bytes.Equal(got, appHash) {
+		return fmt.Errorf("stores hash to %X, not to app hash %X", got, appHash)
+	}
+
+	for _, module := range e.Modules {
+		root, ok := roots[module.Store]
+		if !ok {
+			return fmt.Errorf("store %s of module %s is not part of the export", module.Store, module.Name)
+		}
+		for i, entry := range module.Entries {
+			if i > 0 && bytes.Compare(module.Entries[i-1].Key, entry.Key) >= 0 {
+				return fmt.Errorf("entries of module %s are not sorted by key", module.Name)
+			}
+			if err := VerifyEntry(root, entry); err != nil {
+				return fmt.Errorf("invalid entry %X of module %s: %w", entry.Key, module.Name, err)
+			}
+		}
+		if err := verifyComplete(root, module); err != nil {
+			return fmt.Errorf("entries of module %s are incomplete: %w", module.Name, err)
+		}
+	}
+	return nil
+}
+
+// VerifyGenesis checks that the genesis state of every module is the one
+// exportGenesis derives from the entries of the module. exportGenesis must
+// return the genesis state of every module of e by module name. Together with
+// Verify it binds the genesis states to the app hash.
+func (e *Export) VerifyGenesis(exportGenesis func(*Export) (map[string]json.RawMessage, error)) error {
+	genesis, err := exportGenesis(e)
+	if err != nil {
+		return fmt.Errorf("failed to derive genesis states from the entries: %w", err)
+	}
+	for _, module := range e.Modules {
+		want, err := CanonicalJSON(genesis[module.Name])
+		if err != nil {
+			return fmt.Errorf("failed to encode genesis state of module %s: %w", module.Name, err)
+		}
+		if !bytes.Equal(want, module.Genesis) {
+			return fmt.Errorf("genesis state of module %s does not match its entries", module.Name)
+		}
+	}
+	return nil
+}
+
+// verifyComplete checks that the gaps of module prove that its entries are all
+// entries of the store with root.
+func verifyComplete(root []byte, module Module) error {
+	if len(module.Entries) == 0 {
+		if len(module.Gaps) > 0 || !bytes.Equal(root, emptyStoreHash) {
+			return errors.New("store is not empty")
+		}
+		return nil
+	}
+
+	// next maps the key that precedes the absent key of each gap, or the
+	// empty key if there is none, to the key that follows it.
+	type neighbor struct {
+		key  []byte
+		last bool
+	}
+	next := make(map[string]neighbor, len(module.Gaps))
+	for _, gap := range module.Gaps {
+		left, right, err := VerifyGap(root, gap)
+		if err != nil {
+			return fmt.Errorf("invalid gap %X: %w", gap.Key, err)
+		}
+		next[string(left)] = neighbor{key: right, last: right == nil}
+	}
+
+	var prev []byte
+	for _, entry := range module.Entries {
+		// no key lies between a key and its successor.
+		if !bytes.Equal(entry.Key, successor(prev)) {
+			n, ok := next[string(prev)]
+			if !ok || n.last || !bytes.Equal(n.key, entry.Key) {
+				return fmt.Errorf("no proof that key %X follows key %X", entry.Key, prev)
+			}
+		}
+		prev = entry.Key
+	}
+	if n, ok := next[string(prev)]; !ok || !n.last {
+		return fmt.Errorf("no proof that key %X is the last key", prev)
+	}
+	return nil
+}
+
+// GapKeys returns the absent keys whose proofs of absence show that keys,
+// which must be sorted, are all keys of a store: the first key that could
+// precede keys[0] and the successor of every key unless it is the next key.
+func GapKeys(keys [][]byte) [][]byte {
+	var gaps [][]byte
+	var prev []byte
+	for _, key := range keys {
+		if gap := successor(prev); !bytes.Equal(gap, key) {
+			gaps = append(gaps, gap)
+		}
+		prev = key
+	}
+	if len(keys) > 0 {
+		gaps = append(gaps, successor(prev))
+	}
+	return gaps
+}
+
+// successor returns the smallest key that is greater than key.
+func successor(key []byte) []byte {
+	return append(bytes.Clone(key), 0)
+}
+
+// VerifyGap checks that gap proves the absence of its key from the store with
+// root. It returns the keys that precede and follow the absent key in the
+// store, either of which is nil if there is none.
+func VerifyGap(root []byte, gap cmtprotocrypto.ProofOp) (left, right []byte, err error) {
+	op, err := storetypes.CommitmentOpDecoder(gap)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode proof: %w", err)
+	}
+	commitmentOp, ok := op.(storetypes.CommitmentOp)
+	if !ok || commitmentOp.Proof.GetNonexist() == nil {
+		return nil, nil, errors.New("not a proof of absence")
+	}
+	// a commitment op without a value proves absence and returns the root.
+	got, err := op.Run(nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(got) != 1 || !bytes.Equal(got[0], root) {
+		return nil, nil, errors.New("proof does not match the root of the store")
+	}
+	nonexist := commitmentOp.Proof.GetNonexist()
+	if nonexist.Left != nil {
+		left = nonexist.Left.Key
+	}
+	if nonexist.Right != nil {
+		right = nonexist.Right.Key
+	}
+	return left, right, nil
+}
+
+// VerifyEntry checks that the proof of entry proves its membership in the
+// store with root.
+func VerifyEntry(root []byte, entry Entry) error {
+	op, err := storetypes.CommitmentOpDecoder(entry.Proof)
+	if err != nil {
+		return fmt.Errorf("failed to decode proof: %w", err)
+	}
+	if !bytes.Equal(op.GetKey(), entry.Key) {
+		return errors.New("proof is for a different key")
+	}
+	// a commitment op with a value proves membership and returns the root.
+	got, err := op.Run([][]byte{entry.Value})
+	if err != nil {
+		return err
+	}
+	if len(got) != 1 || !bytes.Equal(got[0], root) {
+		return errors.New("proof does not match the root of the store")
+	}
+	return nil
+}
+
+// CanonicalJSON returns bz with the keys of all objects sorted and without
+// insignificant whitespace. Numbers are preserved as written.
+func CanonicalJSON(bz []byte) (json.RawMessage, error) {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	// encoding/json writes the keys of maps in sorted order.
+	return json.Marshal(v)
+}
+
+// StoresFromCommitInfo returns the stores of commitInfo sorted by name.
+func StoresFromCommitInfo(commitInfo *storetypes.CommitInfo) []Store {
+	stores := make([]Store, 0, len(commitInfo.StoreInfos))
+	for _, info := range commitInfo.StoreInfos {
+		stores = append(stores, Store{Name: info.Name, Hash: info.CommitId.Hash})
+	}
+	sort.Slice(stores, func(i, j int) bool { return stores[i].Name < stores[j].Name })
+	return stores
+}
@@ -0,0 +1,86 @@
+package stateexport
+
+import (
+	"encoding/json"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	got, err := CanonicalJSON([]byte(`{ "b": [1, {"d": 2, "c": 1}], "a": 12345678901234567890 }`))
+	require.NoError(t, err)
+	require.Equal(t, `{"a":12345678901234567890,"b":[1,{"c":1,"d":2}]}`, string(got))
+
+	_, err = CanonicalJSON([]byte(`{`))
+	require.Error(t, err)
+}
+
+func TestVerify(t *testing.T) {
+	commitInfo := &storetypes.CommitInfo{
+		Version: 10,
+		StoreInfos: []storetypes.StoreInfo{
+			{Name: "staking", CommitId: storetypes.CommitID{Version: 10, Hash: []byte{2}}},
+			{Name: "bank", CommitId: storetypes.CommitID{Version: 10, Hash: emptyStoreHash}},
+		},
+	}
+	appHash := commitInfo.Hash()
+	export := func() *Export {
+		return &Export{
+			FormatVersion: FormatVersion,
+			Height:        10,
+			AppHash:       appHash,
+			Stores:        StoresFromCommitInfo(commitInfo),
+			Modules:       []Module{{Name: "bank", Store: "bank"}},
+		}
+	}
+
+	require.Equal(t, "bank", export().Stores[0].Name)
+	require.NoError(t, export().Verify(appHash))
+
+	e := export()
+	require.ErrorContains(t, e.Verify([]byte{1}), "export is for app hash")
+
+	e = export()
+	e.FormatVersion = FormatVersion + 1
+	require.ErrorContains(t, e.Verify(appHash), "unsupported format version")
+
+	e = export()
+	e.Stores[0].Hash = []byte{3}
+	require.ErrorContains(t, e.Verify(appHash), "not to app hash")
+
+	e = export()
+	e.Modules[0].Store = "fibre"
+	require.ErrorContains(t, e.Verify(appHash), "is not part of the export")
+
+	e = export()
+	e.Modules[0].Entries = []Entry{{Key: []byte{1}}}
+	require.ErrorContains(t, e.Verify(appHash), "invalid entry")
+
+	e = export()
+	e.Modules[0].Store = "staking"
+	require.ErrorContains(t, e.Verify(appHash), "store is not empty")
+
+	e = export()
+	e.Modules[0].Gaps = []cmtprotocrypto.ProofOp{{Key: []byte{0}}}
+	require.ErrorContains(t, e.Verify(appHash), "store is not empty")
+}
+
+func TestGapKeys(t *testing.T) {
+	require.Empty(t, GapKeys(nil))
+	require.Equal(t, [][]byte{{0}, {1, 0}, {2, 0}}, GapKeys([][]byte{{1}, {2}}))
+	// no key lies before {0} or between a key and its successor.
+	require.Equal(t, [][]byte{{0, 0, 0}}, GapKeys([][]byte{{0}, {0, 0}}))
+}
+
+func TestVerifyGenesis(t *testing.T) {
+	e := &Export{Modules: []Module{{Name: "bank", Genesis: []byte(`{"a":1,"b":2}`)}}}
+	require.NoError(t, e.VerifyGenesis(func(*Export) (map[string]json.RawMessage, error) {
+		return map[string]json.RawMessage{"bank": []byte(`{"b": 2, "a": 1}`)}, nil
+	}))
+	require.ErrorContains(t, e.VerifyGenesis(func(*Export) (map[string]json.RawMessage, error) {
+		return map[string]json.RawMessage{"bank": []byte(`{"a":1,"b":3}`)}, nil
+	}), "does not match its entries")
+}
@@ -0,0 +1,546 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v4/share"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"google.golang.org/grpc"
+)
+
+// AccountPoolConfig configures the sub-accounts of an AccountPool.
+type AccountPoolConfig struct {
+	// Balance is the balance in utia that new sub-accounts are funded with.
+	// Sub-accounts don't need a balance to pay fees, but an account must
+	// exist on chain to sign transactions. Defaults to DefaultWorkerBalance.
+	Balance uint64
+	// SpendLimit is the fee allowance in utia granted to each sub-account.
+	// Zero means the allowance is unlimited.
+	SpendLimit uint64
+	// TopUpThreshold is the remaining allowance in utia below which the
+	// allowance of a sub-account is granted again. It is only used if
+	// SpendLimit is set and defaults to a tenth of SpendLimit.
+	TopUpThreshold uint64
+	// Expiration is how long a granted allowance is valid. Zero means the
+	// allowance doesn't expire.
+	Expiration time.Duration
+}
+
+// Validate returns an error if the config is invalid.
+func (cfg AccountPoolConfig) Validate() error {
+	if cfg.SpendLimit > 0 && cfg.TopUpThreshold >= cfg.SpendLimit {
+		return fmt.Errorf("top up threshold %d must be below the spend limit %d", cfg.TopUpThreshold, cfg.SpendLimit)
+	}
+	if cfg.Expiration < 0 {
+		return fmt.Errorf("expiration must not be negative: %s", cfg.Expiration)
+	}
+	return nil
+}
+
+// SubAccount is an account of an AccountPool whose fees are paid by the
+// granter of the pool.
+type SubAccount struct {
+	Name    string
+	Address sdktypes.AccAddress
+	// Allowance is the remaining fee allowance in utia as tracked by the pool.
+	// It is zero if the allowance is unlimited.
+	Allowance uint64
+}
+
+// AccountPool manages sub-accounts of a TxClient whose fees are paid by the
+// default account of the TxClient through fee grants. It creates, funds,
+// grants, rotates and revokes sub-accounts and tracks the fees they spend so
+// that allowances can be topped up before they run out. The keys of
+// sub-accounts are stored in the keyring of the TxClient.
+// AccountPool is thread-safe.
+type AccountPool struct {
+	client  *TxClient
+	cfg     AccountPoolConfig
+	granter sdktypes.AccAddress
+
+	mtx      sync.Mutex
+	accounts map[string]*SubAccount
+	// grantLocks serialise the changes to the fee grant of each sub-account,
+	// so that concurrent top ups don't revoke and grant an allowance twice.
+	grantLocks map[string]*sync.Mutex
+}
+
+// NewAccountPool returns an empty pool of sub-accounts whose fees are paid by
+// the default account of the client.
+func (client *TxClient) NewAccountPool(cfg AccountPoolConfig) (*AccountPool, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.Balance == 0 {
+		cfg.Balance = DefaultWorkerBalance
+	}
+	if cfg.SpendLimit > 0 && cfg.TopUpThreshold == 0 {
+		cfg.TopUpThreshold = cfg.SpendLimit / 10
+	}
+	return &AccountPool{
+		client:     client,
+		cfg:        cfg,
+		granter:    client.DefaultAddress(),
+		accounts:   make(map[string]*SubAccount),
+		grantLocks: make(map[string]*sync.Mutex),
+	}, nil
+}
+
+// Granter returns the address that pays the fees of the sub-accounts.
+func (p *AccountPool) Granter() sdktypes.AccAddress {
+	return p.granter
+}
+
+// Accounts returns the sub-accounts of the pool sorted by name.
+func (p *AccountPool) Accounts() []SubAccount {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	accounts := make([]SubAccount, 0, len(p.accounts))
+	for _, account := range p.accounts {
+		accounts = append(accounts, *account)
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].Name < accounts[j].Name })
+	return accounts
+}
+
+// Account returns the sub-account with the given name.
+func (p *AccountPool) Account(name string) (SubAccount, bool) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	account, exists := p.accounts[name]
+	if !exists {
+		return SubAccount{}, false
+	}
+	return *account, true
+}
+
+// Create adds sub-accounts with the given names to the pool. Keys that don't
+// exist in the keyring are created. Accounts are funded and granted an
+// allowance in a single transaction, skipping those that already have a
+// balance or a fee grant from the granter. The allowances of the accounts are
+// queried from the chain.
+func (p *AccountPool) Create(ctx context.Context, names ...string) ([]SubAccount, error) {
+	if len(names) == 0 {
+		return nil, errors.New("no account names provided")
+	}
+
+	unlock := p.lockGrants(names...)
+	defer unlock()
+
+	addresses := make([]sdktypes.AccAddress, len(names))
+	var msgs []sdktypes.Msg
+	var gasLimit uint64
+	for i, name := range names {
+		if name == p.client.DefaultAccountName() {
+			return nil, fmt.Errorf("account %s is the granter of the pool", name)
+		}
+		if err := p.client.ensureAccountInKeyring(name); err != nil {
+			return nil, err
+		}
+		address, err := p.client.keyAddress(name)
+		if err != nil {
+			return nil, err
+		}
+		addresses[i] = address
+
+		accountMsgs, accountGasLimit, err := p.client.fundAndGrantMsgs(ctx, address, p.cfg.Balance, p.allowance())
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, accountMsgs...)
+		gasLimit += accountGasLimit
+	}
+
+	if len(msgs) > 0 {
+		if _, err := p.client.SubmitTx(ctx, msgs, SetGasLimit(gasLimit)); err != nil {
+			return nil, fmt.Errorf("funding and granting sub-accounts: %w", err)
+		}
+	}
+
+	accounts := make([]SubAccount, len(names))
+	for i, name := range names {
+		if err := p.loadAccount(ctx, name, addresses[i]); err != nil {
+			return nil, err
+		}
+		p.add(name, addresses[i])
+		// Accounts that already had a fee grant may have spent part of it, so
+		// the tracked allowance is the one on chain.
+		if _, err := p.Allowance(ctx, name); err != nil {
+			return nil, err
+		}
+		accounts[i], _ = p.Account(name)
+	}
+	return accounts, nil
+}
+
+// Fund sends amount utia from the granter to the sub-account.
+func (p *AccountPool) Fund(ctx context.Context, name string, amount uint64) error {
+	account, exists := p.Account(name)
+	if !exists {
+		return fmt.Errorf("account %s is not in the pool", name)
+	}
+	if _, err := p.client.SubmitTx(ctx, []sdktypes.Msg{p.fundMsg(account.Address, amount)}, SetGasLimit(SendGasLimit)); err != nil {
+		return fmt.Errorf("funding account %s: %w", name, err)
+	}
+	return nil
+}
+
+// Grant replaces the allowances of the sub-accounts with a new allowance of
+// the configured spend limit and expiration.
+func (p *AccountPool) Grant(ctx context.Context, names ...string) error {
+	unlock := p.lockGrants(names...)
+	defer unlock()
+	return p.grant(ctx, names...)
+}
+
+// grant replaces the allowances of the sub-accounts. It must be called with
+// the grant locks of the sub-accounts held.
+func (p *AccountPool) grant(ctx context.Context, names ...string) error {
+	var msgs []sdktypes.Msg
+	var gasLimit uint64
+	for _, name := range names {
+		account, exists := p.Account(name)
+		if !exists {
+			return fmt.Errorf("account %s is not in the pool", name)
+		}
+		hasGrant, err := p.client.hasFeeGrant(ctx, p.granter, account.Address)
+		if err != nil {
+			return err
+		}
+		// An existing allowance must be revoked before a new one can be
+		// granted.
+		if hasGrant {
+			msgs = append(msgs, p.revokeMsg(account.Address))
+			gasLimit += FeegrantGasLimit
+		}
+		grantMsg, err := p.grantMsg(account.Address)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, grantMsg)
+		gasLimit += FeegrantGasLimit
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	if _, err := p.client.SubmitTx(ctx, msgs, SetGasLimit(gasLimit)); err != nil {
+		return fmt.Errorf("granting allowances: %w", err)
+	}
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for _, name := range names {
+		if account, exists := p.accounts[name]; exists {
+			account.Allowance = p.cfg.SpendLimit
+		}
+	}
+	return nil
+}
+
+// Revoke revokes the allowances of the sub-accounts and removes them from the
+// pool. Their keys remain in the keyring and any balance remains in the
+// accounts.
+func (p *AccountPool) Revoke(ctx context.Context, names ...string) error {
+	unlock := p.lockGrants(names...)
+	defer unlock()
+
+	msgs := make([]sdktypes.Msg, 0, len(names))
+	for _, name := range names {
+		account, exists := p.Account(name)
+		if !exists {
+			return fmt.Errorf("account %s is not in the pool", name)
+		}
+		msgs = append(msgs, p.revokeMsg(account.Address))
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	if _, err := p.client.SubmitTx(ctx, msgs, SetGasLimit(uint64(len(msgs))*FeegrantGasLimit)); err != nil {
+		return fmt.Errorf("revoking allowances: %w", err)
+	}
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for _, name := range names {
+		delete(p.accounts, name)
+	}
+	return nil
+}
+
+// Rotate replaces the sub-account oldName with a new sub-account newName. The
+// new account is funded and granted an allowance in the same transaction that
+// revokes the allowance of the old account, so that the pool never has fewer
+// usable accounts.
+func (p *AccountPool) Rotate(ctx context.Context, oldName, newName string) (SubAccount, error) {
+	unlock := p.lockGrants(oldName, newName)
+	defer unlock()
+
+	old, exists := p.Account(oldName)
+	if !exists {
+		return SubAccount{}, fmt.Errorf("account %s is not in the pool", oldName)
+	}
+	if _, exists := p.Account(newName); exists {
+		return SubAccount{}, fmt.Errorf("account %s is already in the pool", newName)
+	}
+	if err := p.client.ensureAccountInKeyring(newName); err != nil {
+		return SubAccount{}, err
+	}
+	address, err := p.client.keyAddress(newName)
+	if err != nil {
+		return SubAccount{}, err
+	}
+
+	grantMsg, err := p.grantMsg(address)
+	if err != nil {
+		return SubAccount{}, err
+	}
+	msgs := []sdktypes.Msg{p.fundMsg(address, p.cfg.Balance), grantMsg, p.revokeMsg(old.Address)}
+	if _, err := p.client.SubmitTx(ctx, msgs, SetGasLimit(SendGasLimit+2*FeegrantGasLimit)); err != nil {
+		return SubAccount{}, fmt.Errorf("rotating account %s: %w", oldName, err)
+	}
+	if err := p.loadAccount(ctx, newName, address); err != nil {
+		return SubAccount{}, err
+	}
+
+	p.mtx.Lock()
+	delete(p.accounts, oldName)
+	p.mtx.Unlock()
+	return p.add(newName, address), nil
+}
+
+// Allowance queries the remaining fee allowance in utia of the sub-account
+// and updates the tracked allowance. It returns zero if the allowance is
+// unlimited.
+func (p *AccountPool) Allowance(ctx context.Context, name string) (uint64, error) {
+	account, exists := p.Account(name)
+	if !exists {
+		return 0, fmt.Errorf("account %s is not in the pool", name)
+	}
+	var resp *feegrant.QueryAllowanceResponse
+	err := p.client.endpoints.do(ctx, func(conn *grpc.ClientConn) (err error) {
+		resp, err = feegrant.NewQueryClient(conn).Allowance(ctx, &feegrant.QueryAllowanceRequest{
+			Granter: p.granter.String(),
+			Grantee: account.Address.String(),
+		})
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("querying allowance of account %s: %w", name, err)
+	}
+	var allowance feegrant.FeeAllowanceI
+	if err := p.client.registry.UnpackAny(resp.Allowance.Allowance, &allowance); err != nil {
+		return 0, fmt.Errorf("unpacking allowance of account %s: %w", name, err)
+	}
+	basic, ok := allowance.(*feegrant.BasicAllowance)
+	if !ok {
+		return 0, fmt.Errorf("unsupported allowance type %T", allowance)
+	}
+	remaining := basic.SpendLimit.AmountOf(appconsts.BondDenom).Uint64()
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if account, exists := p.accounts[name]; exists {
+		account.Allowance = remaining
+	}
+	return remaining, nil
+}
+
+// SubmitTx submits the messages signed by the sub-account with its fees paid by
+// the granter and waits for the transaction to be committed.
+func (p *AccountPool) SubmitTx(ctx context.Context, name string, msgs []sdktypes.Msg, opts ...TxOption) (*TxResponse, error) {
+	account, exists := p.Account(name)
+	if !exists {
+		return nil, fmt.Errorf("account %s is not in the pool", name)
+	}
+	// The granter pays the fees of the signer of the transaction, so the
+	// messages must not be signed by another account.
+	signer, err := p.client.msgsSigner(msgs)
+	if err != nil {
+		return nil, err
+	}
+	if !signer.Equals(account.Address) {
+		return nil, fmt.Errorf("messages must be signed by account %s (%s), got %s", name, account.Address, signer)
+	}
+	resp, err := p.client.BroadcastTx(ctx, msgs, append(opts, SetFeeGranter(p.granter))...)
+	if err != nil {
+		return nil, err
+	}
+	return p.confirm(ctx, name, resp.TxHash)
+}
+
+// SubmitPayForBlob pays for the blobs with the sub-account with its fees paid
+// by the granter and waits for the transaction to be committed.
+func (p *AccountPool) SubmitPayForBlob(ctx context.Context, name string, blobs []*share.Blob, opts ...TxOption) (*TxResponse, error) {
+	if _, exists := p.Account(name); !exists {
+		return nil, fmt.Errorf("account %s is not in the pool", name)
+	}
+	resp, err := p.client.BroadcastPayForBlobWithAccount(ctx, name, blobs, append(opts, SetFeeGranter(p.granter))...)
+	if err != nil {
+		return nil, err
+	}
+	return p.confirm(ctx, name, resp.TxHash)
+}
+
+// confirm confirms the transaction of the sub-account, deducts its fee from
+// the tracked allowance and tops the allowance up if it fell below the
+// threshold.
+func (p *AccountPool) confirm(ctx context.Context, name, txHash string) (*TxResponse, error) {
+	var fee uint64
+	if _, _, txBytes, exists := p.client.GetTxFromTxTracker(txHash); exists {
+		fee, _ = p.client.transactionFee(txBytes)
+	}
+
+	resp, err := p.client.ConfirmTx(ctx, txHash)
+	// Fees are deducted from the allowance even if execution fails.
+	var executionErr *ExecutionError
+	if err != nil && !errors.As(err, &executionErr) {
+		return nil, err
+	}
+	p.spend(name, fee)
+	if p.needsTopUp(name) {
+		if topUpErr := p.topUp(ctx, name); topUpErr != nil {
+			return resp, errors.Join(err, fmt.Errorf("topping up allowance of account %s: %w", name, topUpErr))
+		}
+	}
+	return resp, err
+}
+
+// spend deducts fee from the tracked allowance of the sub-account.
+func (p *AccountPool) spend(name string, fee uint64) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if account, exists := p.accounts[name]; exists {
+		account.Allowance -= min(fee, account.Allowance)
+	}
+}
+
+// needsTopUp reports whether the tracked allowance of the sub-account fell
+// below the threshold.
+func (p *AccountPool) needsTopUp(name string) bool {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	account, exists := p.accounts[name]
+	return exists && p.cfg.SpendLimit > 0 && account.Allowance < p.cfg.TopUpThreshold
+}
+
+// topUp grants the sub-account a new allowance unless it was already topped
+// up by a concurrent transaction of the sub-account.
+func (p *AccountPool) topUp(ctx context.Context, name string) error {
+	unlock := p.lockGrants(name)
+	defer unlock()
+	if !p.needsTopUp(name) {
+		return nil
+	}
+	return p.grant(ctx, name)
+}
+
+// lockGrants acquires the grant locks of the sub-accounts in the order of
+// their names and returns a function that releases them.
+func (p *AccountPool) lockGrants(names ...string) func() {
+	names = slices.Clone(names)
+	slices.Sort(names)
+	names = slices.Compact(names)
+
+	p.mtx.Lock()
+	locks := make([]*sync.Mutex, len(names))
+	for i, name := range names {
+		lock, exists := p.grantLocks[name]
+		if !exists {
+			lock = &sync.Mutex{}
+			p.grantLocks[name] = lock
+		}
+		locks[i] = lock
+	}
+	p.mtx.Unlock()
+
+	for _, lock := range locks {
+		lock.Lock()
+	}
+	return func() {
+		for _, lock := range locks {
+			lock.Unlock()
+		}
+	}
+}
+
+// add adds the sub-account to the pool with a full allowance.
+func (p *AccountPool) add(name string, address sdktypes.AccAddress) SubAccount {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	account := &SubAccount{Name: name, Address: address, Allowance: p.cfg.SpendLimit}
+	p.accounts[name] = account
+	return *account
+}
+
+// loadAccount adds the sub-account to the signer unless it already is loaded.
+func (p *AccountPool) loadAccount(ctx context.Context, name string, address sdktypes.AccAddress) error {
+	if p.client.Account(name) != nil {
+		return nil
+	}
+	// The funding transaction is confirmed at this point, but the committed
+	// account state can briefly lag behind.
+	accNum, seqNum, err := queryAccountWithRetry(ctx, workerAccountQueryRetries, workerAccountQueryRetryDelay, func() (uint64, uint64, error) {
+		return p.client.queryAccount(ctx, address)
+	})
+	if err != nil {
+		return fmt.Errorf("querying account %s: %w", name, err)
+	}
+	if err := p.client.signer.AddAccount(NewAccount(name, accNum, seqNum)); err != nil {
+		return fmt.Errorf("adding account %s to signer: %w", name, err)
+	}
+	return nil
+}
+
+func (p *AccountPool) fundMsg(address sdktypes.AccAddress, amount uint64) sdktypes.Msg {
+	return bank.NewMsgSend(p.granter, address, sdktypes.NewCoins(sdktypes.NewCoin(appconsts.BondDenom, sdkmath.NewIntFromUint64(amount))))
+}
+
+// allowance returns a new allowance of the configured spend limit and
+// expiration.
+func (p *AccountPool) allowance() *feegrant.BasicAllowance {
+	allowance := &feegrant.BasicAllowance{}
+	if p.cfg.SpendLimit > 0 {
+		allowance.SpendLimit = sdktypes.NewCoins(sdktypes.NewCoin(appconsts.BondDenom, sdkmath.NewIntFromUint64(p.cfg.SpendLimit)))
+	}
+	if p.cfg.Expiration > 0 {
+		expiration := time.Now().Add(p.cfg.Expiration)
+		allowance.Expiration = &expiration
+	}
+	return allowance
+}
+
+func (p *AccountPool) grantMsg(address sdktypes.AccAddress) (sdktypes.Msg, error) {
+	msg, err := feegrant.NewMsgGrantAllowance(p.allowance(), p.granter, address)
+	if err != nil {
+		return nil, fmt.Errorf("creating fee grant for %s: %w", address, err)
+	}
+	return msg, nil
+}
+
+func (p *AccountPool) revokeMsg(address sdktypes.AccAddress) sdktypes.Msg {
+	msg := feegrant.NewMsgRevokeAllowance(p.granter, address)
+	return &msg
+}
+
+// keyAddress returns the address of the key with the given name.
+func (client *TxClient) keyAddress(name string) (sdktypes.AccAddress, error) {
+	record, err := client.signer.keys.Key(name)
+	if err != nil {
+		return nil, fmt.Errorf("getting key %s: %w", name, err)
+	}
+	address, err := record.GetAddress()
+	if err != nil {
+		return nil, fmt.Errorf("getting address of key %s: %w", name, err)
+	}
+	return address, nil
+}
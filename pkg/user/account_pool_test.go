@@ -0,0 +1,99 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/x/feegrant"
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v10/pkg/user"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountPoolConfigValidate(t *testing.T) {
+	require.NoError(t, user.AccountPoolConfig{}.Validate())
+	require.NoError(t, user.AccountPoolConfig{SpendLimit: 100, TopUpThreshold: 10}.Validate())
+	require.Error(t, user.AccountPoolConfig{SpendLimit: 100, TopUpThreshold: 100}.Validate())
+	require.Error(t, user.AccountPoolConfig{Expiration: -time.Hour}.Validate())
+}
+
+func (suite *TxClientTestSuite) TestAccountPool() {
+	t := suite.T()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	pool, err := suite.txClient.NewAccountPool(user.AccountPoolConfig{
+		SpendLimit:     1_000_000,
+		TopUpThreshold: 900_000,
+	})
+	require.NoError(t, err)
+	require.Equal(t, suite.txClient.DefaultAddress(), pool.Granter())
+
+	accounts, err := pool.Create(ctx, "pool-a", "pool-b")
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	for _, account := range accounts {
+		require.Equal(t, uint64(1_000_000), account.Allowance)
+		require.NotNil(t, suite.txClient.Account(account.Name))
+	}
+
+	// The fees of sub-accounts are paid by the granter.
+	poolA := accounts[0].Address
+	msg := bank.NewMsgSend(poolA, poolA, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1)))
+	granterBalance := suite.queryCurrentBalance(t)
+	_, err = pool.SubmitTx(ctx, "pool-a", []sdk.Msg{msg}, user.SetFee(200_000), user.SetGasLimit(200_000))
+	require.NoError(t, err)
+	require.LessOrEqual(t, suite.queryCurrentBalance(t), granterBalance-200_000)
+
+	// Sub-accounts can only submit messages they sign themselves.
+	otherMsg := bank.NewMsgSend(accounts[1].Address, poolA, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1)))
+	_, err = pool.SubmitTx(ctx, "pool-a", []sdk.Msg{otherMsg})
+	require.ErrorContains(t, err, "messages must be signed by account pool-a")
+
+	// Spending more than the threshold tops the allowance up.
+	account, exists := pool.Account("pool-a")
+	require.True(t, exists)
+	require.Equal(t, uint64(1_000_000), account.Allowance)
+	allowance, err := pool.Allowance(ctx, "pool-a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1_000_000), allowance)
+
+	rotated, err := pool.Rotate(ctx, "pool-b", "pool-c")
+	require.NoError(t, err)
+	require.Equal(t, "pool-c", rotated.Name)
+	require.True(t, suite.hasFeeGrant(ctx, rotated.Address))
+	require.False(t, suite.hasFeeGrant(ctx, accounts[1].Address))
+
+	require.NoError(t, pool.Revoke(ctx, "pool-a"))
+	require.False(t, suite.hasFeeGrant(ctx, poolA))
+	_, err = pool.SubmitTx(ctx, "pool-a", []sdk.Msg{msg})
+	require.Error(t, err)
+
+	remaining := pool.Accounts()
+	require.Len(t, remaining, 1)
+	require.Equal(t, "pool-c", remaining[0].Name)
+
+	// A new pool tracks the remaining allowance of an existing fee grant.
+	poolC := rotated.Address
+	msg = bank.NewMsgSend(poolC, poolC, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1)))
+	_, err = pool.SubmitTx(ctx, "pool-c", []sdk.Msg{msg}, user.SetFee(50_000), user.SetGasLimit(200_000))
+	require.NoError(t, err)
+	other, err := suite.txClient.NewAccountPool(user.AccountPoolConfig{SpendLimit: 1_000_000})
+	require.NoError(t, err)
+	accounts, err = other.Create(ctx, "pool-c")
+	require.NoError(t, err)
+	require.Equal(t, uint64(950_000), accounts[0].Allowance)
+}
+
+// hasFeeGrant queries the chain for a fee grant of the default account to
+// grantee.
+func (suite *TxClientTestSuite) hasFeeGrant(ctx context.Context, grantee sdk.AccAddress) bool {
+	_, err := feegrant.NewQueryClient(suite.ctx.GRPCClient).Allowance(ctx, &feegrant.QueryAllowanceRequest{
+		Granter: suite.txClient.DefaultAddress().String(),
+		Grantee: grantee.String(),
+	})
+	return err == nil
+}
@@ -0,0 +1,356 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v10/pkg/proof"
+	blobtypes "github.com/celestiaorg/celestia-app/v10/x/blob/types"
+	fibretypes "github.com/celestiaorg/celestia-app/v10/x/fibre/types"
+	"github.com/celestiaorg/go-square/v4"
+	"github.com/celestiaorg/go-square/v4/inclusion"
+	"github.com/celestiaorg/go-square/v4/share"
+	blobtx "github.com/celestiaorg/go-square/v4/tx"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmservice "github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	"google.golang.org/grpc"
+)
+
+// pfbTxOverhead is an upper bound on the number of bytes a BlobTx paying for
+// a single blob needs in addition to the data of the blob, i.e. the signed PFB
+// and the encoding of the blob.
+const pfbTxOverhead = 2048
+
+// BlobManifest describes a payload that SubmitPayload split into blobs. It is
+// needed to reassemble the payload with ReadPayload and can be serialized as
+// JSON.
+type BlobManifest struct {
+	Namespace []byte `json:"namespace"`
+	// Size is the size of the payload in bytes.
+	Size int `json:"size"`
+	// Hash is the SHA-256 hash of the payload.
+	Hash []byte `json:"hash"`
+	// Chunks are the blobs the payload was split into, in order.
+	Chunks []BlobChunk `json:"chunks"`
+}
+
+// Validate returns an error if the sizes of the manifest are inconsistent.
+func (m *BlobManifest) Validate() error {
+	if m.Size < 0 {
+		return fmt.Errorf("payload size must not be negative: %d", m.Size)
+	}
+	total := 0
+	for i, chunk := range m.Chunks {
+		if chunk.Size < 0 || chunk.Size > m.Size-total {
+			return fmt.Errorf("chunk %d of %d has an invalid size %d", i+1, len(m.Chunks), chunk.Size)
+		}
+		total += chunk.Size
+	}
+	if total != m.Size {
+		return fmt.Errorf("chunks have %d bytes, manifest expects %d", total, m.Size)
+	}
+	return nil
+}
+
+// BlobChunk is a blob that holds part of a payload.
+type BlobChunk struct {
+	// Height is the height of the block that includes the blob.
+	Height int64  `json:"height"`
+	TxHash string `json:"tx_hash"`
+	// Commitment is the share commitment of the blob.
+	Commitment []byte `json:"commitment"`
+	// Size is the size of the data of the blob in bytes.
+	Size int `json:"size"`
+}
+
+// PayloadOptions configure how SubmitPayload submits a payload.
+type PayloadOptions struct {
+	// MaxBlobSize is the maximum size of the data of a blob. It defaults to the
+	// size of the largest blob that fits both the max effective square size of
+	// the network and MaxTxSize.
+	MaxBlobSize int
+	// UseTxQueue submits the blobs through the parallel transaction queue, so
+	// that multiple blobs can be pending at once. The queue must be started.
+	UseTxQueue bool
+	// TxOptions are applied to every PFB.
+	TxOptions []TxOption
+}
+
+// MaxBlobSize returns the size of the data of the largest blob that a single
+// PFB can pay for given the max effective square size and the max tx size.
+func MaxBlobSize(maxSquareSize, maxTxSize int) int {
+	// The blob shares the square with the compact shares of its PFB.
+	available := maxSquareSize*maxSquareSize - share.CompactSharesNeeded(pfbTxOverhead)
+	// The square builder reserves the worst case padding needed to align the
+	// blob to its subtree width, which grows with the size of the blob.
+	blobShares := available
+	for blobShares > 0 && blobShares+inclusion.SubTreeWidth(blobShares, appconsts.SubtreeRootThreshold)-1 > available {
+		blobShares--
+	}
+	return max(0, min(share.AvailableBytesFromSparseShares(blobShares), maxTxSize-pfbTxOverhead))
+}
+
+// SplitPayload splits the payload into blobs of the namespace with at most
+// maxBlobSize bytes of data each.
+func SplitPayload(namespace share.Namespace, payload []byte, maxBlobSize int) ([]*share.Blob, error) {
+	if len(payload) == 0 {
+		return nil, errors.New("payload is empty")
+	}
+	if maxBlobSize <= 0 {
+		return nil, fmt.Errorf("max blob size must be positive: %d", maxBlobSize)
+	}
+
+	blobs := make([]*share.Blob, 0, (len(payload)+maxBlobSize-1)/maxBlobSize)
+	for start := 0; start < len(payload); start += maxBlobSize {
+		end := min(start+maxBlobSize, len(payload))
+		blob, err := share.NewV0Blob(namespace, payload[start:end])
+		if err != nil {
+			return nil, err
+		}
+		blobs = append(blobs, blob)
+	}
+	return blobs, nil
+}
+
+// SubmitPayload splits an arbitrarily large payload into blobs that each fit
+// in a block, pays for every blob with a separate PFB and waits for all of them
+// to be committed. The returned manifest is needed to read the payload back
+// with ReadPayload. If a PFB fails, the blobs that were already committed are
+// not reverted.
+func (client *TxClient) SubmitPayload(ctx context.Context, namespace share.Namespace, payload []byte, opts PayloadOptions) (*BlobManifest, error) {
+	maxBlobSize := opts.MaxBlobSize
+	if maxBlobSize == 0 {
+		maxSquareSize, err := client.MaxEffectiveSquareSize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		maxBlobSize = MaxBlobSize(maxSquareSize, appconsts.MaxTxSize)
+	}
+
+	blobs, err := SplitPayload(namespace, payload, maxBlobSize)
+	if err != nil {
+		return nil, err
+	}
+	commitments, err := inclusion.CreateCommitments(blobs, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+	if err != nil {
+		return nil, fmt.Errorf("creating commitments: %w", err)
+	}
+
+	responses, err := client.submitBlobs(ctx, blobs, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(payload)
+	manifest := &BlobManifest{
+		Namespace: namespace.Bytes(),
+		Size:      len(payload),
+		Hash:      hash[:],
+		Chunks:    make([]BlobChunk, len(blobs)),
+	}
+	for i, resp := range responses {
+		manifest.Chunks[i] = BlobChunk{
+			Height:     resp.Height,
+			TxHash:     resp.TxHash,
+			Commitment: commitments[i],
+			Size:       blobs[i].DataLen(),
+		}
+	}
+	return manifest, nil
+}
+
+// submitBlobs pays for every blob with a separate PFB and returns the
+// responses in the order of the blobs.
+func (client *TxClient) submitBlobs(ctx context.Context, blobs []*share.Blob, opts PayloadOptions) ([]*TxResponse, error) {
+	responses := make([]*TxResponse, len(blobs))
+	if !opts.UseTxQueue {
+		for i, blob := range blobs {
+			resp, err := client.SubmitPayForBlob(ctx, []*share.Blob{blob}, opts.TxOptions...)
+			if err != nil {
+				return nil, fmt.Errorf("submitting blob %d of %d: %w", i+1, len(blobs), err)
+			}
+			responses[i] = resp
+		}
+		return responses, nil
+	}
+
+	resultsC := make([]chan SubmissionResult, len(blobs))
+	for i, blob := range blobs {
+		resultsC[i] = make(chan SubmissionResult, 1)
+		client.QueueBlob(ctx, resultsC[i], []*share.Blob{blob}, opts.TxOptions...)
+	}
+	var errs []error
+	for i := range blobs {
+		result := <-resultsC[i]
+		if result.Error != nil {
+			errs = append(errs, fmt.Errorf("submitting blob %d of %d: %w", i+1, len(blobs), result.Error))
+			continue
+		}
+		responses[i] = result.TxResponse
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return responses, nil
+}
+
+// ReadPayload reads the blobs of the manifest from the chain, verifies them and
+// reassembles the payload. Every blob is verified with an inclusion proof of its
+// shares to the data root of the block that includes it, and the payload is
+// verified against the manifest's hash. The data roots are taken from the
+// blocks returned by the node, so callers that don't trust the node should
+// verify the block headers with a light client.
+func (client *TxClient) ReadPayload(ctx context.Context, manifest *BlobManifest) ([]byte, error) {
+	if err := manifest.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	namespace, err := share.NewNamespaceFromBytes(manifest.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace: %w", err)
+	}
+
+	// The manifest is untrusted, so the payload only grows by the data of
+	// verified blobs.
+	var payload []byte
+	for i, chunk := range manifest.Chunks {
+		data, err := client.readBlob(ctx, namespace, chunk)
+		if err != nil {
+			return nil, fmt.Errorf("reading blob %d of %d: %w", i+1, len(manifest.Chunks), err)
+		}
+		payload = append(payload, data...)
+	}
+
+	if len(payload) != manifest.Size {
+		return nil, fmt.Errorf("payload has %d bytes, manifest expects %d", len(payload), manifest.Size)
+	}
+	if hash := sha256.Sum256(payload); !bytes.Equal(hash[:], manifest.Hash) {
+		return nil, errors.New("payload does not match the manifest's hash")
+	}
+	return payload, nil
+}
+
+// readBlob reads the data of the blob of the chunk and verifies that it is
+// included in the block.
+func (client *TxClient) readBlob(ctx context.Context, namespace share.Namespace, chunk BlobChunk) ([]byte, error) {
+	block, err := client.blockByHeight(ctx, chunk.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	txIndex, blobIndex, err := client.findBlob(block.Data.Txs, chunk.Commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	classifiedTxs, err := fibretypes.ClassifyTxs(block.Data.Txs)
+	if err != nil {
+		return nil, err
+	}
+	shareRange, err := square.BlobShareRange(classifiedTxs, txIndex, blobIndex, appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold)
+	if err != nil {
+		return nil, err
+	}
+	dataSquare, err := square.Construct(classifiedTxs, appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold)
+	if err != nil {
+		return nil, err
+	}
+	shareProof, err := proof.NewShareInclusionProof(dataSquare, namespace, shareRange)
+	if err != nil {
+		return nil, err
+	}
+	if err := shareProof.Validate(block.Header.DataHash); err != nil {
+		return nil, fmt.Errorf("verifying inclusion proof: %w", err)
+	}
+
+	shares, err := share.FromBytes(shareProof.Data)
+	if err != nil {
+		return nil, err
+	}
+	blobs, err := share.ParseBlobs(shares)
+	if err != nil {
+		return nil, err
+	}
+	if len(blobs) != 1 {
+		return nil, fmt.Errorf("expected 1 blob in the proven shares, got %d", len(blobs))
+	}
+	commitment, err := inclusion.CreateCommitment(blobs[0], merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(commitment, chunk.Commitment) {
+		return nil, errors.New("blob does not match the chunk's commitment")
+	}
+	if blobs[0].DataLen() != chunk.Size {
+		return nil, fmt.Errorf("blob has %d bytes, chunk expects %d", blobs[0].DataLen(), chunk.Size)
+	}
+	return blobs[0].Data(), nil
+}
+
+// findBlob returns the index of the blob tx and the index of the blob within
+// it that pays for the blob with the given commitment.
+func (client *TxClient) findBlob(txs [][]byte, commitment []byte) (int, int, error) {
+	for txIndex, rawTx := range txs {
+		blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTx)
+		if !isBlobTx || err != nil {
+			continue
+		}
+		decoded, err := client.signer.DecodeTx(blobTx.Tx)
+		if err != nil {
+			continue
+		}
+		for _, msg := range decoded.GetMsgs() {
+			pfb, ok := msg.(*blobtypes.MsgPayForBlobs)
+			if !ok {
+				continue
+			}
+			for blobIndex, c := range pfb.ShareCommitments {
+				if bytes.Equal(c, commitment) {
+					return txIndex, blobIndex, nil
+				}
+			}
+		}
+	}
+	return 0, 0, errors.New("blob not found in block")
+}
+
+// blockByHeight returns the block at height from the healthiest endpoint.
+func (client *TxClient) blockByHeight(ctx context.Context, height int64) (block *tmproto.Block, err error) {
+	err = client.endpoints.do(ctx, func(conn *grpc.ClientConn) error {
+		resp, err := tmservice.NewServiceClient(conn).GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: height})
+		if err != nil {
+			return err
+		}
+		block = resp.Block
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("querying block at height %d: %w", height, err)
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block at height %d not found", height)
+	}
+	return block, nil
+}
+
+// MaxEffectiveSquareSize returns the max effective square size of the network,
+// i.e. the governance max square size capped by the upper bound.
+func (client *TxClient) MaxEffectiveSquareSize(ctx context.Context) (int, error) {
+	var govMaxSquareSize uint64
+	err := client.endpoints.do(ctx, func(conn *grpc.ClientConn) error {
+		resp, err := blobtypes.NewQueryClient(conn).Params(ctx, &blobtypes.QueryParamsRequest{})
+		if err != nil {
+			return err
+		}
+		govMaxSquareSize = resp.Params.GovMaxSquareSize
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("querying blob params: %w", err)
+	}
+	return min(int(govMaxSquareSize), appconsts.SquareSizeUpperBound), nil
+}
@@ -0,0 +1,117 @@
+package user_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v10/pkg/user"
+	"github.com/celestiaorg/celestia-app/v10/test/util/random"
+	"github.com/celestiaorg/go-square/v4"
+	"github.com/celestiaorg/go-square/v4/share"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxBlobSize(t *testing.T) {
+	// Small squares are limited by the square size.
+	small := user.MaxBlobSize(8, appconsts.MaxTxSize)
+	require.Greater(t, small, 0)
+	require.Less(t, small, share.AvailableBytesFromSparseShares(8*8))
+
+	// Large squares are limited by the max tx size.
+	large := user.MaxBlobSize(appconsts.SquareSizeUpperBound, appconsts.MaxTxSize)
+	require.Less(t, large, appconsts.MaxTxSize)
+	require.Greater(t, large, small)
+
+	require.Zero(t, user.MaxBlobSize(1, appconsts.MaxTxSize))
+}
+
+func TestMaxBlobSizeFitsSquare(t *testing.T) {
+	txClient, _ := setupTxClientWithMockServers(t, []BroadcastHandler{nil}, nil)
+	namespace := share.RandomBlobNamespace()
+
+	// fits reports whether the square builder includes a PFB for a blob of
+	// blobSize bytes in a square of squareSize.
+	fits := func(squareSize, blobSize int) bool {
+		blob, err := share.NewV0Blob(namespace, random.Bytes(blobSize))
+		require.NoError(t, err)
+		blobTx, _, err := txClient.Signer().CreatePayForBlobs(txClient.DefaultAccountName(), []*share.Blob{blob}, user.SetGasLimit(1_000_000_000), user.SetFee(1_000_000))
+		require.NoError(t, err)
+		_, txs, err := square.Build([][]byte{blobTx}, squareSize, appconsts.SubtreeRootThreshold)
+		require.NoError(t, err)
+		return len(txs) == 1
+	}
+
+	for _, squareSize := range []int{64, 128} {
+		maxBlobSize := user.MaxBlobSize(squareSize, appconsts.MaxTxSize)
+		require.True(t, fits(squareSize, maxBlobSize), "square size %d", squareSize)
+
+		// A blob that fills the square without room for the padding that
+		// aligns it to its subtree width doesn't fit.
+		unaligned := share.AvailableBytesFromSparseShares(squareSize*squareSize - share.CompactSharesNeeded(2048))
+		require.Greater(t, unaligned, maxBlobSize)
+		require.False(t, fits(squareSize, unaligned), "square size %d", squareSize)
+	}
+}
+
+func TestSplitPayload(t *testing.T) {
+	namespace := share.RandomBlobNamespace()
+	payload := random.Bytes(2500)
+
+	blobs, err := user.SplitPayload(namespace, payload, 1000)
+	require.NoError(t, err)
+	require.Len(t, blobs, 3)
+
+	var reassembled []byte
+	for _, blob := range blobs {
+		require.Equal(t, namespace, blob.Namespace())
+		require.LessOrEqual(t, blob.DataLen(), 1000)
+		reassembled = append(reassembled, blob.Data()...)
+	}
+	require.Equal(t, payload, reassembled)
+
+	_, err = user.SplitPayload(namespace, nil, 1000)
+	require.Error(t, err)
+	_, err = user.SplitPayload(namespace, payload, 0)
+	require.Error(t, err)
+}
+
+func TestBlobManifestValidate(t *testing.T) {
+	chunks := []user.BlobChunk{{Size: 10}, {Size: 5}}
+	require.NoError(t, (&user.BlobManifest{Size: 15, Chunks: chunks}).Validate())
+	require.NoError(t, (&user.BlobManifest{}).Validate())
+
+	require.Error(t, (&user.BlobManifest{Size: -1}).Validate())
+	require.Error(t, (&user.BlobManifest{Size: 1 << 40, Chunks: chunks}).Validate())
+	require.Error(t, (&user.BlobManifest{Size: 10, Chunks: chunks}).Validate())
+	require.Error(t, (&user.BlobManifest{Size: 5, Chunks: []user.BlobChunk{{Size: 10}, {Size: -5}}}).Validate())
+}
+
+func (suite *TxClientTestSuite) TestSubmitPayload() {
+	t := suite.T()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	maxSquareSize, err := suite.txClient.MaxEffectiveSquareSize(ctx)
+	require.NoError(t, err)
+	require.LessOrEqual(t, maxSquareSize, appconsts.SquareSizeUpperBound)
+
+	namespace := share.RandomBlobNamespace()
+	payload := random.Bytes(25_000)
+
+	manifest, err := suite.txClient.SubmitPayload(ctx, namespace, payload, user.PayloadOptions{MaxBlobSize: 10_000})
+	require.NoError(t, err)
+	require.Len(t, manifest.Chunks, 3)
+	require.Equal(t, len(payload), manifest.Size)
+
+	read, err := suite.txClient.ReadPayload(ctx, manifest)
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(payload, read))
+
+	// A manifest that doesn't match the chain is rejected.
+	manifest.Chunks[0], manifest.Chunks[1] = manifest.Chunks[1], manifest.Chunks[0]
+	_, err = suite.txClient.ReadPayload(ctx, manifest)
+	require.Error(t, err)
+}
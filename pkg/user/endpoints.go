@@ -0,0 +1,311 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v10/app/grpc/gasestimation"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultEndpointHealthCheckInterval is the default interval at which the
+	// height of every core endpoint is queried.
+	DefaultEndpointHealthCheckInterval = 10 * time.Second
+	// DefaultMaxEndpointHeightLag is the default number of blocks an endpoint
+	// may lag behind the highest endpoint before it is considered unhealthy.
+	DefaultMaxEndpointHeightLag = 5
+	// maxEndpointErrorRate is the error rate above which an endpoint is
+	// considered unhealthy.
+	maxEndpointErrorRate = 0.5
+	// endpointStatsWeight is the weight of the latest request in the moving
+	// averages of an endpoint's latency and error rate.
+	endpointStatsWeight = 0.2
+)
+
+// WithBroadcastFanOut sets the number of core endpoints every transaction is
+// broadcast to. The healthiest endpoints are used. By default transactions are
+// broadcast to all endpoints.
+func WithBroadcastFanOut(fanOut int) Option {
+	return func(c *TxClient) {
+		c.broadcastFanOut = fanOut
+	}
+}
+
+// WithEndpointHealthCheckInterval sets the interval at which SetupTxClient
+// queries the height of every core endpoint to detect endpoints that lag
+// behind. Health checks are only run when multiple endpoints are configured.
+func WithEndpointHealthCheckInterval(interval time.Duration) Option {
+	return func(c *TxClient) {
+		c.healthCheckInterval = interval
+	}
+}
+
+// WithMaxEndpointHeightLag sets the number of blocks a core endpoint may lag
+// behind the highest endpoint before it is considered unhealthy.
+func WithMaxEndpointHeightLag(blocks int64) Option {
+	return func(c *TxClient) {
+		c.maxHeightLag = blocks
+	}
+}
+
+// EndpointStats describes the health of a core endpoint.
+type EndpointStats struct {
+	// Target is the target of the endpoint's gRPC connection.
+	Target  string
+	Healthy bool
+	// Latency is the moving average of the latency of successful requests.
+	Latency time.Duration
+	// ErrorRate is the moving average of the fraction of failed requests.
+	ErrorRate float64
+	// Height is the latest height reported by the endpoint.
+	Height int64
+	// HeightLag is the number of blocks the endpoint lags behind the highest
+	// endpoint.
+	HeightLag int64
+	Requests  uint64
+	Failures  uint64
+}
+
+// endpoint tracks the health of a core endpoint.
+type endpoint struct {
+	conn      *grpc.ClientConn
+	latency   time.Duration
+	errorRate float64
+	height    int64
+	requests  uint64
+	failures  uint64
+}
+
+// endpointPool ranks core endpoints by health so that requests go to the
+// healthiest endpoint and fail over to the next one. It is thread-safe.
+type endpointPool struct {
+	mtx          sync.Mutex
+	endpoints    []*endpoint
+	maxHeightLag int64
+}
+
+func newEndpointPool(conns []*grpc.ClientConn, maxHeightLag int64) *endpointPool {
+	endpoints := make([]*endpoint, len(conns))
+	for i, conn := range conns {
+		endpoints[i] = &endpoint{conn: conn}
+	}
+	return &endpointPool{
+		endpoints:    endpoints,
+		maxHeightLag: maxHeightLag,
+	}
+}
+
+// record updates the stats of the endpoint of conn with the outcome of a
+// request.
+func (p *endpointPool) record(conn *grpc.ClientConn, latency time.Duration, err error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for _, e := range p.endpoints {
+		if e.conn != conn {
+			continue
+		}
+		e.requests++
+		if isEndpointFailure(err) {
+			e.failures++
+			e.errorRate = endpointStatsWeight + (1-endpointStatsWeight)*e.errorRate
+			return
+		}
+		e.errorRate = (1 - endpointStatsWeight) * e.errorRate
+		if e.latency == 0 {
+			e.latency = latency
+		} else {
+			e.latency = time.Duration(endpointStatsWeight*float64(latency) + (1-endpointStatsWeight)*float64(e.latency))
+		}
+		return
+	}
+}
+
+// setHeight records the latest height reported by the endpoint of conn.
+func (p *endpointPool) setHeight(conn *grpc.ClientConn, height int64) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for _, e := range p.endpoints {
+		if e.conn == conn {
+			e.height = max(e.height, height)
+		}
+	}
+}
+
+// maxHeight returns the highest height reported by any endpoint. The caller
+// must hold the mutex.
+func (p *endpointPool) maxHeight() int64 {
+	var height int64
+	for _, e := range p.endpoints {
+		height = max(height, e.height)
+	}
+	return height
+}
+
+// healthy returns true if the endpoint is healthy. The caller must hold the
+// mutex.
+func (p *endpointPool) healthy(e *endpoint, maxHeight int64) bool {
+	if e.errorRate > maxEndpointErrorRate {
+		return false
+	}
+	// Endpoints whose height is unknown are not considered lagging.
+	return e.height == 0 || maxHeight-e.height <= p.maxHeightLag
+}
+
+// ranked returns the connections ordered from the healthiest to the least
+// healthy endpoint. Endpoints that are equally healthy keep the order in which
+// they were configured, so the primary endpoint is preferred.
+func (p *endpointPool) ranked() []*grpc.ClientConn {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	maxHeight := p.maxHeight()
+	endpoints := make([]*endpoint, len(p.endpoints))
+	copy(endpoints, p.endpoints)
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		healthyI, healthyJ := p.healthy(endpoints[i], maxHeight), p.healthy(endpoints[j], maxHeight)
+		if healthyI != healthyJ {
+			return healthyI
+		}
+		if endpoints[i].errorRate != endpoints[j].errorRate {
+			return endpoints[i].errorRate < endpoints[j].errorRate
+		}
+		return endpoints[i].latency < endpoints[j].latency
+	})
+
+	conns := make([]*grpc.ClientConn, len(endpoints))
+	for i, e := range endpoints {
+		conns[i] = e.conn
+	}
+	return conns
+}
+
+// best returns the connection of the healthiest endpoint.
+func (p *endpointPool) best() *grpc.ClientConn {
+	return p.ranked()[0]
+}
+
+// stats returns the stats of all endpoints in the order they were configured.
+func (p *endpointPool) stats() []EndpointStats {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	maxHeight := p.maxHeight()
+	stats := make([]EndpointStats, len(p.endpoints))
+	for i, e := range p.endpoints {
+		stats[i] = EndpointStats{
+			Target:    e.conn.Target(),
+			Healthy:   p.healthy(e, maxHeight),
+			Latency:   e.latency,
+			ErrorRate: e.errorRate,
+			Height:    e.height,
+			Requests:  e.requests,
+			Failures:  e.failures,
+		}
+		if e.height != 0 {
+			stats[i].HeightLag = maxHeight - e.height
+		}
+	}
+	return stats
+}
+
+// do calls fn with the connections of the endpoints from the healthiest to
+// the least healthy until fn succeeds or fails with an error that is not
+// caused by the endpoint.
+func (p *endpointPool) do(ctx context.Context, fn func(conn *grpc.ClientConn) error) error {
+	var errs []error
+	for _, conn := range p.ranked() {
+		start := time.Now()
+		err := fn(conn)
+		p.record(conn, time.Since(start), err)
+		if !isEndpointFailure(err) || ctx.Err() != nil {
+			return err
+		}
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// checkHeights queries the latest height of every endpoint.
+func (p *endpointPool) checkHeights(ctx context.Context) {
+	p.mtx.Lock()
+	conns := make([]*grpc.ClientConn, len(p.endpoints))
+	for i, e := range p.endpoints {
+		conns[i] = e.conn
+	}
+	p.mtx.Unlock()
+
+	var wg sync.WaitGroup
+	for _, conn := range conns {
+		wg.Add(1)
+		go func(conn *grpc.ClientConn) {
+			defer wg.Done()
+			start := time.Now()
+			resp, err := nodeservice.NewServiceClient(conn).Status(ctx, &nodeservice.StatusRequest{})
+			p.record(conn, time.Since(start), err)
+			if err == nil {
+				p.setHeight(conn, int64(resp.Height))
+			}
+		}(conn)
+	}
+	wg.Wait()
+}
+
+// monitor checks the heights of all endpoints at the given interval until ctx
+// is cancelled.
+func (p *endpointPool) monitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		p.checkHeights(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// isEndpointFailure returns true if err indicates that the endpoint could not
+// serve the request, as opposed to the request itself being invalid.
+func isEndpointFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted, codes.Canceled:
+		return true
+	default:
+		return false
+	}
+}
+
+// failoverGasEstimatorClient is a GasEstimatorClient that sends every request
+// to the healthiest endpoint and fails over to the others.
+type failoverGasEstimatorClient struct {
+	endpoints *endpointPool
+}
+
+var _ gasestimation.GasEstimatorClient = failoverGasEstimatorClient{}
+
+func (c failoverGasEstimatorClient) EstimateGasPrice(ctx context.Context, in *gasestimation.EstimateGasPriceRequest, opts ...grpc.CallOption) (resp *gasestimation.EstimateGasPriceResponse, err error) {
+	err = c.endpoints.do(ctx, func(conn *grpc.ClientConn) error {
+		resp, err = gasestimation.NewGasEstimatorClient(conn).EstimateGasPrice(ctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c failoverGasEstimatorClient) EstimateGasPriceAndUsage(ctx context.Context, in *gasestimation.EstimateGasPriceAndUsageRequest, opts ...grpc.CallOption) (resp *gasestimation.EstimateGasPriceAndUsageResponse, err error) {
+	err = c.endpoints.do(ctx, func(conn *grpc.ClientConn) error {
+		resp, err = gasestimation.NewGasEstimatorClient(conn).EstimateGasPriceAndUsage(ctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
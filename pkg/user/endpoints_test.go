@@ -0,0 +1,170 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func newTestConns(t *testing.T, targets ...string) []*grpc.ClientConn {
+	conns := make([]*grpc.ClientConn, len(targets))
+	for i, target := range targets {
+		conn, err := grpc.NewClient("passthrough:///"+target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		conns[i] = conn
+	}
+	return conns
+}
+
+func TestEndpointPoolRanking(t *testing.T) {
+	conns := newTestConns(t, "primary", "secondary", "tertiary")
+	pool := newEndpointPool(conns, 2)
+
+	// Equally healthy endpoints keep their configured order.
+	require.Equal(t, conns, pool.ranked())
+
+	// Lower latency is preferred.
+	pool.record(conns[0], 30*time.Millisecond, nil)
+	pool.record(conns[1], 10*time.Millisecond, nil)
+	pool.record(conns[2], 20*time.Millisecond, nil)
+	require.Equal(t, []*grpc.ClientConn{conns[1], conns[2], conns[0]}, pool.ranked())
+
+	// Failing endpoints are ranked behind the others.
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+	for range 5 {
+		pool.record(conns[1], 0, unavailable)
+	}
+	require.Equal(t, conns[2], pool.best())
+
+	// Endpoints that lag behind are ranked behind the others.
+	pool.setHeight(conns[0], 100)
+	pool.setHeight(conns[2], 90)
+	require.Equal(t, conns[0], pool.best())
+
+	stats := pool.stats()
+	require.Len(t, stats, 3)
+	require.True(t, stats[0].Healthy)
+	require.False(t, stats[1].Healthy)
+	require.Equal(t, uint64(6), stats[1].Requests)
+	require.Equal(t, uint64(5), stats[1].Failures)
+	require.False(t, stats[2].Healthy)
+	require.Equal(t, int64(10), stats[2].HeightLag)
+}
+
+func TestEndpointPoolFailover(t *testing.T) {
+	conns := newTestConns(t, "primary", "secondary")
+	pool := newEndpointPool(conns, DefaultMaxEndpointHeightLag)
+	ctx := context.Background()
+
+	// Requests fail over when an endpoint is unavailable.
+	var called []*grpc.ClientConn
+	err := pool.do(ctx, func(conn *grpc.ClientConn) error {
+		called = append(called, conn)
+		if conn == conns[0] {
+			return status.Error(codes.Unavailable, "connection refused")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, conns, called)
+
+	// Errors that are not caused by the endpoint are returned right away.
+	called = nil
+	notFound := status.Error(codes.NotFound, "account not found")
+	err = pool.do(ctx, func(conn *grpc.ClientConn) error {
+		called = append(called, conn)
+		return notFound
+	})
+	require.ErrorIs(t, err, notFound)
+	require.Len(t, called, 1)
+
+	// All errors are returned if every endpoint is unavailable.
+	err = pool.do(ctx, func(*grpc.ClientConn) error {
+		return status.Error(codes.Unavailable, "connection refused")
+	})
+	require.Error(t, err)
+	require.False(t, errors.Is(err, notFound))
+}
+
+func TestBroadcastConns(t *testing.T) {
+	conns := newTestConns(t, "primary", "secondary", "tertiary")
+	client := &TxClient{conns: conns, endpoints: newEndpointPool(conns, DefaultMaxEndpointHeightLag)}
+	require.Equal(t, conns, client.broadcastConns())
+
+	client.broadcastFanOut = 2
+	require.Equal(t, conns[:2], client.broadcastConns())
+}
+
+func TestEndpointMetrics(t *testing.T) {
+	conns := newTestConns(t, "primary", "secondary")
+	pool := newEndpointPool(conns, DefaultMaxEndpointHeightLag)
+	pool.record(conns[0], 10*time.Millisecond, nil)
+	pool.record(conns[1], 0, status.Error(codes.Unavailable, "connection refused"))
+
+	reader := sdkmetric.NewManualReader()
+	registration, err := registerEndpointMetrics(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test"), pool)
+	require.NoError(t, err)
+
+	collect := func() map[string]map[string]int64 {
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(context.Background(), &rm))
+		values := make(map[string]map[string]int64)
+		for _, scope := range rm.ScopeMetrics {
+			for _, m := range scope.Metrics {
+				var points []metricdata.DataPoint[int64]
+				switch data := m.Data.(type) {
+				case metricdata.Gauge[int64]:
+					points = data.DataPoints
+				case metricdata.Sum[int64]:
+					points = data.DataPoints
+				}
+				for _, dp := range points {
+					endpoint, _ := dp.Attributes.Value("endpoint")
+					if values[m.Name] == nil {
+						values[m.Name] = make(map[string]int64)
+					}
+					values[m.Name][endpoint.AsString()] = dp.Value
+				}
+			}
+		}
+		return values
+	}
+
+	values := collect()
+	require.Equal(t, map[string]int64{conns[0].Target(): 1, conns[1].Target(): 1}, values["txclient.endpoint.requests"])
+	require.Equal(t, map[string]int64{conns[0].Target(): 0, conns[1].Target(): 1}, values["txclient.endpoint.failures"])
+	require.Equal(t, int64(1), values["txclient.endpoint.healthy"][conns[0].Target()])
+
+	require.NoError(t, registration.Unregister())
+	require.Empty(t, collect())
+}
+
+func TestTxClientCloseStopsEndpointMonitor(t *testing.T) {
+	conns := newTestConns(t, "primary", "secondary")
+	client := &TxClient{
+		conns:               conns,
+		endpoints:           newEndpointPool(conns, DefaultMaxEndpointHeightLag),
+		healthCheckInterval: time.Millisecond,
+	}
+	client.startEndpointMonitor(context.Background())
+	done := client.monitorDone
+
+	client.Close()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("endpoint monitor did not stop")
+	}
+	// Closing twice is a no-op.
+	client.Close()
+}
@@ -0,0 +1,196 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v10/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	blobtx "github.com/celestiaorg/go-square/v4/tx"
+	"github.com/cometbft/cometbft/rpc/core"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+// errMaxFeeReached is returned when a transaction can't be replaced because
+// its fee already is the maximum fee of the FeeBumpPolicy.
+var errMaxFeeReached = errors.New("max fee reached")
+
+// FeeBumpPolicy configures how ConfirmTx replaces transactions that are
+// evicted from the mempool. A replacement has the same sequence as the
+// transaction it replaces and a higher fee, so at most one of them can be
+// committed. Fees are only bumped on eviction: a node only accepts the
+// replacement once the transaction it replaces has left its mempool, and the
+// mempool gossips a pending transaction to the other nodes.
+type FeeBumpPolicy struct {
+	// Multiplier is the factor by which the fee of each replacement exceeds
+	// the fee of the transaction it replaces. It must be greater than 1.
+	Multiplier float64
+	// MaxFee is the maximum fee in utia that a replacement may pay.
+	MaxFee uint64
+}
+
+// Validate returns an error if the policy is invalid.
+func (p FeeBumpPolicy) Validate() error {
+	if p.Multiplier <= 1 {
+		return fmt.Errorf("multiplier must be greater than 1: %f", p.Multiplier)
+	}
+	if p.MaxFee == 0 {
+		return errors.New("max fee must be positive")
+	}
+	return nil
+}
+
+// bumpedFee returns the fee of the replacement of a transaction paying fee.
+func (p FeeBumpPolicy) bumpedFee(fee uint64) (uint64, error) {
+	if fee >= p.MaxFee {
+		return 0, errMaxFeeReached
+	}
+	bumped := uint64(math.Ceil(float64(fee) * p.Multiplier))
+	// Always increase the fee, even if rounding would not.
+	bumped = max(bumped, fee+1)
+	return min(bumped, p.MaxFee), nil
+}
+
+// WithFeeBumping enables replace-by-fee in ConfirmTx: a transaction that is
+// evicted is re-signed with the same sequence and a fee that is
+// policy.Multiplier times higher, up to policy.MaxFee. NewTxClient returns an
+// error if the policy is invalid.
+func WithFeeBumping(policy FeeBumpPolicy) Option {
+	return func(c *TxClient) {
+		c.feeBumpPolicy = &policy
+	}
+}
+
+// feeBumpState tracks the replacements of a transaction while it is being
+// confirmed.
+type feeBumpState struct {
+	// superseded are the hashes of earlier versions of the transaction, each
+	// of which may still be committed instead of the latest one.
+	superseded []string
+}
+
+// bumpFee broadcasts a replacement of the evicted transaction with txHash that
+// has the same sequence and a bumped fee. It returns the hash of the
+// replacement and true if a node accepted it. A rejected replacement is not an
+// error and leaves the signer untouched: the original is resubmitted instead.
+func (client *TxClient) bumpFee(ctx context.Context, txHash string) (string, bool, error) {
+	client.mtx.Lock()
+	info, exists := client.txTracker[txHash]
+	if !exists {
+		client.mtx.Unlock()
+		return "", false, nil
+	}
+	replacement, fee, err := client.replaceTransaction(info)
+	client.mtx.Unlock()
+	if errors.Is(err, errMaxFeeReached) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("replacing tx %s: %w", txHash, err)
+	}
+
+	span := trace.SpanFromContext(ctx)
+	resp, conn, err := client.broadcastToBestEndpoint(ctx, replacement)
+	if err != nil {
+		var broadcastTxErr *BroadcastTxError
+		if !errors.As(err, &broadcastTxErr) {
+			return "", false, err
+		}
+		span.RecordError(fmt.Errorf("txclient/bumpFee: replacement of tx %s not accepted: %w", txHash, err))
+		return "", false, nil
+	}
+
+	span.AddEvent("txclient/bumpFee: replaced tx", trace.WithAttributes(
+		attribute.String("tx_hash", txHash),
+		attribute.String("replacement_tx_hash", resp.TxHash),
+		attribute.Int64("fee", int64(fee)),
+	))
+	client.metrics.observeResubmission(ctx, info.signer, resubmitReasonFeeBump)
+
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	client.trackTransactionWithSequence(ctx, info.signer, info.sequence, resp.TxHash, replacement, []*grpc.ClientConn{conn})
+	return resp.TxHash, true, nil
+}
+
+// replaceTransaction re-signs the tracked transaction with the same sequence
+// and a bumped fee. It returns the replacement and its fee.
+func (client *TxClient) replaceTransaction(info txInfo) ([]byte, uint64, error) {
+	fee, err := client.transactionFee(info.txBytes)
+	if err != nil {
+		return nil, 0, err
+	}
+	bumped, err := client.feeBumpPolicy.bumpedFee(fee)
+	if err != nil {
+		return nil, 0, err
+	}
+	fees := sdktypes.NewCoins(sdktypes.NewCoin(appconsts.BondDenom, sdkmath.NewIntFromUint64(bumped)))
+	replacement, err := client.resignTransaction(info.txBytes, fees, &info.sequence)
+	if err != nil {
+		return nil, 0, err
+	}
+	return replacement, bumped, nil
+}
+
+// transactionFee returns the fee in utia paid by the transaction.
+func (client *TxClient) transactionFee(txBytes []byte) (uint64, error) {
+	decoded, err := client.decodeTransaction(txBytes)
+	if err != nil {
+		return 0, err
+	}
+	return decoded.GetFee().AmountOf(appconsts.BondDenom).Uint64(), nil
+}
+
+// decodeTransaction decodes a transaction which may be wrapped in a blob tx.
+func (client *TxClient) decodeTransaction(txBytes []byte) (authsigning.Tx, error) {
+	if blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(txBytes); isBlobTx {
+		if err != nil {
+			return nil, err
+		}
+		txBytes = blobTx.Tx
+	}
+	return client.signer.DecodeTx(txBytes)
+}
+
+// findCommittedTx returns the hash and status of the first of the given
+// transactions that has been committed. It returns a nil status if none of
+// them has been committed.
+func (client *TxClient) findCommittedTx(ctx context.Context, txHashes []string) (string, *tx.TxStatusResponse, error) {
+	for _, txHash := range txHashes {
+		resp, err := client.txStatus(ctx, txHash)
+		if err != nil {
+			return "", nil, err
+		}
+		if resp.Status == core.TxStatusCommitted {
+			return txHash, resp, nil
+		}
+	}
+	return "", nil, nil
+}
+
+// dropSuperseded removes the versions of a transaction that were not
+// committed from the tx tracker and records them as dropped in the journal.
+func (client *TxClient) dropSuperseded(ctx context.Context, committedHash string, superseded []string) {
+	for _, txHash := range superseded {
+		client.updateJournal(ctx, txHash, JournalTxStatusDropped, 0, 0, fmt.Sprintf("replaced by %s", committedHash))
+		client.deleteFromTxTracker(txHash)
+	}
+}
+
+// removeHash returns txHashes without txHash.
+func removeHash(txHashes []string, txHash string) []string {
+	result := make([]string, 0, len(txHashes))
+	for _, hash := range txHashes {
+		if hash != txHash {
+			result = append(result, hash)
+		}
+	}
+	return result
+}
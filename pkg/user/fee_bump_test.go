@@ -0,0 +1,199 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v10/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v10/pkg/user"
+	"github.com/celestiaorg/celestia-app/v10/pkg/user/utils"
+	"github.com/celestiaorg/celestia-app/v10/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v10/test/util/random"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/rpc/core"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeBumpPolicyValidate(t *testing.T) {
+	valid := user.FeeBumpPolicy{Multiplier: 1.5, MaxFee: 1_000_000}
+	require.NoError(t, valid.Validate())
+
+	noIncrease := valid
+	noIncrease.Multiplier = 1
+	require.Error(t, noIncrease.Validate())
+
+	noMaxFee := valid
+	noMaxFee.MaxFee = 0
+	require.Error(t, noMaxFee.Validate())
+
+	encCfg, txClient, ctx := utils.SetupTxClientWithDefaultParams(t)
+	_, err := user.NewTxClient(encCfg.Codec, txClient.Signer(), ctx.GRPCClient, encCfg.InterfaceRegistry, user.WithFeeBumping(noMaxFee))
+	require.ErrorContains(t, err, "invalid fee bump policy")
+}
+
+func TestConfirmTxWithFeeBumpingKeepsPendingTx(t *testing.T) {
+	var (
+		mtx        sync.Mutex
+		broadcasts int
+	)
+	broadcastHandler := func(context.Context, *sdktx.BroadcastTxRequest) (*sdktx.BroadcastTxResponse, error) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		broadcasts++
+		return &sdktx.BroadcastTxResponse{
+			TxResponse: &sdk.TxResponse{TxHash: "original-hash", Code: abci.CodeTypeOK},
+		}, nil
+	}
+	// A pending tx is not replaced because the nodes already hold it.
+	responses := map[string][]*tx.TxStatusResponse{
+		"original-hash": {
+			{Status: core.TxStatusPending},
+			{Status: core.TxStatusPending},
+			{Status: core.TxStatusPending},
+			{Status: core.TxStatusCommitted, Height: 5, ExecutionCode: abci.CodeTypeOK},
+		},
+	}
+	txClient, _ := setupTxClientWithMockServers(t, []BroadcastHandler{broadcastHandler}, responses,
+		user.WithPollTime(10*time.Millisecond),
+		user.WithFeeBumping(user.FeeBumpPolicy{Multiplier: 2, MaxFee: 1_000_000}),
+	)
+
+	ctx := context.Background()
+	addr := txClient.DefaultAddress()
+	sequence := txClient.Signer().Account(txClient.DefaultAccountName()).Sequence()
+	msg := bank.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1)))
+	resp, err := txClient.BroadcastTx(ctx, []sdk.Msg{msg}, user.SetFee(1000), user.SetGasLimit(100_000))
+	require.NoError(t, err)
+
+	confirmed, err := txClient.ConfirmTx(ctx, resp.TxHash)
+	require.NoError(t, err)
+	require.Equal(t, "original-hash", confirmed.TxHash)
+	require.Empty(t, confirmed.ReplacedTxHashes)
+	require.Equal(t, 1, broadcasts)
+	require.Equal(t, sequence+1, txClient.Signer().Account(txClient.DefaultAccountName()).Sequence())
+}
+
+func TestConfirmTxWithFeeBumpingReplacesEvictedTx(t *testing.T) {
+	var (
+		mtx        sync.Mutex
+		broadcasts [][]byte
+	)
+	// The original is replaced once it is evicted.
+	broadcastHandler := func(_ context.Context, req *sdktx.BroadcastTxRequest) (*sdktx.BroadcastTxResponse, error) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		broadcasts = append(broadcasts, req.TxBytes)
+		switch len(broadcasts) {
+		case 1:
+			return &sdktx.BroadcastTxResponse{
+				TxResponse: &sdk.TxResponse{TxHash: "original-hash", Code: abci.CodeTypeOK},
+			}, nil
+		case 2:
+			return &sdktx.BroadcastTxResponse{
+				TxResponse: &sdk.TxResponse{TxHash: "replacement-hash", Code: abci.CodeTypeOK},
+			}, nil
+		default:
+			return nil, errors.New("unexpected broadcast")
+		}
+	}
+	responses := map[string][]*tx.TxStatusResponse{
+		"original-hash": {
+			{Status: core.TxStatusPending},
+			{Status: core.TxStatusPending},
+			{Status: core.TxStatusEvicted},
+		},
+		"replacement-hash": {{Status: core.TxStatusCommitted, Height: 5, ExecutionCode: abci.CodeTypeOK}},
+	}
+	txClient, _ := setupTxClientWithMockServers(t, []BroadcastHandler{broadcastHandler}, responses,
+		user.WithPollTime(10*time.Millisecond),
+		user.WithFeeBumping(user.FeeBumpPolicy{Multiplier: 2, MaxFee: 1_000_000}),
+	)
+
+	ctx := context.Background()
+	addr := txClient.DefaultAddress()
+	sequence := txClient.Signer().Account(txClient.DefaultAccountName()).Sequence()
+	msg := bank.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1)))
+	resp, err := txClient.BroadcastTx(ctx, []sdk.Msg{msg}, user.SetFee(1000), user.SetGasLimit(100_000))
+	require.NoError(t, err)
+
+	confirmed, err := txClient.ConfirmTx(ctx, resp.TxHash)
+	require.NoError(t, err)
+	require.Equal(t, "replacement-hash", confirmed.TxHash)
+	require.Equal(t, []string{"original-hash"}, confirmed.ReplacedTxHashes)
+
+	// The replacement has the same sequence as the original and twice the fee.
+	require.Len(t, broadcasts, 2)
+	for _, txBytes := range broadcasts {
+		decoded, err := txClient.Signer().DecodeTx(txBytes)
+		require.NoError(t, err)
+		sigs, err := decoded.GetSignaturesV2()
+		require.NoError(t, err)
+		require.Equal(t, sequence, sigs[0].Sequence)
+	}
+	original, err := txClient.Signer().DecodeTx(broadcasts[0])
+	require.NoError(t, err)
+	replacement, err := txClient.Signer().DecodeTx(broadcasts[1])
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), original.GetFee().AmountOf(appconsts.BondDenom).Int64())
+	require.Equal(t, int64(2000), replacement.GetFee().AmountOf(appconsts.BondDenom).Int64())
+	require.True(t, wasRemovedFromTxTracker("original-hash", txClient))
+	require.True(t, wasRemovedFromTxTracker("replacement-hash", txClient))
+	require.Equal(t, sequence+1, txClient.Signer().Account(txClient.DefaultAccountName()).Sequence())
+}
+
+// TestFeeBumpingOnChain verifies against a node that txs evicted from its
+// mempool are replaced by txs with a higher fee which are committed instead.
+func TestFeeBumpingOnChain(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping fee bumping test in short mode")
+	}
+
+	// Blocks fit a single tx and txs are evicted after one block.
+	blocksize := int64(1024 * 1024 * 2) // 2 MiB
+	_, txClient, ctx := utils.SetupTxClient(t, 1, 64, blocksize,
+		user.WithFeeBumping(user.FeeBumpPolicy{Multiplier: 2, MaxFee: 1e8}),
+	)
+	serviceClient := sdktx.NewServiceClient(ctx.GRPCClient)
+
+	fee := uint64(1e6)
+	responses := make([]*sdk.TxResponse, 5)
+	for i := range responses {
+		blobs := blobfactory.ManyRandBlobs(random.New(), 500000, 500000) // ~1.5MiB per transaction
+		resp, err := txClient.BroadcastPayForBlob(ctx.GoContext(), blobs, user.SetFee(fee), user.SetGasLimit(10e6))
+		require.NoError(t, err)
+		require.Equal(t, abci.CodeTypeOK, resp.Code)
+		responses[i] = resp
+	}
+
+	replaced := 0
+	for _, resp := range responses {
+		confirmed, err := txClient.ConfirmTx(ctx.GoContext(), resp.TxHash)
+		// A tx can be rejected if a tx with a lower sequence was evicted.
+		if err != nil && strings.Contains(err.Error(), "rejected") {
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, abci.CodeTypeOK, confirmed.Code)
+		if len(confirmed.ReplacedTxHashes) == 0 {
+			continue
+		}
+		replaced++
+
+		// The replacement landed on chain with a higher fee than the original.
+		require.NotEqual(t, resp.TxHash, confirmed.TxHash)
+		require.Equal(t, []string{resp.TxHash}, confirmed.ReplacedTxHashes)
+		getTxResp, err := utils.GetTxWithRetry(ctx.GoContext(), serviceClient, confirmed.TxHash)
+		require.NoError(t, err)
+		require.Equal(t, confirmed.Height, getTxResp.TxResponse.Height)
+		require.Greater(t, getTxResp.Tx.AuthInfo.Fee.Amount.AmountOf(appconsts.BondDenom).Uint64(), fee)
+	}
+	require.Positive(t, replaced, "no tx was replaced")
+}
@@ -0,0 +1,511 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/rpc/core"
+	dbm "github.com/cosmos/cosmos-db"
+	"google.golang.org/grpc"
+)
+
+// JournalTxStatus is the status of a transaction recorded in a TxJournal.
+type JournalTxStatus string
+
+const (
+	// JournalTxStatusPending is the status of a transaction that was accepted
+	// by a node but has not yet been committed.
+	JournalTxStatusPending JournalTxStatus = "pending"
+	// JournalTxStatusCommitted is the status of a transaction that was
+	// committed and executed successfully.
+	JournalTxStatusCommitted JournalTxStatus = "committed"
+	// JournalTxStatusFailed is the status of a transaction that was committed
+	// but failed during execution.
+	JournalTxStatusFailed JournalTxStatus = "failed"
+	// JournalTxStatusRejected is the status of a transaction that was rejected
+	// by the node after being accepted into the mempool.
+	JournalTxStatusRejected JournalTxStatus = "rejected"
+	// JournalTxStatusDropped is the status of a transaction that will never be
+	// committed, e.g. because it was evicted and could not be resubmitted or
+	// because the node no longer knows about it.
+	JournalTxStatusDropped JournalTxStatus = "dropped"
+)
+
+// IsFinal returns true if the status can no longer change.
+func (s JournalTxStatus) IsFinal() bool {
+	return s != JournalTxStatusPending
+}
+
+const (
+	// journalDBName is the name of the database created by OpenTxJournal.
+	journalDBName = "tx_journal"
+	// journalKeyPrefix prefixes the keys of all journal entries.
+	journalKeyPrefix = "tx/"
+)
+
+// JournalEntry is a transaction recorded in a TxJournal.
+type JournalEntry struct {
+	TxHash string `json:"tx_hash"`
+	// Signer is the name of the account that signed the transaction.
+	Signer string `json:"signer"`
+	// Address is the bech32 encoded address of the signer.
+	Address  string `json:"address"`
+	Sequence uint64 `json:"sequence"`
+	// TxBytes are the signed transaction bytes that were broadcast.
+	TxBytes []byte          `json:"tx_bytes"`
+	Status  JournalTxStatus `json:"status"`
+	// Height is the height at which the transaction was committed. It is zero
+	// unless the transaction was committed.
+	Height int64 `json:"height,omitempty"`
+	// Code is the execution code of a committed transaction.
+	Code uint32 `json:"code,omitempty"`
+	// Log describes why a transaction failed, was rejected or dropped.
+	Log         string    `json:"log,omitempty"`
+	SubmittedAt time.Time `json:"submitted_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TxJournal durably records the transactions submitted by a TxClient so that
+// a restarted client can confirm or resubmit transactions that were in flight
+// and so that submissions can be audited. TxJournal is thread-safe.
+//
+// Entries are written to disk in batches by a background goroutine so that
+// recording a transaction doesn't wait for the disk. Reads include the entries
+// that have not been written yet. Flush and Close write them immediately.
+type TxJournal struct {
+	mtx sync.Mutex
+	db  dbm.DB
+	// pending are the entries that have been recorded but not yet written,
+	// keyed by tx hash.
+	pending map[string]JournalEntry
+	// flushErr is the error of the last background write. It is returned by
+	// the next call that records an entry.
+	flushErr error
+
+	// flushMtx serialises writes so that a batch never overwrites the
+	// entries of a later batch.
+	flushMtx sync.Mutex
+	// flushSignal wakes up the background writer.
+	flushSignal chan struct{}
+	stop        chan struct{}
+	stopped     chan struct{}
+	closeOnce   sync.Once
+}
+
+// NewTxJournal returns a journal that stores its entries in db.
+func NewTxJournal(db dbm.DB) *TxJournal {
+	j := &TxJournal{
+		db:          db,
+		pending:     make(map[string]JournalEntry),
+		flushSignal: make(chan struct{}, 1),
+		stop:        make(chan struct{}),
+		stopped:     make(chan struct{}),
+	}
+	go j.writeLoop()
+	return j
+}
+
+// OpenTxJournal opens or creates a journal backed by a goleveldb database in
+// dir.
+func OpenTxJournal(dir string) (*TxJournal, error) {
+	db, err := dbm.NewDB(journalDBName, dbm.GoLevelDBBackend, dir)
+	if err != nil {
+		return nil, fmt.Errorf("opening tx journal: %w", err)
+	}
+	return NewTxJournal(db), nil
+}
+
+// Close writes the pending entries and closes the underlying database.
+func (j *TxJournal) Close() error {
+	j.closeOnce.Do(func() { close(j.stop) })
+	<-j.stopped
+	return errors.Join(j.Flush(), j.db.Close())
+}
+
+// Flush writes the entries that have been recorded but not yet written.
+func (j *TxJournal) Flush() error {
+	j.flushMtx.Lock()
+	defer j.flushMtx.Unlock()
+	return j.flush()
+}
+
+// flush is like Flush but must be called with flushMtx held.
+func (j *TxJournal) flush() error {
+	j.mtx.Lock()
+	entries := j.pending
+	j.pending = make(map[string]JournalEntry)
+	err := j.flushErr
+	j.flushErr = nil
+	j.mtx.Unlock()
+	if len(entries) == 0 {
+		return err
+	}
+
+	if writeErr := j.write(entries); writeErr != nil {
+		// Keep the entries that have not been recorded again in the meantime
+		// so that the next write retries them.
+		j.mtx.Lock()
+		for txHash, entry := range entries {
+			if _, exists := j.pending[txHash]; !exists {
+				j.pending[txHash] = entry
+			}
+		}
+		j.mtx.Unlock()
+		return writeErr
+	}
+	return err
+}
+
+// write writes entries in a single batch.
+func (j *TxJournal) write(entries map[string]JournalEntry) error {
+	batch := j.db.NewBatch()
+	defer batch.Close()
+	for txHash, entry := range entries {
+		bz, err := json.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("marshaling journal entry: %w", err)
+		}
+		if err := batch.Set(journalKey(txHash), bz); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
+// writeLoop writes the recorded entries in the background until the journal
+// is closed.
+func (j *TxJournal) writeLoop() {
+	defer close(j.stopped)
+	for {
+		select {
+		case <-j.stop:
+			return
+		case <-j.flushSignal:
+			if err := j.Flush(); err != nil {
+				j.mtx.Lock()
+				j.flushErr = err
+				j.mtx.Unlock()
+			}
+		}
+	}
+}
+
+// Put records entry, replacing any entry with the same tx hash. The entry is
+// written in the background, so the returned error is that of a previous
+// write, if any.
+func (j *TxJournal) Put(entry JournalEntry) error {
+	if entry.TxHash == "" {
+		return errors.New("journal entry must have a tx hash")
+	}
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	return j.put(entry)
+}
+
+// put records entry. It must be called with mtx held.
+func (j *TxJournal) put(entry JournalEntry) error {
+	j.pending[entry.TxHash] = entry
+	select {
+	case j.flushSignal <- struct{}{}:
+	default:
+	}
+	err := j.flushErr
+	j.flushErr = nil
+	return err
+}
+
+// Get returns the entry of the transaction with txHash.
+func (j *TxJournal) Get(txHash string) (JournalEntry, bool, error) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	return j.get(txHash)
+}
+
+// get returns the entry of the transaction with txHash. It must be called with
+// mtx held.
+func (j *TxJournal) get(txHash string) (JournalEntry, bool, error) {
+	if entry, exists := j.pending[txHash]; exists {
+		return entry, true, nil
+	}
+	bz, err := j.db.Get(journalKey(txHash))
+	if err != nil {
+		return JournalEntry{}, false, err
+	}
+	if bz == nil {
+		return JournalEntry{}, false, nil
+	}
+	var entry JournalEntry
+	if err := json.Unmarshal(bz, &entry); err != nil {
+		return JournalEntry{}, false, fmt.Errorf("unmarshaling journal entry %s: %w", txHash, err)
+	}
+	return entry, true, nil
+}
+
+// UpdateStatus sets the status of the transaction with txHash. height, code
+// and log are recorded alongside the status. Like Put, the entry is written in
+// the background.
+func (j *TxJournal) UpdateStatus(txHash string, status JournalTxStatus, height int64, code uint32, log string) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	entry, exists, err := j.get(txHash)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("tx %s not found in journal", txHash)
+	}
+	entry.Status = status
+	entry.Height = height
+	entry.Code = code
+	entry.Log = log
+	entry.UpdatedAt = time.Now()
+	return j.put(entry)
+}
+
+// Entries returns all entries in the journal ordered by tx hash.
+func (j *TxJournal) Entries() ([]JournalEntry, error) {
+	return j.filter(func(JournalEntry) bool { return true })
+}
+
+// Pending returns the entries of all transactions that have not yet reached
+// a final status, ordered by tx hash.
+func (j *TxJournal) Pending() ([]JournalEntry, error) {
+	return j.filter(func(entry JournalEntry) bool { return !entry.Status.IsFinal() })
+}
+
+// Prune deletes the entries of transactions that reached a final status
+// before the given time. It returns the number of deleted entries.
+func (j *TxJournal) Prune(before time.Time) (int, error) {
+	// Hold off background writes so that an entry that is recorded again
+	// while pruning is not deleted.
+	j.flushMtx.Lock()
+	defer j.flushMtx.Unlock()
+	if err := j.flush(); err != nil {
+		return 0, err
+	}
+
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	entries, err := j.scan(func(entry JournalEntry) bool {
+		_, recorded := j.pending[entry.TxHash]
+		return !recorded && entry.Status.IsFinal() && entry.UpdatedAt.Before(before)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	batch := j.db.NewBatch()
+	defer batch.Close()
+	for _, entry := range entries {
+		if err := batch.Delete(journalKey(entry.TxHash)); err != nil {
+			return 0, err
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return 0, err
+	}
+	return len(entries), nil
+}
+
+func (j *TxJournal) filter(keep func(JournalEntry) bool) ([]JournalEntry, error) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	return j.scan(keep)
+}
+
+// scan returns the entries for which keep returns true ordered by tx hash,
+// including those that have not been written yet. It must be called with mtx
+// held.
+func (j *TxJournal) scan(keep func(JournalEntry) bool) ([]JournalEntry, error) {
+	iterator, err := dbm.IteratePrefix(j.db, []byte(journalKeyPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var entries []JournalEntry
+	for ; iterator.Valid(); iterator.Next() {
+		var entry JournalEntry
+		if err := json.Unmarshal(iterator.Value(), &entry); err != nil {
+			return nil, fmt.Errorf("unmarshaling journal entry %s: %w", iterator.Key(), err)
+		}
+		if _, recorded := j.pending[entry.TxHash]; recorded {
+			continue
+		}
+		if keep(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	if err := iterator.Error(); err != nil {
+		return nil, err
+	}
+
+	for _, entry := range j.pending {
+		if keep(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, k int) bool { return entries[i].TxHash < entries[k].TxHash })
+	return entries, nil
+}
+
+func journalKey(txHash string) []byte {
+	return []byte(journalKeyPrefix + txHash)
+}
+
+// JournalReconciliation summarizes the outcome of TxClient.ReconcileJournal.
+// Each field lists tx hashes.
+type JournalReconciliation struct {
+	// Committed are transactions that were committed, whether or not they
+	// executed successfully.
+	Committed []string
+	// Pending are transactions that are still in the mempool.
+	Pending []string
+	// Resubmitted are transactions that were evicted or unknown to the node
+	// and were broadcast again.
+	Resubmitted []string
+	// Rejected are transactions that were rejected by the node.
+	Rejected []string
+	// Dropped are transactions that could not be resubmitted, typically
+	// because their sequence has been used by another transaction.
+	Dropped []string
+}
+
+// ReconcileJournal reconciles the pending transactions in the journal with the
+// chain. It is intended to be called once after a restart, before new
+// transactions are submitted. Committed and rejected transactions are recorded
+// as such, transactions that are still pending are tracked again so that
+// ConfirmTx can be called on them and transactions that are unknown to the
+// node are resubmitted. Finally the sequence of every signer is advanced past
+// its pending transactions.
+func (client *TxClient) ReconcileJournal(ctx context.Context) (*JournalReconciliation, error) {
+	if client.journal == nil {
+		return nil, errors.New("tx journal not configured")
+	}
+	entries, err := client.journal.Pending()
+	if err != nil {
+		return nil, fmt.Errorf("reading pending journal entries: %w", err)
+	}
+	// resubmit the txs of each signer in sequence order so that none of them
+	// fails with a sequence mismatch.
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Signer != entries[j].Signer {
+			return entries[i].Signer < entries[j].Signer
+		}
+		return entries[i].Sequence < entries[j].Sequence
+	})
+
+	result := &JournalReconciliation{}
+	// nextSequences maps each signer to the sequence following its last
+	// in-flight transaction.
+	nextSequences := make(map[string]uint64)
+	for _, entry := range entries {
+		// conns are the endpoints the tx is known to be in the mempool of.
+		var conns []*grpc.ClientConn
+		resp, err := client.txStatus(ctx, entry.TxHash)
+		if err != nil {
+			return nil, fmt.Errorf("querying status of tx %s: %w", entry.TxHash, err)
+		}
+
+		switch resp.Status {
+		case core.TxStatusCommitted:
+			status := JournalTxStatusCommitted
+			if resp.ExecutionCode != abci.CodeTypeOK {
+				status = JournalTxStatusFailed
+			}
+			if err := client.journal.UpdateStatus(entry.TxHash, status, resp.Height, resp.ExecutionCode, resp.Error); err != nil {
+				return nil, err
+			}
+			result.Committed = append(result.Committed, entry.TxHash)
+			continue
+		case core.TxStatusRejected:
+			if err := client.journal.UpdateStatus(entry.TxHash, JournalTxStatusRejected, 0, resp.ExecutionCode, resp.Error); err != nil {
+				return nil, err
+			}
+			result.Rejected = append(result.Rejected, entry.TxHash)
+			continue
+		case core.TxStatusPending:
+			result.Pending = append(result.Pending, entry.TxHash)
+		default:
+			// The tx was evicted or the node doesn't know about it, e.g.
+			// because it restarted as well.
+			_, conn, err := client.broadcastToBestEndpoint(ctx, entry.TxBytes)
+			if err != nil {
+				var broadcastTxErr *BroadcastTxError
+				if !errors.As(err, &broadcastTxErr) {
+					return nil, err
+				}
+				if err := client.journal.UpdateStatus(entry.TxHash, JournalTxStatusDropped, 0, broadcastTxErr.Code, broadcastTxErr.ErrorLog); err != nil {
+					return nil, err
+				}
+				result.Dropped = append(result.Dropped, entry.TxHash)
+				continue
+			}
+			conns = []*grpc.ClientConn{conn}
+			result.Resubmitted = append(result.Resubmitted, entry.TxHash)
+		}
+
+		client.restoreTransaction(entry, conns)
+		nextSequences[entry.Signer] = max(nextSequences[entry.Signer], entry.Sequence+1)
+	}
+
+	// accounts are loaded before taking the lock because that may query them.
+	for signer := range nextSequences {
+		if err := client.loadAccount(ctx, signer); err != nil {
+			return nil, err
+		}
+	}
+
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	for signer, sequence := range nextSequences {
+		if client.signer.Account(signer).Sequence() < sequence {
+			if err := client.signer.SetSequence(signer, sequence); err != nil {
+				return nil, fmt.Errorf("setting sequence: %w", err)
+			}
+		}
+	}
+	return result, nil
+}
+
+// loadAccount adds the keyring account with the given name to the signer
+// unless it already is. The account is queried without holding client.mtx.
+func (client *TxClient) loadAccount(ctx context.Context, account string) error {
+	client.mtx.Lock()
+	_, exists := client.signer.accounts[account]
+	client.mtx.Unlock()
+	if exists {
+		return nil
+	}
+
+	acc, err := client.queryKeyringAccount(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	if _, exists := client.signer.accounts[account]; exists {
+		return nil
+	}
+	return client.signer.AddAccount(acc)
+}
+
+// restoreTransaction adds a pending journal entry back to the tx tracker.
+func (client *TxClient) restoreTransaction(entry JournalEntry, conns []*grpc.ClientConn) {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	client.txTracker[entry.TxHash] = txInfo{
+		sequence:  entry.Sequence,
+		signer:    entry.Signer,
+		timestamp: time.Now(),
+		txBytes:   entry.TxBytes,
+		conns:     conns,
+	}
+}
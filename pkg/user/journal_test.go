@@ -0,0 +1,147 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v10/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v10/pkg/user"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/rpc/core"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxJournal(t *testing.T) {
+	journal := user.NewTxJournal(dbm.NewMemDB())
+	now := time.Now()
+
+	for _, hash := range []string{"a", "b", "c"} {
+		require.NoError(t, journal.Put(user.JournalEntry{
+			TxHash:      hash,
+			Signer:      "signer",
+			Sequence:    1,
+			TxBytes:     []byte(hash),
+			Status:      user.JournalTxStatusPending,
+			SubmittedAt: now,
+			UpdatedAt:   now,
+		}))
+	}
+	require.Error(t, journal.Put(user.JournalEntry{}))
+
+	require.NoError(t, journal.UpdateStatus("a", user.JournalTxStatusCommitted, 10, abci.CodeTypeOK, ""))
+	require.NoError(t, journal.UpdateStatus("b", user.JournalTxStatusFailed, 11, 5, "out of gas"))
+	require.Error(t, journal.UpdateStatus("missing", user.JournalTxStatusCommitted, 10, abci.CodeTypeOK, ""))
+
+	entry, exists, err := journal.Get("b")
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, user.JournalTxStatusFailed, entry.Status)
+	require.Equal(t, int64(11), entry.Height)
+	require.Equal(t, uint32(5), entry.Code)
+	require.Equal(t, "out of gas", entry.Log)
+
+	pending, err := journal.Pending()
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, "c", pending[0].TxHash)
+
+	// Only finalized entries are pruned.
+	pruned, err := journal.Prune(time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, 2, pruned)
+	entries, err := journal.Entries()
+	require.NoError(t, err)
+	require.Equal(t, pending, entries)
+}
+
+func TestOpenTxJournal(t *testing.T) {
+	dir := t.TempDir()
+	journal, err := user.OpenTxJournal(dir)
+	require.NoError(t, err)
+	require.NoError(t, journal.Put(user.JournalEntry{TxHash: "a", Status: user.JournalTxStatusPending}))
+	require.NoError(t, journal.Close())
+
+	// The entry survives reopening the journal.
+	journal, err = user.OpenTxJournal(dir)
+	require.NoError(t, err)
+	defer journal.Close()
+	_, exists, err := journal.Get("a")
+	require.NoError(t, err)
+	require.True(t, exists)
+}
+
+func TestTxJournalFlush(t *testing.T) {
+	db := dbm.NewMemDB()
+	journal := user.NewTxJournal(db)
+	require.NoError(t, journal.Put(user.JournalEntry{TxHash: "a", Status: user.JournalTxStatusPending}))
+	require.NoError(t, journal.UpdateStatus("a", user.JournalTxStatusCommitted, 10, abci.CodeTypeOK, ""))
+
+	// Recorded entries can be read whether or not they have been written.
+	entry, exists, err := journal.Get("a")
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, user.JournalTxStatusCommitted, entry.Status)
+
+	require.NoError(t, journal.Flush())
+	bz, err := db.Get([]byte("tx/a"))
+	require.NoError(t, err)
+	require.Contains(t, string(bz), string(user.JournalTxStatusCommitted))
+}
+
+func TestReconcileJournal(t *testing.T) {
+	journal := user.NewTxJournal(dbm.NewMemDB())
+	responses := map[string][]*tx.TxStatusResponse{
+		"committed": {{Status: core.TxStatusCommitted, Height: 10, ExecutionCode: abci.CodeTypeOK}},
+		"pending":   {{Status: core.TxStatusPending}},
+		"rejected":  {{Status: core.TxStatusRejected, ExecutionCode: 30}},
+		// The mock accepts the first broadcast and rejects the second one with
+		// a sequence mismatch, so unknown2, which has the lower sequence, must
+		// be resubmitted first.
+		"unknown1": {{Status: core.TxStatusUnknown}},
+		"unknown2": {{Status: core.TxStatusUnknown}},
+	}
+	txClient, _ := setupTxClientWithMockServers(t, []BroadcastHandler{nil}, responses, user.WithTxJournal(journal))
+	require.Equal(t, journal, txClient.TxJournal())
+
+	signer := txClient.DefaultAccountName()
+	sequence := txClient.Signer().Account(signer).Sequence()
+	for i, hash := range []string{"committed", "pending", "rejected", "unknown2", "unknown1"} {
+		require.NoError(t, journal.Put(user.JournalEntry{
+			TxHash:   hash,
+			Signer:   signer,
+			Sequence: sequence + uint64(i),
+			TxBytes:  []byte(hash),
+			Status:   user.JournalTxStatusPending,
+		}))
+	}
+
+	result, err := txClient.ReconcileJournal(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, &user.JournalReconciliation{
+		Committed:   []string{"committed"},
+		Pending:     []string{"pending"},
+		Resubmitted: []string{"unknown2"},
+		Rejected:    []string{"rejected"},
+		Dropped:     []string{"unknown1"},
+	}, result)
+
+	entry, _, err := journal.Get("committed")
+	require.NoError(t, err)
+	require.Equal(t, user.JournalTxStatusCommitted, entry.Status)
+	require.Equal(t, int64(10), entry.Height)
+
+	// Pending and resubmitted txs are tracked again and the sequence moves
+	// past the last one of them.
+	_, _, _, exists := txClient.GetTxFromTxTracker("pending")
+	require.True(t, exists)
+	seq, _, _, exists := txClient.GetTxFromTxTracker("unknown2")
+	require.True(t, exists)
+	require.Equal(t, sequence+3, seq)
+	require.Equal(t, sequence+4, txClient.Signer().Account(signer).Sequence())
+
+	pending, err := journal.Pending()
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+}
@@ -3,6 +3,7 @@ package user_test
 import (
 	"context"
 	"net"
+	"sync/atomic"
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/v10/app/grpc/gasestimation"
@@ -11,6 +12,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v10/pkg/user/utils"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/rpc/core"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/stretchr/testify/require"
@@ -29,9 +31,11 @@ type mockTxServer struct {
 	sdktx.UnimplementedServiceServer
 	tx.UnimplementedTxServer
 	gasestimation.UnimplementedGasEstimatorServer
+	nodeservice.UnimplementedServiceServer
 	txStatusResponses   map[string][]*tx.TxStatusResponse // txHash with sequence of responses
 	txStatusCallCounts  map[string]int                    // txHash with number of TxStatus calls made
 	broadcastCallCounts map[string]int                    // txHash with number of BroadcastTx calls made
+	height              atomic.Uint64                     // latest height, advanced by every Status call
 
 	// Optional custom handlers - if set, these override default behavior
 	broadcastHandler BroadcastHandler
@@ -53,6 +57,11 @@ func (m *mockTxServer) EstimateGasPriceAndUsage(_ context.Context, _ *gasestimat
 	}, nil
 }
 
+// Status returns a height that advances by one block with every call.
+func (m *mockTxServer) Status(_ context.Context, _ *nodeservice.StatusRequest) (*nodeservice.StatusResponse, error) {
+	return &nodeservice.StatusResponse{Height: m.height.Add(1)}, nil
+}
+
 // defaultTxStatusHandler implements the original default behavior for TxStatus
 func (m *mockTxServer) defaultTxStatusHandler(ctx context.Context, req *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
 	// Use predefined response sequences
@@ -131,6 +140,7 @@ func createMockServer(t *testing.T, txStatusResponses map[string][]*tx.TxStatusR
 	sdktx.RegisterServiceServer(s, mockServer)              // For BroadcastTx
 	tx.RegisterTxServer(s, mockServer)                      // For TxStatus
 	gasestimation.RegisterGasEstimatorServer(s, mockServer) // For gas estimation
+	nodeservice.RegisterServiceServer(s, mockServer)        // For the latest height
 
 	go func() {
 		if err := s.Serve(lis); err != nil {
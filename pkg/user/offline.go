@@ -0,0 +1,409 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v10/x/blob/types"
+	"github.com/celestiaorg/go-square/v4/share"
+	blobtx "github.com/celestiaorg/go-square/v4/tx"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"google.golang.org/grpc"
+)
+
+// offlineSignMode is the sign mode of offline signatures. Unlike
+// SIGN_MODE_DIRECT, the bytes it signs do not depend on the other signers of
+// the transaction, so every party can sign independently. It is also the sign
+// mode supported by Ledger devices and legacy amino multisig accounts.
+var offlineSignMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+
+// OfflineSigner is a signer of an OfflineTx together with the account number
+// and sequence it signs with.
+type OfflineSigner struct {
+	Address       string `json:"address"`
+	AccountNumber uint64 `json:"account_number"`
+	Sequence      uint64 `json:"sequence"`
+}
+
+// OfflineTx is an unsigned transaction that is exported to be signed offline
+// by one or more parties. It can be serialized as JSON.
+//
+// The offline workflow is:
+//  1. CreateOfflineTx or CreateOfflinePayForBlobs exports the transaction.
+//  2. Every party calls SignOfflineTx with a key in its keyring, which may be
+//     a Ledger device, and shares the resulting OfflineSignature.
+//  3. CombineOfflineTx adds the signatures to the transaction.
+//  4. TxClient.SubmitSignedTx broadcasts it.
+type OfflineTx struct {
+	ChainID string `json:"chain_id"`
+	// Tx is the encoded unsigned transaction. If the transaction pays for
+	// blobs, it is a BlobTx wrapping the transaction and its blobs.
+	Tx []byte `json:"tx"`
+	// Signers are the signers of the transaction in the order of its
+	// messages' signers.
+	Signers []OfflineSigner `json:"signers"`
+}
+
+// OfflineSignature is the signature of one party over an OfflineTx.
+type OfflineSignature struct {
+	// Signer is the address of the transaction signer the signature is for.
+	// It is a multisig account if the signature is one of its members'.
+	Signer string `json:"signer"`
+	// Signature is the signature encoded by TxConfig.MarshalSignatureJSON.
+	Signature json.RawMessage `json:"signature"`
+}
+
+// signer returns the signer of the transaction with the given address.
+func (t *OfflineTx) signer(address string) (OfflineSigner, error) {
+	for _, signer := range t.Signers {
+		if signer.Address == address {
+			return signer, nil
+		}
+	}
+	return OfflineSigner{}, fmt.Errorf("%s is not a signer of the transaction", address)
+}
+
+// CreateOfflineTx forms an unsigned transaction from the provided messages.
+// The signers must match the signers of the messages. TxOptions should be used
+// to set the gas limit and fee, as they can't be changed once the transaction
+// is signed.
+func (s *Signer) CreateOfflineTx(msgs []sdktypes.Msg, signers []OfflineSigner, opts ...TxOption) (*OfflineTx, error) {
+	builder, err := s.txBuilder(msgs, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	txSigners, err := builder.GetTx().GetSigners()
+	if err != nil {
+		return nil, fmt.Errorf("getting signers: %w", err)
+	}
+	if len(txSigners) != len(signers) {
+		return nil, fmt.Errorf("transaction has %d signers, got %d", len(txSigners), len(signers))
+	}
+	for i, txSigner := range txSigners {
+		addr, err := s.addressCodec.BytesToString(txSigner)
+		if err != nil {
+			return nil, fmt.Errorf("converting signer to string: %w", err)
+		}
+		if addr != signers[i].Address {
+			return nil, fmt.Errorf("signer %d of the transaction is %s, got %s", i, addr, signers[i].Address)
+		}
+	}
+
+	txBytes, err := s.EncodeTx(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	return &OfflineTx{
+		ChainID: s.chainID,
+		Tx:      txBytes,
+		Signers: signers,
+	}, nil
+}
+
+// CreateOfflinePayForBlobs forms an unsigned transaction that pays for the
+// blobs. The BlobTx wrapping the transaction and blobs is preserved when the
+// transaction is signed.
+func (s *Signer) CreateOfflinePayForBlobs(signer OfflineSigner, blobs []*share.Blob, opts ...TxOption) (*OfflineTx, error) {
+	msg, err := blobtypes.NewMsgPayForBlobs(signer.Address, appconsts.Version, blobs...)
+	if err != nil {
+		return nil, err
+	}
+
+	offlineTx, err := s.CreateOfflineTx([]sdktypes.Msg{msg}, []OfflineSigner{signer}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	offlineTx.Tx, err = blobtx.MarshalBlobTx(offlineTx.Tx, blobs...)
+	if err != nil {
+		return nil, err
+	}
+	return offlineTx, nil
+}
+
+// SignOfflineTx signs the transaction with the key keyName on behalf of the
+// transaction signer with address signerAddress. The key must either belong
+// to that signer or be a member of it if the signer is a multisig account.
+func (s *Signer) SignOfflineTx(offlineTx *OfflineTx, keyName, signerAddress string) (*OfflineSignature, error) {
+	if offlineTx.ChainID != s.chainID {
+		return nil, fmt.Errorf("transaction is for chain %s, signer is for chain %s", offlineTx.ChainID, s.chainID)
+	}
+
+	tx, _, _, err := s.decodeOfflineTx(offlineTx)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := offlineTx.signer(signerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.keys.Key(keyName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving key %s: %w", keyName, err)
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, fmt.Errorf("getting public key of key %s: %w", keyName, err)
+	}
+
+	bytesToSign, err := s.offlineSignBytes(tx, offlineTx.ChainID, signer, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	signature, _, err := s.keys.Sign(keyName, bytesToSign, offlineSignMode)
+	if err != nil {
+		return nil, fmt.Errorf("error signing bytes: %w", err)
+	}
+
+	signatureJSON, err := s.enc.MarshalSignatureJSON([]signing.SignatureV2{{
+		PubKey: pubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  offlineSignMode,
+			Signature: signature,
+		},
+		Sequence: signer.Sequence,
+	}})
+	if err != nil {
+		return nil, fmt.Errorf("encoding signature: %w", err)
+	}
+
+	return &OfflineSignature{
+		Signer:    signer.Address,
+		Signature: signatureJSON,
+	}, nil
+}
+
+// CombineOfflineTx adds the signatures to the transaction and returns the
+// encoded signed transaction, wrapped in a BlobTx if the transaction pays for
+// blobs. Every signer of the transaction needs either its own signature or, if
+// it is a multisig account, at least threshold signatures of its members. The
+// public key of a multisig account is looked up in the keyring, so it must
+// have been added with keyring.SaveMultisig.
+func (s *Signer) CombineOfflineTx(offlineTx *OfflineTx, signatures ...*OfflineSignature) ([]byte, error) {
+	tx, blobs, isBlobTx, err := s.decodeOfflineTx(offlineTx)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs := make([]signing.SignatureV2, len(offlineTx.Signers))
+	for i, signer := range offlineTx.Signers {
+		var parts []signing.SignatureV2
+		for _, signature := range signatures {
+			if signature.Signer != signer.Address {
+				continue
+			}
+			decoded, err := s.enc.UnmarshalSignatureJSON(signature.Signature)
+			if err != nil {
+				return nil, fmt.Errorf("decoding signature for %s: %w", signer.Address, err)
+			}
+			parts = append(parts, decoded...)
+		}
+
+		sigs[i], err = s.combineSignatures(tx, offlineTx.ChainID, signer, parts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	builder, err := s.enc.WrapTxBuilder(tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := builder.SetSignatures(sigs...); err != nil {
+		return nil, fmt.Errorf("error setting signatures: %w", err)
+	}
+
+	txBytes, err := s.EncodeTx(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	if isBlobTx {
+		return blobtx.MarshalBlobTx(txBytes, blobs...)
+	}
+	return txBytes, nil
+}
+
+// combineSignatures verifies the signatures of the signer and combines them
+// into the signature of the transaction.
+func (s *Signer) combineSignatures(tx authsigning.Tx, chainID string, signer OfflineSigner, parts []signing.SignatureV2) (signing.SignatureV2, error) {
+	if len(parts) == 0 {
+		return signing.SignatureV2{}, fmt.Errorf("missing signature of %s", signer.Address)
+	}
+	address, err := s.addressCodec.StringToBytes(signer.Address)
+	if err != nil {
+		return signing.SignatureV2{}, fmt.Errorf("converting signer to bytes: %w", err)
+	}
+
+	if len(parts) == 1 && sdktypes.AccAddress(address).Equals(sdktypes.AccAddress(parts[0].PubKey.Address())) {
+		data, ok := parts[0].Data.(*signing.SingleSignatureData)
+		if !ok || data.SignMode != offlineSignMode {
+			return signing.SignatureV2{}, fmt.Errorf("signature of %s must be a single %s signature", signer.Address, offlineSignMode)
+		}
+		bytesToSign, err := s.offlineSignBytes(tx, chainID, signer, parts[0].PubKey)
+		if err != nil {
+			return signing.SignatureV2{}, err
+		}
+		if !parts[0].PubKey.VerifySignature(bytesToSign, data.Signature) {
+			return signing.SignatureV2{}, fmt.Errorf("invalid signature of %s", signer.Address)
+		}
+		return parts[0], nil
+	}
+
+	// Otherwise the signatures are of the members of a multisig account.
+	record, err := s.keys.KeyByAddress(sdktypes.AccAddress(address))
+	if err != nil {
+		return signing.SignatureV2{}, fmt.Errorf("retrieving multisig key %s: %w", signer.Address, err)
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return signing.SignatureV2{}, fmt.Errorf("getting public key of %s: %w", signer.Address, err)
+	}
+	multisigPubKey, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+	if !ok {
+		return signing.SignatureV2{}, fmt.Errorf("%s is not a multisig account", signer.Address)
+	}
+
+	data := multisig.NewMultisig(len(multisigPubKey.PubKeys))
+	for _, part := range parts {
+		if err := multisig.AddSignatureFromPubKey(data, part.Data, part.PubKey, multisigPubKey.GetPubKeys()); err != nil {
+			return signing.SignatureV2{}, fmt.Errorf("adding signature to multisig %s: %w", signer.Address, err)
+		}
+	}
+
+	bytesToSign, err := s.offlineSignBytes(tx, chainID, signer, multisigPubKey)
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+	getSignBytes := func(signing.SignMode) ([]byte, error) { return bytesToSign, nil }
+	if err := multisigPubKey.VerifyMultisignature(getSignBytes, data); err != nil {
+		return signing.SignatureV2{}, fmt.Errorf("verifying multisig %s: %w", signer.Address, err)
+	}
+
+	return signing.SignatureV2{
+		PubKey:   multisigPubKey,
+		Data:     data,
+		Sequence: signer.Sequence,
+	}, nil
+}
+
+// offlineSignBytes returns the bytes the signer signs.
+func (s *Signer) offlineSignBytes(tx authsigning.Tx, chainID string, signer OfflineSigner, pubKey cryptotypes.PubKey) ([]byte, error) {
+	signerData := authsigning.SignerData{
+		Address:       signer.Address,
+		ChainID:       chainID,
+		AccountNumber: signer.AccountNumber,
+		Sequence:      signer.Sequence,
+		PubKey:        pubKey,
+	}
+	bytesToSign, err := authsigning.GetSignBytesAdapter(context.Background(), s.enc.SignModeHandler(), offlineSignMode, signerData, tx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting sign bytes: %w", err)
+	}
+	return bytesToSign, nil
+}
+
+// decodeOfflineTx decodes the transaction and, if it is wrapped in a BlobTx,
+// its blobs.
+func (s *Signer) decodeOfflineTx(offlineTx *OfflineTx) (authsigning.Tx, []*share.Blob, bool, error) {
+	if offlineTx == nil || len(offlineTx.Tx) == 0 {
+		return nil, nil, false, errors.New("offline transaction is empty")
+	}
+
+	txBytes := offlineTx.Tx
+	var blobs []*share.Blob
+	blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(txBytes)
+	if isBlobTx {
+		if err != nil {
+			return nil, nil, false, err
+		}
+		txBytes, blobs = blobTx.Tx, blobTx.Blobs
+	}
+
+	tx, err := s.DecodeTx(txBytes)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("decoding transaction: %w", err)
+	}
+	return tx, blobs, isBlobTx, nil
+}
+
+// QueryOfflineSigner returns the OfflineSigner of the account with the given
+// address with its current account number and sequence. The account doesn't
+// need to be in the TxClient's keyring.
+func (client *TxClient) QueryOfflineSigner(ctx context.Context, address sdktypes.AccAddress) (OfflineSigner, error) {
+	accNum, sequence, err := client.queryAccount(ctx, address)
+	if err != nil {
+		return OfflineSigner{}, fmt.Errorf("querying account %s: %w", address, err)
+	}
+	return OfflineSigner{
+		Address:       address.String(),
+		AccountNumber: accNum,
+		Sequence:      sequence,
+	}, nil
+}
+
+// SubmitSignedTx broadcasts a transaction that was signed outside of the
+// TxClient, e.g. by CombineOfflineTx, and waits for it to be confirmed.
+func (client *TxClient) SubmitSignedTx(ctx context.Context, txBytes []byte) (*TxResponse, error) {
+	resp, err := client.BroadcastSignedTx(ctx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.ConfirmTx(ctx, resp.TxHash)
+}
+
+// BroadcastSignedTx broadcasts a transaction that was signed outside of the
+// TxClient, e.g. by CombineOfflineTx. Blob txs are supported. If the
+// transaction is signed by one of the TxClient's accounts, the transaction is
+// tracked and the account's sequence is moved past the transaction's.
+// Transactions without signatures or whose first signer has no pubkey are
+// rejected before they are broadcast.
+func (client *TxClient) BroadcastSignedTx(ctx context.Context, txBytes []byte) (*sdktypes.TxResponse, error) {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+
+	client.pruneTxTracker()
+
+	decoded, err := client.decodeTransaction(txBytes)
+	if err != nil {
+		return nil, fmt.Errorf("decoding transaction: %w", err)
+	}
+
+	sigs, err := decoded.GetSignaturesV2()
+	if err != nil {
+		return nil, fmt.Errorf("getting signatures: %w", err)
+	}
+	if len(sigs) == 0 {
+		return nil, errors.New("transaction contains no signatures")
+	}
+	if sigs[0].PubKey == nil {
+		return nil, fmt.Errorf("tx signer %d has no associated pubkey", 0)
+	}
+
+	resp, conn, err := client.broadcastToBestEndpoint(ctx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	account := client.signer.AccountByAddress(sdktypes.AccAddress(sigs[0].PubKey.Address()))
+	if account == nil {
+		return resp, nil
+	}
+	client.trackTransactionWithSequence(ctx, account.Name(), sigs[0].Sequence, resp.TxHash, txBytes, []*grpc.ClientConn{conn})
+	if account.Sequence() <= sigs[0].Sequence {
+		if err := client.signer.SetSequence(account.Name(), sigs[0].Sequence+1); err != nil {
+			return nil, fmt.Errorf("setting sequence: %w", err)
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,189 @@
+package user_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v10/app"
+	"github.com/celestiaorg/celestia-app/v10/app/encoding"
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v10/pkg/user"
+	"github.com/celestiaorg/go-square/v4/share"
+	blobtx "github.com/celestiaorg/go-square/v4/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+const offlineChainID = "offline-chain"
+
+func newOfflineSigner(t *testing.T, names ...string) (*user.Signer, []cryptotypes.PubKey) {
+	t.Helper()
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := keyring.NewInMemory(encCfg.Codec)
+
+	pubKeys := make([]cryptotypes.PubKey, len(names))
+	for i, name := range names {
+		record, _, err := kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		pubKeys[i], err = record.GetPubKey()
+		require.NoError(t, err)
+	}
+
+	signer, err := user.NewSigner(kr, encCfg.TxConfig, offlineChainID)
+	require.NoError(t, err)
+	return signer, pubKeys
+}
+
+func TestOfflinePayForBlobs(t *testing.T) {
+	signer, pubKeys := newOfflineSigner(t, "alice")
+	offlineSigner := user.OfflineSigner{
+		Address:       sdk.AccAddress(pubKeys[0].Address()).String(),
+		AccountNumber: 7,
+		Sequence:      3,
+	}
+
+	blob := randomBlob(t)
+	offlineTx, err := signer.CreateOfflinePayForBlobs(offlineSigner, []*share.Blob{blob}, user.SetGasLimit(100_000), user.SetFee(2000))
+	require.NoError(t, err)
+
+	// The unsigned tx survives a JSON round trip.
+	bz, err := json.Marshal(offlineTx)
+	require.NoError(t, err)
+	var exported user.OfflineTx
+	require.NoError(t, json.Unmarshal(bz, &exported))
+	require.Equal(t, *offlineTx, exported)
+
+	_, err = signer.CombineOfflineTx(&exported)
+	require.Error(t, err, "combining without signatures should fail")
+
+	signature, err := signer.SignOfflineTx(&exported, "alice", offlineSigner.Address)
+	require.NoError(t, err)
+	txBytes, err := signer.CombineOfflineTx(&exported, signature)
+	require.NoError(t, err)
+
+	// The BlobTx wrapper and its blobs are preserved.
+	blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(txBytes)
+	require.NoError(t, err)
+	require.True(t, isBlobTx)
+	require.Len(t, blobTx.Blobs, 1)
+	require.Equal(t, blob.Data(), blobTx.Blobs[0].Data())
+
+	signedTx, err := signer.DecodeTx(blobTx.Tx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100_000), signedTx.GetGas())
+	sigs, err := signedTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.Equal(t, uint64(3), sigs[0].Sequence)
+	require.True(t, sigs[0].PubKey.Equals(pubKeys[0]))
+}
+
+func TestOfflineMultisig(t *testing.T) {
+	signer, pubKeys := newOfflineSigner(t, "alice", "bob", "carol")
+	multisigPubKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	_, err := signer.Keyring().SaveMultisig("multisig", multisigPubKey)
+	require.NoError(t, err)
+
+	multisigAddr := sdk.AccAddress(multisigPubKey.Address())
+	offlineSigner := user.OfflineSigner{Address: multisigAddr.String(), AccountNumber: 1, Sequence: 0}
+	msg := bank.NewMsgSend(multisigAddr, multisigAddr, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 10)))
+	offlineTx, err := signer.CreateOfflineTx([]sdk.Msg{msg}, []user.OfflineSigner{offlineSigner}, user.SetGasLimit(100_000), user.SetFee(2000))
+	require.NoError(t, err)
+
+	_, err = signer.CreateOfflineTx([]sdk.Msg{msg}, nil)
+	require.Error(t, err, "signers must match the signers of the messages")
+
+	aliceSig, err := signer.SignOfflineTx(offlineTx, "alice", offlineSigner.Address)
+	require.NoError(t, err)
+	carolSig, err := signer.SignOfflineTx(offlineTx, "carol", offlineSigner.Address)
+	require.NoError(t, err)
+
+	_, err = signer.CombineOfflineTx(offlineTx, aliceSig)
+	require.Error(t, err, "a single signature should not meet the threshold")
+
+	txBytes, err := signer.CombineOfflineTx(offlineTx, aliceSig, carolSig)
+	require.NoError(t, err)
+
+	signedTx, err := signer.DecodeTx(txBytes)
+	require.NoError(t, err)
+	sigs, err := signedTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.True(t, sigs[0].PubKey.Equals(multisigPubKey))
+	data, ok := sigs[0].Data.(*signing.MultiSignatureData)
+	require.True(t, ok)
+	require.Len(t, data.Signatures, 2)
+}
+
+func TestBroadcastSignedTx(t *testing.T) {
+	txClient, _ := setupTxClientWithMockServers(t, []BroadcastHandler{nil}, nil)
+
+	account := txClient.Account(txClient.DefaultAccountName())
+	offlineSigner := user.OfflineSigner{
+		Address:       account.Address().String(),
+		AccountNumber: account.AccountNumber(),
+		Sequence:      account.Sequence(),
+	}
+	msg := bank.NewMsgSend(account.Address(), account.Address(), sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 10)))
+	offlineTx, err := txClient.Signer().CreateOfflineTx([]sdk.Msg{msg}, []user.OfflineSigner{offlineSigner}, user.SetGasLimit(100_000), user.SetFee(2000))
+	require.NoError(t, err)
+	signature, err := txClient.Signer().SignOfflineTx(offlineTx, account.Name(), offlineSigner.Address)
+	require.NoError(t, err)
+	txBytes, err := txClient.Signer().CombineOfflineTx(offlineTx, signature)
+	require.NoError(t, err)
+
+	resp, err := txClient.BroadcastSignedTx(context.Background(), txBytes)
+	require.NoError(t, err)
+
+	// Transactions of the client's own accounts are tracked and move the
+	// account's sequence forward.
+	seq, signer, _, exists := txClient.GetTxFromTxTracker(resp.TxHash)
+	require.True(t, exists)
+	require.Equal(t, account.Name(), signer)
+	require.Equal(t, offlineSigner.Sequence, seq)
+	require.Equal(t, offlineSigner.Sequence+1, txClient.Account(account.Name()).Sequence())
+}
+
+func TestBroadcastSignedTxRejectsUnsignedTx(t *testing.T) {
+	txClient, _ := setupTxClientWithMockServers(t, []BroadcastHandler{nil}, nil)
+
+	account := txClient.Account(txClient.DefaultAccountName())
+	offlineSigner := user.OfflineSigner{
+		Address:       account.Address().String(),
+		AccountNumber: account.AccountNumber(),
+		Sequence:      account.Sequence(),
+	}
+	msg := bank.NewMsgSend(account.Address(), account.Address(), sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 10)))
+	offlineTx, err := txClient.Signer().CreateOfflineTx([]sdk.Msg{msg}, []user.OfflineSigner{offlineSigner}, user.SetGasLimit(100_000), user.SetFee(2000))
+	require.NoError(t, err)
+
+	t.Run("should reject a transaction without signatures", func(t *testing.T) {
+		_, err := txClient.BroadcastSignedTx(context.Background(), offlineTx.Tx)
+		require.ErrorContains(t, err, "contains no signatures")
+	})
+	t.Run("should reject a signature without a pubkey", func(t *testing.T) {
+		tx, err := txClient.Signer().DecodeTx(offlineTx.Tx)
+		require.NoError(t, err)
+		builder, err := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig.WrapTxBuilder(tx)
+		require.NoError(t, err)
+		require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: []byte("signature")},
+			Sequence: offlineSigner.Sequence,
+		}))
+		txBytes, err := txClient.Signer().EncodeTx(builder.GetTx())
+		require.NoError(t, err)
+
+		_, err = txClient.BroadcastSignedTx(context.Background(), txBytes)
+		require.ErrorContains(t, err, "has no associated pubkey")
+	})
+
+	// Rejected transactions are neither tracked nor move the sequence.
+	require.Equal(t, offlineSigner.Sequence, txClient.Account(account.Name()).Sequence())
+}
@@ -5,10 +5,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"strings"
 	"sync"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/feegrant"
 	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
 	"github.com/celestiaorg/go-square/v4/share"
@@ -16,18 +18,54 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-// SubmissionJob represents a transaction submission task for parallel processing
+// SubmissionJob represents a transaction submission task for parallel processing.
+// A job either pays for Blobs or submits arbitrary messages given by Msgs or
+// BuildMsgs.
 type SubmissionJob struct {
-	Blobs    []*share.Blob
+	Blobs []*share.Blob
+	// Msgs are messages with a fixed signer. The job is processed by the
+	// worker whose account is that signer, so the signer must be the default
+	// account or a worker account.
+	Msgs []sdktypes.Msg
+	// BuildMsgs builds the messages of the job for the worker account that
+	// processes it. It lets any worker process the job, with the default
+	// account paying the fees via its fee grant.
+	BuildMsgs func(signer sdktypes.AccAddress) ([]sdktypes.Msg, error)
+	// RequireGranter requires the job to be signed by the default account,
+	// i.e. the fee granter of all workers, e.g. because its messages move the
+	// default account's funds.
+	RequireGranter bool
+	// Group orders jobs: jobs with the same non-empty group are processed by
+	// the same worker, one after the other, in the order they were queued.
+	Group    string
 	Options  []TxOption
 	Ctx      context.Context
 	ResultsC chan SubmissionResult
 }
 
+// validate returns an error if the job is malformed.
+func (job *SubmissionJob) validate() error {
+	payloads := 0
+	if len(job.Blobs) > 0 {
+		payloads++
+	}
+	if len(job.Msgs) > 0 {
+		payloads++
+	}
+	if job.BuildMsgs != nil {
+		payloads++
+	}
+	if payloads != 1 {
+		return errors.New("submission job must have exactly one of blobs, msgs and build msgs")
+	}
+	return nil
+}
+
 // SubmissionResult contains the result of a parallel transaction submission
 type SubmissionResult struct {
 	Signer     string
@@ -37,7 +75,11 @@ type SubmissionResult struct {
 
 // txQueue manages parallel transaction submission
 type txQueue struct {
-	wg     sync.WaitGroup
+	wg sync.WaitGroup
+	// mtx guards ctx and cancel. submitJob holds it for reading while it
+	// enqueues a job so that stop can answer every job left in the queues
+	// once no more jobs can be enqueued.
+	mtx    sync.RWMutex
 	ctx    context.Context
 	cancel context.CancelFunc
 
@@ -53,6 +95,9 @@ type txWorker struct {
 	address     string
 	client      *TxClient
 	jobQueue    chan *SubmissionJob
+	// routedJobs receives the jobs that must be processed by this worker, in
+	// order.
+	routedJobs chan *SubmissionJob
 }
 
 const (
@@ -80,6 +125,15 @@ func isAccountNotFound(err error) bool {
 	return strings.Contains(err.Error(), "not found")
 }
 
+// isFeeGrantNotFound reports whether err indicates that a fee grant does not
+// exist.
+func isFeeGrantNotFound(err error) bool {
+	if status.Code(err) == codes.NotFound {
+		return true
+	}
+	return strings.Contains(err.Error(), feegrant.ErrNoAllowance.Error())
+}
+
 // queryAccountWithRetry calls query, retrying while the account is reported as
 // not found. This tolerates the window after a funding transaction is confirmed
 // but before the committed account state is queryable. It returns immediately on
@@ -132,6 +186,7 @@ func newTxQueue(client *TxClient, numWorkers int) *txQueue {
 			address:     address,
 			client:      client,
 			jobQueue:    pool.jobQueue,
+			routedJobs:  make(chan *SubmissionJob, defaultParallelQueueSize),
 		}
 		pool.workers[i] = worker
 	}
@@ -146,11 +201,14 @@ func (p *txQueue) start(ctx context.Context) error {
 		return fmt.Errorf("failed to initialize worker accounts: %w", err)
 	}
 
-	// Recreate job queue channel if it was closed during previous stop
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
 	p.jobQueue = make(chan *SubmissionJob, defaultParallelQueueSize)
 	// Update workers to use new job queue BEFORE starting goroutines
 	for _, worker := range p.workers {
 		worker.jobQueue = p.jobQueue
+		worker.routedJobs = make(chan *SubmissionJob, defaultParallelQueueSize)
 	}
 
 	// Create a new context for this pool instance
@@ -170,37 +228,105 @@ func (p *txQueue) start(ctx context.Context) error {
 
 // stop shuts down all workers in the pool
 func (p *txQueue) stop() {
-	if !p.isStarted() {
+	p.mtx.RLock()
+	cancel := p.cancel
+	p.mtx.RUnlock()
+	if cancel == nil {
 		return // Already stopped
 	}
 
-	p.cancel()
-
-	// Close the job queue to signal workers to stop accepting new jobs
-	close(p.jobQueue)
+	// Cancel the context to signal workers and blocked submitters to stop,
+	// then wait for the submitters to release the lock.
+	cancel()
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if p.ctx == nil {
+		return // Stopped concurrently
+	}
 
-	// Wait for all workers to finish before marking as stopped
+	// Wait for all workers to finish, then answer the jobs they left behind.
 	p.wg.Wait()
+	p.drain(p.jobQueue)
+	for _, worker := range p.workers {
+		p.drain(worker.routedJobs)
+	}
 
 	p.ctx, p.cancel = nil, nil
 }
 
+// drain answers every job left in queue with an error.
+func (p *txQueue) drain(queue chan *SubmissionJob) {
+	for {
+		select {
+		case job := <-queue:
+			job.ResultsC <- SubmissionResult{Error: errors.New("tx queue has stopped")}
+		default:
+			return
+		}
+	}
+}
+
 // submitJob submits a job to the parallel worker pool
 func (p *txQueue) submitJob(job *SubmissionJob) {
-	if !p.isStarted() {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	if p.ctx == nil {
 		job.ResultsC <- SubmissionResult{Error: errors.New("tx queue not started")}
 		return
 	}
 
+	if err := job.validate(); err != nil {
+		job.ResultsC <- SubmissionResult{Error: err}
+		return
+	}
+
+	worker, err := p.route(job)
+	if err != nil {
+		job.ResultsC <- SubmissionResult{Error: err}
+		return
+	}
+	queue := p.jobQueue
+	if worker != nil {
+		queue = worker.routedJobs
+	}
+
 	select {
-	case p.jobQueue <- job:
+	case queue <- job:
 	case <-p.ctx.Done():
 		job.ResultsC <- SubmissionResult{Error: errors.New("tx queue full or has stopped")}
 	}
 }
 
+// route returns the worker that must process the job, or nil if any worker
+// can process it.
+func (p *txQueue) route(job *SubmissionJob) (*txWorker, error) {
+	if job.RequireGranter {
+		return p.workers[0], nil
+	}
+	if len(job.Msgs) > 0 {
+		signer, err := p.client.msgsSigner(job.Msgs)
+		if err != nil {
+			return nil, err
+		}
+		for _, worker := range p.workers {
+			if worker.address == signer.String() {
+				return worker, nil
+			}
+		}
+		return nil, fmt.Errorf("signer %s is neither the default account nor a worker account", signer)
+	}
+	if job.Group != "" {
+		hash := fnv.New32a()
+		_, _ = hash.Write([]byte(job.Group))
+		return p.workers[hash.Sum32()%uint32(len(p.workers))], nil
+	}
+	return nil, nil
+}
+
 // isStarted returns whether the tx queue is started
 func (p *txQueue) isStarted() bool {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
 	return p.ctx != nil && p.cancel != nil
 }
 
@@ -208,13 +334,13 @@ func (p *txQueue) isStarted() bool {
 func (w *txWorker) start(ctx context.Context) {
 	for {
 		select {
-		case job, ok := <-w.jobQueue:
-			if !ok {
-				// Channel closed, exit worker
-				return
-			}
+		case job := <-w.jobQueue:
+			w.processJob(job, ctx)
+		case job := <-w.routedJobs:
 			w.processJob(job, ctx)
 		case <-ctx.Done():
+			// The tx queue answers the jobs left in the queues once all
+			// workers have exited.
 			return
 		}
 	}
@@ -245,6 +371,16 @@ func (w *txWorker) processJob(job *SubmissionJob, workerCtx context.Context) {
 		options = append([]TxOption{SetFeeGranter(w.client.DefaultAddress())}, options...)
 	}
 
+	if len(job.Blobs) == 0 {
+		txResponse, err := w.submitMsgs(jobCtx, job, options)
+		job.ResultsC <- SubmissionResult{
+			Signer:     w.address,
+			TxResponse: txResponse,
+			Error:      err,
+		}
+		return
+	}
+
 	// Fill in the signer for v1 blobs to match the transaction signer
 	workerAddrBytes := w.client.signer.Account(w.accountName).Address().Bytes()
 	for i, blob := range job.Blobs {
@@ -271,6 +407,52 @@ func (w *txWorker) processJob(job *SubmissionJob, workerCtx context.Context) {
 	job.ResultsC <- result
 }
 
+// submitMsgs signs the messages of the job with the worker's account and
+// submits them.
+func (w *txWorker) submitMsgs(ctx context.Context, job *SubmissionJob, options []TxOption) (*TxResponse, error) {
+	workerAddress := w.client.signer.Account(w.accountName).Address()
+	msgs := job.Msgs
+	if job.BuildMsgs != nil {
+		var err error
+		msgs, err = job.BuildMsgs(workerAddress)
+		if err != nil {
+			return nil, fmt.Errorf("building messages: %w", err)
+		}
+	}
+
+	signer, err := w.client.msgsSigner(msgs)
+	if err != nil {
+		return nil, err
+	}
+	if !signer.Equals(workerAddress) {
+		return nil, fmt.Errorf("messages must be signed by worker %s, got %s", workerAddress, signer)
+	}
+
+	return w.client.SubmitTx(ctx, msgs, options...)
+}
+
+// msgsSigner returns the single signer of msgs.
+func (client *TxClient) msgsSigner(msgs []sdktypes.Msg) (sdktypes.AccAddress, error) {
+	var signer sdktypes.AccAddress
+	for _, msg := range msgs {
+		signers, _, err := client.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return nil, fmt.Errorf("getting signers from message: %w", err)
+		}
+		if len(signers) != 1 {
+			return nil, fmt.Errorf("only one signer per transaction supported, got %d", len(signers))
+		}
+		if signer != nil && !bytes.Equal(signer, signers[0]) {
+			return nil, errors.New("not supported: got two different signers across multiple messages")
+		}
+		signer = signers[0]
+	}
+	if signer == nil {
+		return nil, errors.New("no messages to sign")
+	}
+	return signer, nil
+}
+
 // initializeWorkerAccounts creates and initializes all worker accounts for parallel submission.
 // It creates the accounts in the keyring if they don't exist, funds them with a small balance,
 // and sets up fee grants so the main account pays for transaction fees.
@@ -316,48 +498,6 @@ func (p *txQueue) initializeWorkerAccounts(ctx context.Context) error {
 	return nil
 }
 
-// createFeeGrantMessages creates fee grant messages for workers that don't already have grants
-func (client *TxClient) createFeeGrantMessages(ctx context.Context, workers []*txWorker) ([]sdktypes.Msg, uint64, error) {
-	msgs := make([]sdktypes.Msg, 0, len(workers))
-	totalGasLimit := uint64(0)
-	masterAddress := client.defaultAddress
-
-	for _, worker := range workers {
-		// Get worker address
-		record, err := client.signer.keys.Key(worker.accountName)
-		if err != nil {
-			return nil, 0, fmt.Errorf("failed to get worker account %s from keyring: %w", worker.accountName, err)
-		}
-
-		workerAddress, err := record.GetAddress()
-		if err != nil {
-			return nil, 0, fmt.Errorf("failed to get address for worker account %s: %w", worker.accountName, err)
-		}
-
-		// Check if fee grant already exists
-		hasGrant, err := client.hasFeeGrant(ctx, masterAddress, workerAddress)
-		if err != nil {
-			return nil, 0, fmt.Errorf("failed to check fee grant for worker %s: %w", worker.accountName, err)
-		}
-
-		if !hasGrant {
-			// Create feegrant message so master account pays for worker fees
-			feegrantMsg, err := feegrant.NewMsgGrantAllowance(
-				&feegrant.BasicAllowance{}, // Unlimited allowance
-				masterAddress,
-				workerAddress,
-			)
-			if err != nil {
-				return nil, 0, fmt.Errorf("failed to create feegrant message for worker %s: %w", worker.accountName, err)
-			}
-			msgs = append(msgs, feegrantMsg)
-			totalGasLimit += FeegrantGasLimit
-		}
-	}
-
-	return msgs, totalGasLimit, nil
-}
-
 // loadWorkerAccount loads an existing account from keyring into the signer
 func (client *TxClient) loadWorkerAccount(worker *txWorker) error {
 	// Get account from keyring
@@ -372,7 +512,7 @@ func (client *TxClient) loadWorkerAccount(worker *txWorker) error {
 	}
 
 	// Query account info from chain
-	accNum, seqNum, err := QueryAccount(context.Background(), client.conns[0], client.registry, workerAddress)
+	accNum, seqNum, err := client.queryAccount(context.Background(), workerAddress)
 	if err != nil {
 		return fmt.Errorf("failed to query worker account %s on chain: %w", worker.accountName, err)
 	}
@@ -407,11 +547,18 @@ func (client *TxClient) ensureAccountInKeyring(accountName string) error {
 
 // accountNeedsFunding checks if an account needs funding by querying its balance
 func (client *TxClient) accountNeedsFunding(ctx context.Context, address sdktypes.AccAddress) (bool, error) {
-	// Query account balance
-	balance, err := QueryAccountBalance(ctx, client.conns[0], client.registry, address, appconsts.BondDenom)
+	var balance sdktypes.Coin
+	err := client.endpoints.do(ctx, func(conn *grpc.ClientConn) (err error) {
+		balance, err = QueryAccountBalance(ctx, conn, client.registry, address, appconsts.BondDenom)
+		return err
+	})
 	if err != nil {
-		// If account doesn't exist, it needs funding
-		return true, nil
+		// An account that doesn't exist yet needs funding, but a failed query
+		// says nothing about the balance.
+		if isAccountNotFound(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("querying balance of %s: %w", address, err)
 	}
 
 	// Check if balance is less than the default worker balance
@@ -421,18 +568,58 @@ func (client *TxClient) accountNeedsFunding(ctx context.Context, address sdktype
 
 // hasFeeGrant checks if a fee grant exists between granter and grantee
 func (client *TxClient) hasFeeGrant(ctx context.Context, granter, grantee sdktypes.AccAddress) (bool, error) {
-	feegrantQuery := feegrant.NewQueryClient(client.conns[0])
-	_, err := feegrantQuery.Allowance(ctx, &feegrant.QueryAllowanceRequest{
-		Granter: granter.String(),
-		Grantee: grantee.String(),
+	err := client.endpoints.do(ctx, func(conn *grpc.ClientConn) error {
+		_, err := feegrant.NewQueryClient(conn).Allowance(ctx, &feegrant.QueryAllowanceRequest{
+			Granter: granter.String(),
+			Grantee: grantee.String(),
+		})
+		return err
 	})
 	if err != nil {
-		// If error contains "not found" or similar, grant doesn't exist
-		return false, nil
+		if isFeeGrantNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("querying fee grant of %s: %w", grantee, err)
 	}
 	return true, nil
 }
 
+// fundAndGrantMsgs returns the messages that fund grantee with amount utia
+// from the default account unless it already has a balance and grant it the
+// allowance unless the default account already pays for its fees, together
+// with their gas limit.
+func (client *TxClient) fundAndGrantMsgs(ctx context.Context, grantee sdktypes.AccAddress, amount uint64, allowance feegrant.FeeAllowanceI) ([]sdktypes.Msg, uint64, error) {
+	var msgs []sdktypes.Msg
+	var gasLimit uint64
+
+	needsFunding, err := client.accountNeedsFunding(ctx, grantee)
+	if err != nil {
+		return nil, 0, err
+	}
+	if needsFunding {
+		msgs = append(msgs, bank.NewMsgSend(
+			client.defaultAddress,
+			grantee,
+			sdktypes.NewCoins(sdktypes.NewCoin(appconsts.BondDenom, sdkmath.NewIntFromUint64(amount))),
+		))
+		gasLimit += SendGasLimit
+	}
+
+	hasGrant, err := client.hasFeeGrant(ctx, client.defaultAddress, grantee)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !hasGrant {
+		grantMsg, err := feegrant.NewMsgGrantAllowance(allowance, client.defaultAddress, grantee)
+		if err != nil {
+			return nil, 0, fmt.Errorf("creating fee grant for %s: %w", grantee, err)
+		}
+		msgs = append(msgs, grantMsg)
+		gasLimit += FeegrantGasLimit
+	}
+	return msgs, gasLimit, nil
+}
+
 // fundAndGrantWorkerAccounts sends funds to worker accounts and sets up fee grants
 func (client *TxClient) fundAndGrantWorkerAccounts(ctx context.Context, workers []*txWorker) error {
 	if len(workers) == 0 {
@@ -442,9 +629,6 @@ func (client *TxClient) fundAndGrantWorkerAccounts(ctx context.Context, workers
 	msgs := make([]sdktypes.Msg, 0, len(workers)*2) // Each worker needs up to 2 msgs: send + feegrant
 	totalGasLimit := uint64(0)
 
-	masterAddress := client.defaultAddress
-
-	// Create send messages for funding accounts that need funding
 	for _, worker := range workers {
 		// Get worker address
 		record, err := client.signer.keys.Key(worker.accountName)
@@ -457,38 +641,20 @@ func (client *TxClient) fundAndGrantWorkerAccounts(ctx context.Context, workers
 			return fmt.Errorf("failed to get address for worker account %s: %w", worker.accountName, err)
 		}
 
-		// Check if account already has sufficient balance
-		needsFunding, err := client.accountNeedsFunding(ctx, workerAddress)
+		// Fund the worker unless it already has a balance and let the master
+		// account pay for its fees with an unlimited allowance
+		workerMsgs, gasLimit, err := client.fundAndGrantMsgs(ctx, workerAddress, DefaultWorkerBalance, &feegrant.BasicAllowance{})
 		if err != nil {
-			// If we can't check balance, assume it needs funding to avoid blocking
-			needsFunding = true
-		}
-
-		if needsFunding {
-			// Create send message to fund the account
-			sendMsg := bank.NewMsgSend(
-				masterAddress,
-				workerAddress,
-				sdktypes.NewCoins(sdktypes.NewInt64Coin(appconsts.BondDenom, DefaultWorkerBalance)),
-			)
-			msgs = append(msgs, sendMsg)
-			totalGasLimit += SendGasLimit
+			return fmt.Errorf("failed to create initialization messages for worker %s: %w", worker.accountName, err)
 		}
+		msgs = append(msgs, workerMsgs...)
+		totalGasLimit += gasLimit
 	}
 
-	// Add fee grant messages
-	feeGrantMsgs, feeGrantGasLimit, err := client.createFeeGrantMessages(ctx, workers)
-	if err != nil {
-		return err
-	}
-	msgs = append(msgs, feeGrantMsgs...)
-	totalGasLimit += feeGrantGasLimit
-
 	// Submit the initialization transaction only if there are messages to send
 	if len(msgs) > 0 {
 		opts := []TxOption{SetGasLimit(totalGasLimit)}
-		_, err = client.SubmitTx(ctx, msgs, opts...)
-		if err != nil {
+		if _, err := client.SubmitTx(ctx, msgs, opts...); err != nil {
 			return fmt.Errorf("failed to submit initialization transaction: %w", err)
 		}
 	}
@@ -509,7 +675,7 @@ func (client *TxClient) fundAndGrantWorkerAccounts(ctx context.Context, workers
 		// confirmed at this point, but the committed account state can briefly
 		// lag behind, so retry while the account is reported as not found.
 		accNum, seqNum, err := queryAccountWithRetry(ctx, workerAccountQueryRetries, workerAccountQueryRetryDelay, func() (uint64, uint64, error) {
-			return QueryAccount(ctx, client.conns[0], client.registry, workerAddress)
+			return client.queryAccount(ctx, workerAddress)
 		})
 		if err != nil {
 			return fmt.Errorf("failed to query worker account %s on chain: %w", worker.accountName, err)
@@ -25,6 +25,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -568,3 +569,252 @@ func TestParallelSubmissionV1BlobSignerOverride(t *testing.T) {
 		}
 	}
 }
+
+func TestParallelSubmitMsgs(t *testing.T) {
+	t.Parallel()
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+
+	var mu sync.Mutex
+	statusStore := make(map[string]*tx.TxStatusResponse)
+	capturedTxs := make(map[string]sdktypes.Tx)
+	broadcastHandler := func(ctx context.Context, req *sdktx.BroadcastTxRequest) (*sdktx.BroadcastTxResponse, error) {
+		hash := hashTxBytes(req.TxBytes)
+		decodedTx, err := encCfg.TxConfig.TxDecoder()(req.TxBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		mu.Lock()
+		capturedTxs[hash] = decodedTx
+		statusStore[hash] = &tx.TxStatusResponse{
+			Height:        100,
+			ExecutionCode: abci.CodeTypeOK,
+			Status:        core.TxStatusCommitted,
+		}
+		mu.Unlock()
+
+		return &sdktx.BroadcastTxResponse{
+			TxResponse: &sdktypes.TxResponse{Code: abci.CodeTypeOK, TxHash: hash},
+		}, nil
+	}
+	txStatusHandler := func(ctx context.Context, req *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if resp, ok := statusStore[strings.ToUpper(req.TxId)]; ok {
+			return resp, nil
+		}
+		return &tx.TxStatusResponse{Status: core.TxStatusPending}, nil
+	}
+
+	client, conn := newMockTxClientWithCustomHandlers(t, broadcastHandler, txStatusHandler, []string{"parallel-worker-1"})
+	defer conn.Close()
+
+	ctx := context.Background()
+	opts := []user.TxOption{user.SetGasLimit(100_000), user.SetFee(1000)}
+	sendMsg := func(signer sdktypes.AccAddress) sdktypes.Msg {
+		return bank.NewMsgSend(signer, signer, sdktypes.NewCoins(sdktypes.NewInt64Coin("utia", 1)))
+	}
+	feeGranter := func(txHash string) sdktypes.AccAddress {
+		mu.Lock()
+		defer mu.Unlock()
+		feeTx, ok := capturedTxs[strings.ToUpper(txHash)].(sdktypes.FeeTx)
+		require.True(t, ok)
+		return feeTx.FeeGranter()
+	}
+
+	t.Run("messages with a fixed signer are processed by its worker", func(t *testing.T) {
+		resp, err := client.SubmitTxToQueue(ctx, []sdktypes.Msg{sendMsg(client.DefaultAddress())}, opts...)
+		require.NoError(t, err)
+		require.Equal(t, abci.CodeTypeOK, resp.Code)
+		require.Nil(t, feeGranter(resp.TxHash))
+	})
+
+	t.Run("built messages are signed by the processing worker", func(t *testing.T) {
+		numJobs := 6
+		resultsC := make(chan user.SubmissionResult, numJobs)
+		defer close(resultsC)
+		for range numJobs {
+			client.QueueJob(&user.SubmissionJob{
+				BuildMsgs: func(signer sdktypes.AccAddress) ([]sdktypes.Msg, error) {
+					return []sdktypes.Msg{sendMsg(signer)}, nil
+				},
+				Options:  opts,
+				Ctx:      ctx,
+				ResultsC: resultsC,
+			})
+		}
+
+		for range numJobs {
+			result := <-resultsC
+			require.NoError(t, result.Error)
+			require.Equal(t, abci.CodeTypeOK, result.TxResponse.Code)
+			if result.Signer == client.DefaultAddress().String() {
+				require.Nil(t, feeGranter(result.TxResponse.TxHash))
+			} else {
+				require.Equal(t, client.DefaultAddress(), feeGranter(result.TxResponse.TxHash))
+			}
+		}
+	})
+
+	t.Run("jobs requiring the granter are signed by the default account", func(t *testing.T) {
+		resultsC := make(chan user.SubmissionResult, 1)
+		defer close(resultsC)
+		client.QueueJob(&user.SubmissionJob{
+			BuildMsgs: func(signer sdktypes.AccAddress) ([]sdktypes.Msg, error) {
+				return []sdktypes.Msg{sendMsg(signer)}, nil
+			},
+			RequireGranter: true,
+			Options:        opts,
+			Ctx:            ctx,
+			ResultsC:       resultsC,
+		})
+
+		result := <-resultsC
+		require.NoError(t, result.Error)
+		require.Equal(t, client.DefaultAddress().String(), result.Signer)
+	})
+
+	t.Run("messages of an unknown signer are rejected", func(t *testing.T) {
+		_, err := client.SubmitTxToQueue(ctx, []sdktypes.Msg{sendMsg(testnode.RandomAddress().Bytes())}, opts...)
+		require.Error(t, err)
+	})
+
+	t.Run("jobs with both blobs and messages are rejected", func(t *testing.T) {
+		resultsC := make(chan user.SubmissionResult, 1)
+		defer close(resultsC)
+		client.QueueJob(&user.SubmissionJob{
+			Blobs:    []*share.Blob{randomBlob(t)},
+			Msgs:     []sdktypes.Msg{sendMsg(client.DefaultAddress())},
+			Ctx:      ctx,
+			ResultsC: resultsC,
+		})
+
+		result := <-resultsC
+		require.Error(t, result.Error)
+	})
+}
+
+func TestParallelSubmissionGroupOrdering(t *testing.T) {
+	t.Parallel()
+
+	broadcastHandler := func(ctx context.Context, req *sdktx.BroadcastTxRequest) (*sdktx.BroadcastTxResponse, error) {
+		return &sdktx.BroadcastTxResponse{
+			TxResponse: &sdktypes.TxResponse{Code: abci.CodeTypeOK, TxHash: hashTxBytes(req.TxBytes)},
+		}, nil
+	}
+	txStatusHandler := func(ctx context.Context, req *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+		return &tx.TxStatusResponse{Status: core.TxStatusCommitted}, nil
+	}
+
+	workerAccounts := []string{"parallel-worker-1", "parallel-worker-2"}
+	client, conn := newMockTxClientWithCustomHandlers(t, broadcastHandler, txStatusHandler, workerAccounts)
+	defer conn.Close()
+
+	var (
+		mu    sync.Mutex
+		order []int
+	)
+	numJobs := 5
+	resultsC := make(chan user.SubmissionResult, numJobs)
+	defer close(resultsC)
+	for i := range numJobs {
+		client.QueueJob(&user.SubmissionJob{
+			BuildMsgs: func(signer sdktypes.AccAddress) ([]sdktypes.Msg, error) {
+				mu.Lock()
+				order = append(order, i)
+				mu.Unlock()
+				return []sdktypes.Msg{bank.NewMsgSend(signer, signer, sdktypes.NewCoins(sdktypes.NewInt64Coin("utia", 1)))}, nil
+			},
+			Group:    "group",
+			Options:  []user.TxOption{user.SetGasLimit(100_000), user.SetFee(1000)},
+			Ctx:      context.Background(),
+			ResultsC: resultsC,
+		})
+	}
+
+	signers := make(map[string]struct{})
+	for range numJobs {
+		result := <-resultsC
+		require.NoError(t, result.Error)
+		signers[result.Signer] = struct{}{}
+	}
+
+	// All jobs of the group are processed by the same worker, in order.
+	require.Len(t, signers, 1)
+	require.Equal(t, []int{0, 1, 2, 3, 4}, order)
+}
+
+func TestParallelPoolStopAnswersQueuedJobs(t *testing.T) {
+	t.Parallel()
+
+	startedCh, releaseCh := make(chan struct{}), make(chan struct{})
+	var startOnce sync.Once
+	broadcastHandler := func(ctx context.Context, req *sdktx.BroadcastTxRequest) (*sdktx.BroadcastTxResponse, error) {
+		startOnce.Do(func() { close(startedCh) })
+		<-releaseCh
+		return &sdktx.BroadcastTxResponse{
+			TxResponse: &sdktypes.TxResponse{Code: abci.CodeTypeOK, TxHash: hashTxBytes(req.TxBytes)},
+		}, nil
+	}
+	txStatusHandler := func(ctx context.Context, req *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+		return &tx.TxStatusResponse{Status: core.TxStatusCommitted}, nil
+	}
+
+	workerAccounts := []string{"parallel-worker-1"}
+	client, conn := newMockTxClientWithCustomHandlers(t, broadcastHandler, txStatusHandler, workerAccounts)
+	defer conn.Close()
+
+	// The first job blocks its worker, so the other jobs of the group stay
+	// in the worker's queue.
+	numJobs := 10
+	resultsC := make(chan user.SubmissionResult, 2*numJobs)
+	queueJob := func() {
+		client.QueueJob(&user.SubmissionJob{
+			BuildMsgs: func(signer sdktypes.AccAddress) ([]sdktypes.Msg, error) {
+				return []sdktypes.Msg{bank.NewMsgSend(signer, signer, sdktypes.NewCoins(sdktypes.NewInt64Coin("utia", 1)))}, nil
+			},
+			Group:    "group",
+			Options:  []user.TxOption{user.SetGasLimit(100_000), user.SetFee(1000)},
+			Ctx:      context.Background(),
+			ResultsC: resultsC,
+		})
+	}
+	for range numJobs {
+		queueJob()
+	}
+	select {
+	case <-startedCh:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("broadcast handler was not invoked")
+	}
+
+	// Jobs queued while the queue stops must not panic and must be answered.
+	var wg sync.WaitGroup
+	for range numJobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			queueJob()
+		}()
+	}
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		client.StopTxQueueForTest()
+	}()
+	close(releaseCh)
+	wg.Wait()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timeout waiting for the tx queue to stop")
+	}
+
+	for range 2 * numJobs {
+		select {
+		case <-resultsC:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timeout waiting for the result of a queued job")
+		}
+	}
+}
@@ -288,21 +288,27 @@ func (s *Signer) signTransaction(builder client.TxBuilder) (string, uint64, erro
 	if err != nil {
 		return "", 0, err
 	}
+	return s.signTransactionWithSequence(builder, account, account.sequence)
+}
 
+// signTransactionWithSequence signs the transaction with the given sequence
+// instead of the account's current one. It is used to replace a transaction
+// that has already been broadcast.
+func (s *Signer) signTransactionWithSequence(builder client.TxBuilder, account *Account, sequence uint64) (string, uint64, error) {
 	// a dry run of the signing data
-	err = builder.SetSignatures(signing.SignatureV2{
+	err := builder.SetSignatures(signing.SignatureV2{
 		Data: &signing.SingleSignatureData{
 			SignMode:  s.signMode,
 			Signature: nil,
 		},
 		PubKey:   account.pubKey,
-		Sequence: account.sequence,
+		Sequence: sequence,
 	})
 	if err != nil {
 		return "", 0, fmt.Errorf("error setting draft signatures: %w", err)
 	}
 
-	signature, err := s.createSignature(builder, account, account.sequence)
+	signature, err := s.createSignature(builder, account, sequence)
 	if err != nil {
 		return "", 0, fmt.Errorf("error creating signature: %w", err)
 	}
@@ -313,13 +319,13 @@ func (s *Signer) signTransaction(builder client.TxBuilder) (string, uint64, erro
 			Signature: signature,
 		},
 		PubKey:   account.pubKey,
-		Sequence: account.sequence,
+		Sequence: sequence,
 	})
 	if err != nil {
 		return "", 0, fmt.Errorf("error setting signatures: %w", err)
 	}
 
-	return account.name, account.sequence, nil
+	return account.name, sequence, nil
 }
 
 func (s *Signer) createSignature(builder client.TxBuilder, account *Account, sequence uint64) ([]byte, error) {
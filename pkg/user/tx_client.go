@@ -1,11 +1,11 @@
 package user
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -34,7 +34,9 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 )
@@ -65,6 +67,9 @@ type txInfo struct {
 	signer    string
 	timestamp time.Time
 	txBytes   []byte
+	// conns are the endpoints that accepted the transaction into their
+	// mempool. Its status is polled from them.
+	conns []*grpc.ClientConn
 }
 
 // TxResponse is a response from the chain after
@@ -78,6 +83,10 @@ type TxResponse struct {
 	GasWanted int64
 	GasUsed   int64
 	Signers   []string
+	// ReplacedTxHashes are the hashes of the other versions of the
+	// transaction created by fee bumping. They share the sequence of the
+	// committed transaction so they can no longer be committed.
+	ReplacedTxHashes []string
 }
 
 // BroadcastTxError is an error that occurs when broadcasting a transaction.
@@ -181,8 +190,9 @@ func WithEstimatorService(conn *grpc.ClientConn) Option {
 }
 
 // WithAdditionalCoreEndpoints adds additional core endpoints to the TxClient.
-// For transaction submission, the client will attempt to use the primary endpoint
-// and the first two additional endpoints provided via this option.
+// Transactions are broadcast to the healthiest endpoints (see
+// WithBroadcastFanOut). Queries, gas estimation and confirmation use the
+// healthiest endpoint and fail over to the others.
 func WithAdditionalCoreEndpoints(conns []*grpc.ClientConn) Option {
 	return func(c *TxClient) {
 		c.conns = append(c.conns, conns...)
@@ -213,6 +223,16 @@ func WithParallelQueueSize(size int) Option {
 	}
 }
 
+// WithTxJournal records every submitted transaction in the provided journal.
+// The journal survives restarts so that a new TxClient can confirm or resubmit
+// transactions that were in flight via ReconcileJournal. The caller is
+// responsible for closing the journal.
+func WithTxJournal(journal *TxJournal) Option {
+	return func(c *TxClient) {
+		c.journal = journal
+	}
+}
+
 // TxClient is an abstraction for building, signing, and broadcasting Celestia transactions
 // It supports multiple accounts.
 // TxClient is thread-safe.
@@ -223,6 +243,17 @@ type TxClient struct {
 	registry codectypes.InterfaceRegistry
 	// list of core endpoints for tx submission (primary + additional)
 	conns []*grpc.ClientConn
+	// endpoints tracks the health of the core endpoints
+	endpoints *endpointPool
+	// broadcastFanOut is the number of endpoints each tx is broadcast to.
+	// Zero means all endpoints.
+	broadcastFanOut     int
+	healthCheckInterval time.Duration
+	maxHeightLag        int64
+	// stopMonitor stops the endpoint health checks and monitorDone is closed
+	// once they have stopped.
+	stopMonitor context.CancelFunc
+	monitorDone chan struct{}
 	// how often to poll the network for confirmation of a transaction
 	pollTime time.Duration
 	// sets the default account with which to submit transactions
@@ -230,10 +261,21 @@ type TxClient struct {
 	defaultAddress sdktypes.AccAddress
 	// txTracker maps the tx hash to the Sequence and signer of the transaction
 	// that was submitted to the chain
-	txTracker           map[string]txInfo
+	txTracker map[string]txInfo
+	// journal optionally persists the txTracker so that it survives restarts
+	journal *TxJournal
+	// feeBumpPolicy optionally replaces transactions that are evicted from
+	// the mempool with transactions paying a higher fee
+	feeBumpPolicy       *FeeBumpPolicy
 	gasEstimationClient gasestimation.GasEstimatorClient
 	// txQueue manages parallel transaction submission when enabled
 	txQueue *txQueue
+	// hooks are called as transactions move through their lifecycle
+	hooks   TxHooks
+	meter   metric.Meter
+	metrics *txClientMetrics
+	// endpointMetrics exports the stats of the core endpoints
+	endpointMetrics metric.Registration
 }
 
 // NewTxClient returns a new TxClient
@@ -267,12 +309,34 @@ func NewTxClient(
 		defaultAddress:      addr,
 		txTracker:           make(map[string]txInfo),
 		cdc:                 cdc,
-		gasEstimationClient: gasestimation.NewGasEstimatorClient(conn),
+		healthCheckInterval: DefaultEndpointHealthCheckInterval,
+		maxHeightLag:        DefaultMaxEndpointHeightLag,
+		hooks:               NoopTxHooks{},
+		meter:               otel.Meter("txclient"),
 	}
 
 	for _, opt := range options {
 		opt(txClient)
 	}
+	if txClient.feeBumpPolicy != nil {
+		if err := txClient.feeBumpPolicy.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid fee bump policy: %w", err)
+		}
+	}
+
+	txClient.metrics, err = newTxClientMetrics(txClient.meter)
+	if err != nil {
+		return nil, fmt.Errorf("creating metrics: %w", err)
+	}
+
+	txClient.endpoints = newEndpointPool(txClient.conns, txClient.maxHeightLag)
+	txClient.endpointMetrics, err = registerEndpointMetrics(txClient.meter, txClient.endpoints)
+	if err != nil {
+		return nil, fmt.Errorf("creating endpoint metrics: %w", err)
+	}
+	if txClient.gasEstimationClient == nil {
+		txClient.gasEstimationClient = failoverGasEstimatorClient{endpoints: txClient.endpoints}
+	}
 
 	// Always create a tx queue with at least 1 worker (the default account)
 	// unless already configured by WithTxWorkers option
@@ -285,7 +349,8 @@ func NewTxClient(
 
 // SetupTxClient initializes a TxClient by querying the chain ID and account
 // details for all accounts in the keyring, then starts the transaction queue.
-// The queue runs until the provided context is cancelled.
+// The queue and the endpoint health checks run until the provided context is
+// cancelled or the client is closed.
 func SetupTxClient(
 	ctx context.Context,
 	keys keyring.Keyring,
@@ -339,9 +404,48 @@ func SetupTxClient(
 		return nil, fmt.Errorf("failed to start tx queue: %w", err)
 	}
 
+	if len(txClient.conns) > 1 {
+		txClient.startEndpointMonitor(ctx)
+	}
+
 	return txClient, nil
 }
 
+// startEndpointMonitor checks the heights of the core endpoints in the
+// background until ctx is cancelled or the client is closed.
+func (client *TxClient) startEndpointMonitor(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	client.mtx.Lock()
+	client.stopMonitor, client.monitorDone = cancel, done
+	client.mtx.Unlock()
+	go func() {
+		defer close(done)
+		client.endpoints.monitor(ctx, client.healthCheckInterval)
+	}()
+}
+
+// Close stops the tx queue and the endpoint health checks and unregisters the
+// endpoint metrics. It does not close the gRPC connections, which are owned by
+// the caller.
+func (client *TxClient) Close() {
+	client.mtx.Lock()
+	stopMonitor, monitorDone := client.stopMonitor, client.monitorDone
+	client.stopMonitor, client.monitorDone = nil, nil
+	client.mtx.Unlock()
+
+	if stopMonitor != nil {
+		stopMonitor()
+		<-monitorDone
+	}
+	if client.txQueue != nil {
+		client.txQueue.stop()
+	}
+	if client.endpointMetrics != nil {
+		_ = client.endpointMetrics.Unregister()
+	}
+}
+
 // SubmitPayForBlob forms a transaction from the provided blobs, signs it, and submits it to the chain.
 // TxOptions may be provided to set the fee and gas limit.
 // This method broadcasts the transaction and waits for confirmation using the default account.
@@ -371,23 +475,54 @@ func (client *TxClient) SubmitPayForBlobToQueue(ctx context.Context, blobs []*sh
 // to the provided channel when the transaction is confirmed. The caller is responsible for creating and
 // closing the result channel.
 func (client *TxClient) QueueBlob(ctx context.Context, resultC chan SubmissionResult, blobs []*share.Blob, opts ...TxOption) {
+	client.QueueJob(&SubmissionJob{
+		Blobs:    blobs,
+		Options:  opts,
+		Ctx:      ctx,
+		ResultsC: resultC,
+	})
+}
+
+// SubmitTxToQueue submits messages to the parallel transaction queue and blocks until confirmed.
+// The messages are processed by the worker whose account signs them.
+func (client *TxClient) SubmitTxToQueue(ctx context.Context, msgs []sdktypes.Msg, opts ...TxOption) (*TxResponse, error) {
+	resultsC := make(chan SubmissionResult, 1)
+	defer close(resultsC)
+
+	client.QueueTx(ctx, resultsC, msgs, opts...)
+
+	result := <-resultsC
+	return result.TxResponse, result.Error
+}
+
+// QueueTx submits messages to the parallel transaction queue without blocking. The messages are
+// processed by the worker whose account signs them, which must be the default account or a worker
+// account. Use QueueJob with BuildMsgs to let any worker sign the messages. The result will be sent
+// to the provided channel when the transaction is confirmed. The caller is responsible for creating
+// and closing the result channel.
+func (client *TxClient) QueueTx(ctx context.Context, resultC chan SubmissionResult, msgs []sdktypes.Msg, opts ...TxOption) {
+	client.QueueJob(&SubmissionJob{
+		Msgs:     msgs,
+		Options:  opts,
+		Ctx:      ctx,
+		ResultsC: resultC,
+	})
+}
+
+// QueueJob submits a job to the parallel transaction queue without blocking. The result will be
+// sent to the job's result channel when the transaction is confirmed. The caller is responsible for
+// creating and closing the result channel.
+func (client *TxClient) QueueJob(job *SubmissionJob) {
 	if client.txQueue == nil {
-		resultC <- SubmissionResult{Error: errTxQueueNotConfigured}
+		job.ResultsC <- SubmissionResult{Error: errTxQueueNotConfigured}
 		return
 	}
 
 	if !client.txQueue.isStarted() {
-		resultC <- SubmissionResult{Error: errTxQueueNotStarted}
+		job.ResultsC <- SubmissionResult{Error: errTxQueueNotStarted}
 		return
 	}
 
-	job := &SubmissionJob{
-		Blobs:    blobs,
-		Options:  opts,
-		Ctx:      ctx,
-		ResultsC: resultC,
-	}
-
 	client.txQueue.submitJob(job)
 }
 
@@ -498,7 +633,7 @@ func (client *TxClient) BroadcastTx(ctx context.Context, msgs []sdktypes.Msg, op
 		gasLimit, err = client.estimateGas(ctx, txBuilder)
 		if err != nil {
 			// If not a sequence mismatch, return the error.
-			if ok, err := client.handleSequenceMismatch(err, txBuilder); !ok {
+			if ok, err := client.handleSequenceMismatch(ctx, err, txBuilder); !ok {
 				return nil, err
 			}
 
@@ -530,7 +665,7 @@ func (client *TxClient) BroadcastTx(ctx context.Context, msgs []sdktypes.Msg, op
 }
 
 // handleSequenceMismatch checks if the error is a sequence mismatch and corrects it by setting the sequence to the expected sequence.
-func (client *TxClient) handleSequenceMismatch(sequenceErr error, txBuilder client.TxBuilder) (bool, error) {
+func (client *TxClient) handleSequenceMismatch(ctx context.Context, sequenceErr error, txBuilder client.TxBuilder) (bool, error) {
 	account, err := client.signer.findAccount(txBuilder)
 	if err != nil {
 		return false, err
@@ -545,6 +680,8 @@ func (client *TxClient) handleSequenceMismatch(sequenceErr error, txBuilder clie
 	if err != nil {
 		return false, fmt.Errorf("parsing sequence mismatch: %w. RawLog: %s", err, err)
 	}
+	client.metrics.observeSequenceMismatch(ctx, account.Name())
+	client.hooks.OnSequenceMismatch(ctx, TxEvent{Signer: account.Name(), Sequence: expectedSequence})
 
 	if err = client.signer.SetSequence(account.Name(), expectedSequence); err != nil {
 		return false, fmt.Errorf("setting sequence: %w", err)
@@ -553,14 +690,17 @@ func (client *TxClient) handleSequenceMismatch(sequenceErr error, txBuilder clie
 }
 
 // routeTx routes to single or multi-connection handling
-func (client *TxClient) routeTx(ctx context.Context, txBytes []byte, signer string) (*sdktypes.TxResponse, error) {
+func (client *TxClient) routeTx(ctx context.Context, txBytes []byte, signer string) (resp *sdktypes.TxResponse, err error) {
 	span := trace.SpanFromContext(ctx)
+	client.hooks.OnSigned(ctx, TxEvent{Signer: signer, Sequence: client.signer.Account(signer).Sequence()})
+	done := client.metrics.observeSubmit(ctx, signer)
+	defer func() { done(err) }()
 
-	if len(client.conns) > 1 {
+	if conns := client.broadcastConns(); len(conns) > 1 {
 		span.AddEvent("txclient: broadcasting PFB to multiple endpoints",
-			trace.WithAttributes(attribute.Int("num_endpoints", len(client.conns))),
+			trace.WithAttributes(attribute.Int("num_endpoints", len(conns))),
 		)
-		return client.submitToMultipleConnections(ctx, txBytes, signer)
+		return client.submitToMultipleConnections(ctx, conns, txBytes, signer)
 	}
 	span.AddEvent("txclient: broadcasting PFB to single endpoint")
 	return client.submitToSingleConnection(ctx, txBytes, signer)
@@ -570,19 +710,15 @@ func (client *TxClient) routeTx(ctx context.Context, txBytes []byte, signer stri
 func (client *TxClient) submitToSingleConnection(ctx context.Context, txBytes []byte, signer string) (*sdktypes.TxResponse, error) {
 	span := trace.SpanFromContext(ctx)
 
-	resp, err := client.sendTxToConnection(ctx, client.conns[0], txBytes)
+	resp, conn, err := client.broadcastToBestEndpoint(ctx, txBytes)
 	if err != nil {
 		broadcastTxErr, ok := err.(*BroadcastTxError)
 		if !ok || !apperrors.IsNonceMismatchCode(broadcastTxErr.Code) {
 			return nil, err
 		}
 		// Handle sequence mismatch by updating to expected sequence and retrying
-		expectedSequence, err := apperrors.ParseExpectedSequence(broadcastTxErr.ErrorLog)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing sequence mismatch: %w. ErrorLog: %s", err, broadcastTxErr.ErrorLog)
-		}
-		if err = client.signer.SetSequence(signer, expectedSequence); err != nil {
-			return nil, fmt.Errorf("setting sequence: %w", err)
+		if err := client.handleBroadcastSequenceMismatch(ctx, signer, broadcastTxErr); err != nil {
+			return nil, err
 		}
 		// Retry with updated sequence
 		retryTxBytes, err := client.resignTransactionWithNewSequence(txBytes)
@@ -592,11 +728,12 @@ func (client *TxClient) submitToSingleConnection(ctx context.Context, txBytes []
 		}
 
 		span.AddEvent("txclient/submitToSingleConnection: successfully rebroadcasted tx after sequence mismatch")
+		client.metrics.observeResubmission(ctx, signer, resubmitReasonSequenceMismatch)
 		return client.submitToSingleConnection(ctx, retryTxBytes, signer)
 	}
 	// Save the sequence, signer and txBytes of the in the local txTracker
 	// before the sequence is incremented
-	client.trackTransaction(signer, resp.TxHash, txBytes)
+	client.trackTransaction(ctx, signer, resp.TxHash, txBytes, []*grpc.ClientConn{conn})
 
 	// Increment sequence after successful submission
 	if err := client.signer.IncrementSequence(signer); err != nil {
@@ -606,6 +743,22 @@ func (client *TxClient) submitToSingleConnection(ctx context.Context, txBytes []
 	return resp, nil
 }
 
+// handleBroadcastSequenceMismatch sets the sequence of signer to the sequence
+// that the node expected when it rejected a broadcast with a sequence
+// mismatch. It must be called with client.mtx held.
+func (client *TxClient) handleBroadcastSequenceMismatch(ctx context.Context, signer string, broadcastTxErr *BroadcastTxError) error {
+	expectedSequence, err := apperrors.ParseExpectedSequence(broadcastTxErr.ErrorLog)
+	if err != nil {
+		return fmt.Errorf("error parsing sequence mismatch: %w. ErrorLog: %s", err, broadcastTxErr.ErrorLog)
+	}
+	client.metrics.observeSequenceMismatch(ctx, signer)
+	client.hooks.OnSequenceMismatch(ctx, TxEvent{Signer: signer, Sequence: expectedSequence})
+	if err = client.signer.SetSequence(signer, expectedSequence); err != nil {
+		return fmt.Errorf("setting sequence: %w", err)
+	}
+	return nil
+}
+
 // sendTxToConnection broadcasts a transaction to the chain and returns the response.
 func (client *TxClient) sendTxToConnection(ctx context.Context, conn *grpc.ClientConn, txBytes []byte) (*sdktypes.TxResponse, error) {
 	span := trace.SpanFromContext(ctx)
@@ -635,6 +788,14 @@ func (client *TxClient) sendTxToConnection(ctx context.Context, conn *grpc.Clien
 
 // resignTransactionWithNewSequence creates a new transaction with updated sequence from existing tx bytes
 func (client *TxClient) resignTransactionWithNewSequence(txBytes []byte) ([]byte, error) {
+	return client.resignTransaction(txBytes, nil, nil)
+}
+
+// resignTransaction creates a new transaction from existing tx bytes. If fee
+// is not nil it replaces the fee of the transaction. If sequence is nil the
+// transaction is signed with the signer's current sequence, otherwise with the
+// given sequence.
+func (client *TxClient) resignTransaction(txBytes []byte, fee sdktypes.Coins, sequence *uint64) ([]byte, error) {
 	blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(txBytes)
 	if isBlobTx && err != nil {
 		return nil, err
@@ -662,14 +823,25 @@ func (client *TxClient) resignTransactionWithNewSequence(txBytes []byte) ([]byte
 	if memo := tx.GetMemo(); memo != "" {
 		txBuilder.SetMemo(memo)
 	}
-	if fee := tx.GetFee(); fee != nil {
+	if fee == nil {
+		fee = tx.GetFee()
+	}
+	if fee != nil {
 		txBuilder.SetFeeAmount(fee)
 	}
 	if gas := tx.GetGas(); gas > 0 {
 		txBuilder.SetGasLimit(gas)
 	}
 
-	_, _, err = client.signer.signTransaction(txBuilder)
+	if sequence == nil {
+		_, _, err = client.signer.signTransaction(txBuilder)
+	} else {
+		var account *Account
+		account, err = client.signer.findAccount(txBuilder)
+		if err == nil {
+			_, _, err = client.signer.signTransactionWithSequence(txBuilder, account, *sequence)
+		}
+	}
 	if err != nil {
 		return nil, fmt.Errorf("resigning transaction: %w", err)
 	}
@@ -692,27 +864,42 @@ func (client *TxClient) resignTransactionWithNewSequence(txBytes []byte) ([]byte
 
 // submitToMultipleConnections submits the transaction to multiple connections concurrently
 // and returns the response from the first successful submission.
-func (client *TxClient) submitToMultipleConnections(ctx context.Context, txBytes []byte, signer string) (*sdktypes.TxResponse, error) {
+func (client *TxClient) submitToMultipleConnections(ctx context.Context, conns []*grpc.ClientConn, txBytes []byte, signer string) (*sdktypes.TxResponse, error) {
 	span := trace.SpanFromContext(ctx)
 
 	respCh := make(chan *sdktypes.TxResponse, 1)
-	errCh := make(chan error, len(client.conns))
+	errCh := make(chan error, len(conns))
+	// accepted are the endpoints that accepted the tx before the others were
+	// cancelled.
+	var (
+		acceptedMtx sync.Mutex
+		accepted    []*grpc.ClientConn
+	)
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	var wg sync.WaitGroup
-	wg.Add(len(client.conns))
+	wg.Add(len(conns))
 
-	for _, conn := range client.conns {
+	for _, conn := range conns {
 		go func(conn *grpc.ClientConn) {
 			defer wg.Done()
 
+			start := time.Now()
 			resp, err := client.sendTxToConnection(ctx, conn, txBytes)
+			// Requests cancelled after another endpoint succeeded don't
+			// reflect the health of the endpoint.
+			if ctx.Err() == nil {
+				client.endpoints.record(conn, time.Since(start), err)
+			}
 			if err != nil {
 				errCh <- err
 				return
 			}
+			acceptedMtx.Lock()
+			accepted = append(accepted, conn)
+			acceptedMtx.Unlock()
 
 			// On first successful response, send it and cancel others
 			select {
@@ -733,7 +920,7 @@ func (client *TxClient) submitToMultipleConnections(ctx context.Context, txBytes
 
 	// Return first successful response, if any
 	if resp, ok := <-respCh; ok && resp != nil {
-		client.trackTransaction(signer, resp.TxHash, txBytes)
+		client.trackTransaction(ctx, signer, resp.TxHash, txBytes, accepted)
 
 		if err := client.signer.IncrementSequence(signer); err != nil {
 			return nil, fmt.Errorf("increment sequencing: %w", err)
@@ -764,21 +951,36 @@ func (client *TxClient) pruneTxTracker() {
 func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxResponse, error) {
 	span := trace.SpanFromContext(ctx)
 
-	txClient := tx.NewTxClient(client.conns[0])
-
 	pollTicker := time.NewTicker(client.pollTime)
 	defer pollTicker.Stop()
 	var evictionPollTimeStart *time.Time
+	var feeBump feeBumpState
+	start := time.Now()
 
 	for {
 		span.AddEvent("txclient/ConfirmTx: polling for TxStatus")
-		resp, err := txClient.TxStatus(ctx, &tx.TxStatusRequest{TxId: txHash})
+		resp, err := client.txStatus(ctx, txHash)
 		if err != nil {
 			return nil, err
 		}
 
+		// A replaced version of the tx may have been committed instead of the
+		// latest one.
+		if resp.Status != core.TxStatusCommitted && len(feeBump.superseded) > 0 {
+			committedHash, committedResp, err := client.findCommittedTx(ctx, feeBump.superseded)
+			if err != nil {
+				return nil, err
+			}
+			if committedResp != nil {
+				feeBump.superseded = append(removeHash(feeBump.superseded, committedHash), txHash)
+				txHash, resp = committedHash, committedResp
+			}
+		}
+
 		if evictionPollTimeStart != nil {
 			if time.Since(*evictionPollTimeStart) > evictionPollTimeOut {
+				client.metrics.observeConfirm(ctx, start, client.txSigner(txHash), "evicted")
+				client.updateJournal(ctx, txHash, JournalTxStatusDropped, 0, 0, "evicted and not resubmitted")
 				return nil, fmt.Errorf("eviction poll timeout: transaction %s was evicted ", txHash)
 			}
 		}
@@ -791,17 +993,28 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 			span.AddEvent("txclient/ConfirmTx: transaction committed", trace.WithAttributes(
 				attribute.Int("resp_code", int(resp.ExecutionCode)),
 			))
+			event := client.txEvent(txHash)
+			event.Height, event.Code, event.Log = resp.Height, resp.ExecutionCode, resp.Error
+			client.hooks.OnCommitted(ctx, event)
 			if resp.ExecutionCode != abci.CodeTypeOK {
 				span.RecordError(fmt.Errorf("txclient/ConfirmTx: execution error: %s", resp.Error))
+				client.metrics.observeConfirm(ctx, start, event.Signer, "failed")
+				client.updateJournal(ctx, txHash, JournalTxStatusFailed, resp.Height, resp.ExecutionCode, resp.Error)
 				client.deleteFromTxTracker(txHash)
+				client.dropSuperseded(ctx, txHash, feeBump.superseded)
 				return nil, client.buildExecutionError(txHash, resp)
 			}
 
 			span.AddEvent("txclient/ConfirmTx: transaction confirmed successfully")
+			client.metrics.observeConfirm(ctx, start, event.Signer, "committed")
+			client.updateJournal(ctx, txHash, JournalTxStatusCommitted, resp.Height, resp.ExecutionCode, "")
 			client.deleteFromTxTracker(txHash)
-			return client.buildTxResponse(txHash, resp), nil
+			client.dropSuperseded(ctx, txHash, feeBump.superseded)
+			txResp := client.buildTxResponse(txHash, resp)
+			txResp.ReplacedTxHashes = feeBump.superseded
+			return txResp, nil
 		case core.TxStatusEvicted:
-			_, _, txBytes, exists := client.GetTxFromTxTracker(txHash)
+			sequence, signer, txBytes, exists := client.GetTxFromTxTracker(txHash)
 			if !exists {
 				return nil, fmt.Errorf("tx: %s not found in txTracker; likely failed during broadcast", txHash)
 			}
@@ -815,9 +1028,25 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 			span.AddEvent("txclient/ConfirmTx: transaction evicted, attempting resubmission", trace.WithAttributes(
 				attribute.String("tx_hash", txHash),
 			))
+			client.metrics.observeEviction(ctx, signer)
+			client.hooks.OnEvicted(ctx, TxEvent{Signer: signer, TxHash: txHash, Sequence: sequence})
+
+			// The node no longer holds the tx, so it accepts a replacement
+			// with the same sequence and a higher fee.
+			if client.feeBumpPolicy != nil {
+				replacementHash, replaced, err := client.bumpFee(ctx, txHash)
+				if err != nil {
+					return nil, err
+				}
+				if replaced {
+					feeBump.superseded = append(feeBump.superseded, txHash)
+					txHash = replacementHash
+					break
+				}
+			}
 
 			// If we're not already tracking eviction timeout, try to resubmit
-			_, err := client.sendTxToConnection(ctx, client.conns[0], txBytes)
+			_, conn, err := client.broadcastToBestEndpoint(ctx, txBytes)
 			if err != nil {
 				// Check if the error is a broadcast tx error
 				_, ok := err.(*BroadcastTxError)
@@ -828,23 +1057,35 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 				span.AddEvent("txclient/ConfirmTx: starting eviction timer for broadcast error")
 				now := time.Now()
 				evictionPollTimeStart = &now
+				break
 			}
 			span.AddEvent("txclient/ConfirmTx: transaction resubmitted successfully after eviction")
+			client.addTxConn(txHash, conn)
+			client.metrics.observeResubmission(ctx, signer, resubmitReasonEviction)
+			client.hooks.OnBroadcast(ctx, TxEvent{Signer: signer, TxHash: txHash, Sequence: sequence})
 		case core.TxStatusRejected:
 			span.RecordError(fmt.Errorf("txclient/ConfirmTx: transaction rejected: %s", resp.Error))
 			sequence, signer, _, exists := client.GetTxFromTxTracker(txHash)
 			if !exists {
 				return nil, fmt.Errorf("tx: %s not found in tx client txTracker; likely failed during broadcast", txHash)
 			}
+			client.metrics.observeRejection(ctx, signer)
+			client.metrics.observeConfirm(ctx, start, signer, "rejected")
+			client.hooks.OnRejected(ctx, TxEvent{Signer: signer, TxHash: txHash, Sequence: sequence, Code: resp.ExecutionCode, Log: resp.Error})
 			// Reset sequence to the rejected tx's sequence to enable resubmission
 			// of subsequent transactions.
 			if err := client.signer.SetSequence(signer, sequence); err != nil {
 				return nil, fmt.Errorf("setting sequence: %w", err)
 			}
+			client.updateJournal(ctx, txHash, JournalTxStatusRejected, 0, resp.ExecutionCode, resp.Error)
 			client.deleteFromTxTracker(txHash)
 			return nil, fmt.Errorf("tx with hash %s was rejected by the node with execution code: %d and log: %s", txHash, resp.ExecutionCode, resp.Error)
 		default:
 			span.RecordError(fmt.Errorf("txclient/ConfirmTx: unknown tx status for tx: %s", txHash))
+			if ctx.Err() == nil {
+				client.metrics.observeConfirm(ctx, start, client.txSigner(txHash), "unknown")
+				client.updateJournal(ctx, txHash, JournalTxStatusDropped, 0, 0, "unknown to the node")
+			}
 			client.deleteFromTxTracker(txHash)
 			if ctx.Err() != nil {
 				return nil, ctx.Err()
@@ -874,6 +1115,18 @@ func extractSequenceError(fullError string) string {
 	return s
 }
 
+// txEvent returns an event for the tracked transaction with txHash. Only the
+// tx hash is set if the transaction is not tracked.
+func (client *TxClient) txEvent(txHash string) TxEvent {
+	sequence, signer, _, _ := client.GetTxFromTxTracker(txHash)
+	return TxEvent{Signer: signer, TxHash: txHash, Sequence: sequence}
+}
+
+// txSigner returns the signer of the tracked transaction with txHash.
+func (client *TxClient) txSigner(txHash string) string {
+	return client.txEvent(txHash).Signer
+}
+
 // deleteFromTxTracker safely deletes a transaction from the local tx tracker.
 func (client *TxClient) deleteFromTxTracker(txHash string) {
 	client.mtx.Lock()
@@ -921,7 +1174,7 @@ func (client *TxClient) EstimateGasPriceAndUsage(
 		if err == nil {
 			break
 		}
-		if ok, err := client.handleSequenceMismatch(err, txBuilder); !ok {
+		if ok, err := client.handleSequenceMismatch(ctx, err, txBuilder); !ok {
 			return 0, 0, err
 		}
 
@@ -1034,42 +1287,161 @@ func (client *TxClient) DefaultAccountName() string { return client.defaultAccou
 // transactions to (e.g. the fibre escrow balance query).
 func (client *TxClient) GRPCConn() *grpc.ClientConn { return client.conns[0] }
 
+// EndpointStats returns the health of every core endpoint in the order in
+// which they were configured. The stats are also exported as metrics with the
+// meter of the client (see WithMeter).
+func (client *TxClient) EndpointStats() []EndpointStats {
+	return client.endpoints.stats()
+}
+
+// broadcastConns returns the connections of the endpoints a tx is broadcast
+// to.
+func (client *TxClient) broadcastConns() []*grpc.ClientConn {
+	conns := client.endpoints.ranked()
+	if client.broadcastFanOut > 0 && client.broadcastFanOut < len(conns) {
+		conns = conns[:client.broadcastFanOut]
+	}
+	return conns
+}
+
+// broadcastToBestEndpoint broadcasts a transaction to the healthiest endpoint,
+// failing over to the others if it is unavailable. It returns the connection
+// of the endpoint that accepted the transaction.
+func (client *TxClient) broadcastToBestEndpoint(ctx context.Context, txBytes []byte) (resp *sdktypes.TxResponse, accepted *grpc.ClientConn, err error) {
+	err = client.endpoints.do(ctx, func(conn *grpc.ClientConn) error {
+		resp, err = client.sendTxToConnection(ctx, conn, txBytes)
+		if err == nil {
+			accepted = conn
+		}
+		return err
+	})
+	return resp, accepted, err
+}
+
+// txStatus queries the status of a transaction from the endpoints it was
+// broadcast to. Other endpoints may not have received the transaction and
+// report it as unknown or evicted while it is still pending elsewhere, so
+// they are only queried, from the healthiest to the least healthy, if the
+// endpoints the transaction was broadcast to are not known.
+//
+// A committed or rejected status is returned as soon as an endpoint reports
+// it. Otherwise the most live status is returned: pending over evicted over
+// unknown.
+func (client *TxClient) txStatus(ctx context.Context, txHash string) (resp *tx.TxStatusResponse, err error) {
+	req := &tx.TxStatusRequest{TxId: txHash}
+	conns := client.txConns(txHash)
+	if len(conns) == 0 {
+		err = client.endpoints.do(ctx, func(conn *grpc.ClientConn) error {
+			resp, err = tx.NewTxClient(conn).TxStatus(ctx, req)
+			return err
+		})
+		return resp, err
+	}
+
+	var errs []error
+	for _, conn := range conns {
+		start := time.Now()
+		connResp, err := tx.NewTxClient(conn).TxStatus(ctx, req)
+		client.endpoints.record(conn, time.Since(start), err)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			errs = append(errs, err)
+			continue
+		}
+		switch connResp.Status {
+		case core.TxStatusCommitted, core.TxStatusRejected:
+			return connResp, nil
+		}
+		if resp == nil || txStatusLiveness(connResp.Status) > txStatusLiveness(resp.Status) {
+			resp = connResp
+		}
+	}
+	if resp == nil {
+		return nil, errors.Join(errs...)
+	}
+	return resp, nil
+}
+
+// txStatusLiveness ranks the non-final statuses of a transaction by how
+// likely it is to still be committed.
+func txStatusLiveness(status string) int {
+	switch status {
+	case core.TxStatusPending:
+		return 2
+	case core.TxStatusEvicted:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// txConns returns the endpoints the tracked transaction with txHash was
+// broadcast to.
+func (client *TxClient) txConns(txHash string) []*grpc.ClientConn {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	return slices.Clone(client.txTracker[txHash].conns)
+}
+
+// addTxConn records that the endpoint of conn accepted the tracked
+// transaction with txHash.
+func (client *TxClient) addTxConn(txHash string, conn *grpc.ClientConn) {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	info, ok := client.txTracker[txHash]
+	if !ok || slices.Contains(info.conns, conn) {
+		return
+	}
+	info.conns = append(slices.Clone(info.conns), conn)
+	client.txTracker[txHash] = info
+}
+
+// queryAccount queries the account number and sequence of address from the
+// healthiest endpoint, failing over to the others if it is unavailable.
+func (client *TxClient) queryAccount(ctx context.Context, address sdktypes.AccAddress) (accNum, seqNum uint64, err error) {
+	err = client.endpoints.do(ctx, func(conn *grpc.ClientConn) error {
+		accNum, seqNum, err = QueryAccount(ctx, conn, client.registry, address)
+		return err
+	})
+	return accNum, seqNum, err
+}
+
 func (client *TxClient) checkAccountLoaded(ctx context.Context, account string) error {
 	if _, exists := client.signer.accounts[account]; exists {
 		return nil
 	}
+	acc, err := client.queryKeyringAccount(ctx, account)
+	if err != nil {
+		return err
+	}
+	return client.signer.AddAccount(acc)
+}
+
+// queryKeyringAccount queries the account number and sequence of the keyring
+// account with the given name.
+func (client *TxClient) queryKeyringAccount(ctx context.Context, account string) (*Account, error) {
 	record, err := client.signer.keys.Key(account)
 	if err != nil {
-		return fmt.Errorf("trying to find account %s on keyring: %w", account, err)
+		return nil, fmt.Errorf("trying to find account %s on keyring: %w", account, err)
 	}
 	addr, err := record.GetAddress()
 	if err != nil {
-		return fmt.Errorf("retrieving address from keyring: %w", err)
+		return nil, fmt.Errorf("retrieving address from keyring: %w", err)
 	}
 	// FIXME: have a less trusting way of getting the account number and sequence
-	accNum, sequence, err := QueryAccount(ctx, client.conns[0], client.registry, addr)
+	accNum, sequence, err := client.queryAccount(ctx, addr)
 	if err != nil {
-		return fmt.Errorf("querying account %s: %w", account, err)
+		return nil, fmt.Errorf("querying account %s: %w", account, err)
 	}
-	return client.signer.AddAccount(NewAccount(account, accNum, sequence))
+	return NewAccount(account, accNum, sequence), nil
 }
 
 func (client *TxClient) getAccountNameFromMsgs(msgs []sdktypes.Msg) (string, error) {
-	var addr sdktypes.AccAddress
-	for _, msg := range msgs {
-		signers, _, err := client.cdc.GetMsgV1Signers(msg)
-		if err != nil {
-			return "", fmt.Errorf("getting signers from message: %w", err)
-		}
-		if len(signers) != 1 {
-			return "", fmt.Errorf("only one signer per transaction supported, got %d", len(signers))
-		}
-		if addr == nil {
-			addr = signers[0]
-		}
-		if !bytes.Equal(addr, signers[0]) {
-			return "", errors.New("not supported: got two different signers across multiple messages")
-		}
+	addr, err := client.msgsSigner(msgs)
+	if err != nil {
+		return "", err
 	}
 	record, err := client.signer.keys.KeyByAddress(addr)
 	if err != nil {
@@ -1080,22 +1452,75 @@ func (client *TxClient) getAccountNameFromMsgs(msgs []sdktypes.Msg) (string, err
 
 // trackTransaction tracks a transaction without acquiring the mutex.
 // This should only be called when the caller already holds the mutex.
-func (client *TxClient) trackTransaction(signer, txHash string, txBytes []byte) {
-	sequence := client.signer.Account(signer).Sequence()
+// If a journal is configured, the transaction is also recorded as pending.
+func (client *TxClient) trackTransaction(ctx context.Context, signer, txHash string, txBytes []byte, conns []*grpc.ClientConn) {
+	client.trackTransactionWithSequence(ctx, signer, client.signer.Account(signer).Sequence(), txHash, txBytes, conns)
+}
+
+// trackTransactionWithSequence is like trackTransaction but records the given
+// sequence instead of the signer's current one.
+func (client *TxClient) trackTransactionWithSequence(ctx context.Context, signer string, sequence uint64, txHash string, txBytes []byte, conns []*grpc.ClientConn) {
+	now := time.Now()
 	client.txTracker[txHash] = txInfo{
 		sequence:  sequence,
 		signer:    signer,
-		timestamp: time.Now(),
+		timestamp: now,
 		txBytes:   txBytes,
+		conns:     conns,
+	}
+	client.hooks.OnBroadcast(ctx, TxEvent{Signer: signer, TxHash: txHash, Sequence: sequence})
+
+	if client.journal == nil {
+		return
+	}
+	// The tx has already been broadcast so a journal error must not fail the
+	// submission. It is recorded on the span instead.
+	if err := client.journal.Put(JournalEntry{
+		TxHash:      txHash,
+		Signer:      signer,
+		Address:     client.signer.Account(signer).Address().String(),
+		Sequence:    sequence,
+		TxBytes:     txBytes,
+		Status:      JournalTxStatusPending,
+		SubmittedAt: now,
+		UpdatedAt:   now,
+	}); err != nil {
+		trace.SpanFromContext(ctx).RecordError(fmt.Errorf("txclient/trackTransaction: journaling tx %s: %w", txHash, err))
+	}
+}
+
+// updateJournal records the status of a transaction in the journal, if one is
+// configured.
+func (client *TxClient) updateJournal(ctx context.Context, txHash string, status JournalTxStatus, height int64, code uint32, log string) {
+	if client.journal == nil {
+		return
+	}
+	if err := client.journal.UpdateStatus(txHash, status, height, code, log); err != nil {
+		trace.SpanFromContext(ctx).RecordError(fmt.Errorf("txclient/updateJournal: %w", err))
 	}
 }
 
-// GetTxFromTxTracker gets transaction info from the tx client's local tx tracker by its hash
+// GetTxFromTxTracker gets transaction info from the tx client's local tx tracker by its hash.
+// If a journal is configured, pending transactions that were pruned from the
+// tracker or submitted before a restart are looked up in the journal.
 func (client *TxClient) GetTxFromTxTracker(hash string) (sequence uint64, signer string, txBytes []byte, exists bool) {
 	client.mtx.Lock()
 	defer client.mtx.Unlock()
 	txInfo, exists := client.txTracker[hash]
-	return txInfo.sequence, txInfo.signer, txInfo.txBytes, exists
+	if exists || client.journal == nil {
+		return txInfo.sequence, txInfo.signer, txInfo.txBytes, exists
+	}
+	entry, exists, err := client.journal.Get(hash)
+	if err != nil || !exists || entry.Status.IsFinal() {
+		return 0, "", nil, false
+	}
+	return entry.Sequence, entry.Signer, entry.TxBytes, true
+}
+
+// TxJournal returns the journal the client records transactions in, or nil if
+// none is configured.
+func (client *TxClient) TxJournal() *TxJournal {
+	return client.journal
 }
 
 // Signer exposes the tx clients underlying signer
@@ -1182,7 +1607,16 @@ func QueryMinimumGasPrice(ctx context.Context, grpcConn *grpc.ClientConn) (float
 	return max(localMinPrice, networkMinPrice), nil
 }
 
+// QueryNetworkMinGasPrice queries the network wide minimum gas price. It
+// prefers the minfee module's query because it reflects the dynamic network
+// minimum gas price and falls back to the params module for networks that
+// don't support it.
 func QueryNetworkMinGasPrice(ctx context.Context, grpcConn *grpc.ClientConn) (float64, error) {
+	minfeeResponse, err := minfeetypes.NewQueryClient(grpcConn).NetworkMinGasPrice(ctx, &minfeetypes.QueryNetworkMinGasPrice{})
+	if err == nil {
+		return minfeeResponse.NetworkMinGasPrice.Float64()
+	}
+
 	paramsClient := paramtypes.NewQueryClient(grpcConn)
 	// NOTE: that we don't prove that this is the correct value
 	paramResponse, err := paramsClient.Params(ctx, &paramtypes.QueryParamsRequest{Subspace: minfeetypes.ModuleName, Key: string(minfeetypes.KeyNetworkMinGasPrice)})
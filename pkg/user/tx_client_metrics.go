@@ -0,0 +1,195 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Reasons for which a transaction is resubmitted.
+const (
+	resubmitReasonEviction         = "eviction"
+	resubmitReasonSequenceMismatch = "sequence_mismatch"
+	resubmitReasonFeeBump          = "fee_bump"
+)
+
+// WithMeter sets the OpenTelemetry meter the TxClient records metrics with.
+// If not set, otel.Meter("txclient") is used.
+func WithMeter(meter metric.Meter) Option {
+	return func(c *TxClient) {
+		c.meter = meter
+	}
+}
+
+// txClientMetrics holds OTel metric instruments for the [TxClient].
+type txClientMetrics struct {
+	submitDuration     metric.Float64Histogram
+	confirmDuration    metric.Float64Histogram
+	evictions          metric.Int64Counter
+	rejections         metric.Int64Counter
+	resubmissions      metric.Int64Counter
+	sequenceMismatches metric.Int64Counter
+}
+
+func newTxClientMetrics(m metric.Meter) (*txClientMetrics, error) {
+	var (
+		tm  txClientMetrics
+		err error
+	)
+
+	tm.submitDuration, err = m.Float64Histogram("txclient.submit.duration",
+		metric.WithDescription("Duration from signing a transaction until a node accepts it in seconds"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating submit duration histogram: %w", err)
+	}
+
+	tm.confirmDuration, err = m.Float64Histogram("txclient.confirm.duration",
+		metric.WithDescription("Duration of confirming a transaction until it is committed, rejected or dropped in seconds"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.5, 1, 2.5, 5, 10, 15, 30, 60, 120, 300),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating confirm duration histogram: %w", err)
+	}
+
+	tm.evictions, err = m.Int64Counter("txclient.tx.evictions",
+		metric.WithDescription("Number of transactions evicted from the mempool"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating evictions counter: %w", err)
+	}
+
+	tm.rejections, err = m.Int64Counter("txclient.tx.rejections",
+		metric.WithDescription("Number of transactions rejected by the node"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating rejections counter: %w", err)
+	}
+
+	tm.resubmissions, err = m.Int64Counter("txclient.tx.resubmissions",
+		metric.WithDescription("Number of transactions resubmitted after an eviction, a sequence mismatch or a fee bump"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating resubmissions counter: %w", err)
+	}
+
+	tm.sequenceMismatches, err = m.Int64Counter("txclient.tx.sequence_mismatches",
+		metric.WithDescription("Number of sequence mismatches reported by the node"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating sequence mismatches counter: %w", err)
+	}
+
+	return &tm, nil
+}
+
+// observeSubmit returns a function that records the duration of submitting a
+// transaction signed by account. Call the returned function once the
+// transaction was accepted or the submission failed.
+func (m *txClientMetrics) observeSubmit(ctx context.Context, account string) (done func(err error)) {
+	start := time.Now()
+	return func(err error) {
+		m.submitDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(
+			attribute.String("account", account),
+			attribute.Bool("success", err == nil),
+		))
+	}
+}
+
+// observeConfirm records the duration of confirming a transaction of account
+// that ended with status.
+func (m *txClientMetrics) observeConfirm(ctx context.Context, start time.Time, account, status string) {
+	m.confirmDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(
+		attribute.String("account", account),
+		attribute.String("status", status),
+	))
+}
+
+// observeEviction records the eviction of a transaction of account.
+func (m *txClientMetrics) observeEviction(ctx context.Context, account string) {
+	m.evictions.Add(ctx, 1, metric.WithAttributes(attribute.String("account", account)))
+}
+
+// observeRejection records the rejection of a transaction of account.
+func (m *txClientMetrics) observeRejection(ctx context.Context, account string) {
+	m.rejections.Add(ctx, 1, metric.WithAttributes(attribute.String("account", account)))
+}
+
+// observeResubmission records the resubmission of a transaction of account.
+func (m *txClientMetrics) observeResubmission(ctx context.Context, account, reason string) {
+	m.resubmissions.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("account", account),
+		attribute.String("reason", reason),
+	))
+}
+
+// observeSequenceMismatch records a sequence mismatch of account.
+func (m *txClientMetrics) observeSequenceMismatch(ctx context.Context, account string) {
+	m.sequenceMismatches.Add(ctx, 1, metric.WithAttributes(attribute.String("account", account)))
+}
+
+// registerEndpointMetrics exports the stats of the endpoints of pool as
+// observable instruments. The returned registration must be unregistered when
+// the TxClient is closed.
+func registerEndpointMetrics(m metric.Meter, pool *endpointPool) (metric.Registration, error) {
+	healthy, err := m.Int64ObservableGauge("txclient.endpoint.healthy",
+		metric.WithDescription("Whether the core endpoint is healthy (1) or not (0)"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating endpoint healthy gauge: %w", err)
+	}
+	latency, err := m.Float64ObservableGauge("txclient.endpoint.latency",
+		metric.WithDescription("Moving average of the latency of successful requests to the core endpoint in seconds"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating endpoint latency gauge: %w", err)
+	}
+	errorRate, err := m.Float64ObservableGauge("txclient.endpoint.error_rate",
+		metric.WithDescription("Moving average of the fraction of failed requests to the core endpoint"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating endpoint error rate gauge: %w", err)
+	}
+	heightLag, err := m.Int64ObservableGauge("txclient.endpoint.height_lag",
+		metric.WithDescription("Number of blocks the core endpoint lags behind the highest endpoint"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating endpoint height lag gauge: %w", err)
+	}
+	requests, err := m.Int64ObservableCounter("txclient.endpoint.requests",
+		metric.WithDescription("Number of requests sent to the core endpoint"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating endpoint requests counter: %w", err)
+	}
+	failures, err := m.Int64ObservableCounter("txclient.endpoint.failures",
+		metric.WithDescription("Number of requests the core endpoint failed to serve"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating endpoint failures counter: %w", err)
+	}
+
+	return m.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		for _, stats := range pool.stats() {
+			attrs := metric.WithAttributes(attribute.String("endpoint", stats.Target))
+			var isHealthy int64
+			if stats.Healthy {
+				isHealthy = 1
+			}
+			o.ObserveInt64(healthy, isHealthy, attrs)
+			o.ObserveFloat64(latency, stats.Latency.Seconds(), attrs)
+			o.ObserveFloat64(errorRate, stats.ErrorRate, attrs)
+			o.ObserveInt64(heightLag, stats.HeightLag, attrs)
+			o.ObserveInt64(requests, int64(stats.Requests), attrs)
+			o.ObserveInt64(failures, int64(stats.Failures), attrs)
+		}
+		return nil
+	}, healthy, latency, errorRate, heightLag, requests, failures)
+}
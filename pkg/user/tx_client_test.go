@@ -926,3 +926,41 @@ func (suite *TxClientTestSuite) TestSequenceIncrementOnlyOnceInMultiConnBroadcas
 	require.Equal(t, seqBefore, trackedSeq, "Tracked sequence should be the sequence before increment")
 	require.Equal(t, multiConnClient.DefaultAccountName(), trackedSigner, "Tracked signer should match")
 }
+
+// TestConfirmTxPollsBroadcastEndpoints verifies that the status of a
+// transaction is polled from the endpoint that accepted it, not from an
+// endpoint that never received it and reports it as unknown.
+func TestConfirmTxPollsBroadcastEndpoints(t *testing.T) {
+	const txHash = "accepted-hash"
+	// The primary endpoint doesn't accept the tx and doesn't know about it.
+	primary := createMockServer(t, map[string][]*tx.TxStatusResponse{
+		txHash: {{Status: core.TxStatusUnknown}},
+	}, func(context.Context, *sdktx.BroadcastTxRequest) (*sdktx.BroadcastTxResponse, error) {
+		return nil, errors.New("mempool is full")
+	})
+	accepting := createMockServer(t, map[string][]*tx.TxStatusResponse{
+		txHash: {
+			{Status: core.TxStatusPending},
+			{Status: core.TxStatusCommitted, Height: 5, ExecutionCode: abci.CodeTypeOK},
+		},
+	}, func(context.Context, *sdktx.BroadcastTxRequest) (*sdktx.BroadcastTxResponse, error) {
+		return &sdktx.BroadcastTxResponse{TxResponse: &sdk.TxResponse{TxHash: txHash, Code: abci.CodeTypeOK}}, nil
+	})
+
+	encCfg, txClient, _ := utils.SetupTxClientWithDefaultParams(t)
+	client, err := user.NewTxClient(encCfg.Codec, txClient.Signer(), primary, encCfg.InterfaceRegistry,
+		user.WithAdditionalCoreEndpoints([]*grpc.ClientConn{accepting}),
+		user.WithPollTime(10*time.Millisecond),
+	)
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	addr := client.DefaultAddress()
+	msg := bank.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1)))
+	resp, err := client.SubmitTx(ctx, []sdk.Msg{msg}, user.SetFee(1000), user.SetGasLimit(100_000))
+	require.NoError(t, err)
+	require.Equal(t, txHash, resp.TxHash)
+	require.Equal(t, int64(5), resp.Height)
+}
@@ -0,0 +1,66 @@
+package user
+
+import (
+	"context"
+)
+
+// TxEvent describes a transaction at a point in its lifecycle. Fields that
+// don't apply to an event are left empty.
+type TxEvent struct {
+	// Signer is the name of the account that signed the transaction.
+	Signer   string
+	TxHash   string
+	Sequence uint64
+	// Height is the height at which the transaction was committed.
+	Height int64
+	// Code is the execution code of a committed or rejected transaction.
+	Code uint32
+	// Log describes why a transaction was rejected or failed.
+	Log string
+}
+
+// TxHooks is called by the TxClient as its transactions move through their
+// lifecycle. Hooks are called synchronously, sometimes while the TxClient
+// holds its lock, so they must return quickly and must not call the TxClient.
+// Embed NoopTxHooks to implement only some of them.
+type TxHooks interface {
+	// OnSigned is called after a transaction was signed and before it is
+	// broadcast. The tx hash is not set.
+	OnSigned(ctx context.Context, event TxEvent)
+	// OnBroadcast is called after a node accepted a transaction into its
+	// mempool, including resubmissions and replacements.
+	OnBroadcast(ctx context.Context, event TxEvent)
+	// OnEvicted is called when ConfirmTx finds that a transaction was evicted
+	// from the mempool, before it is resubmitted.
+	OnEvicted(ctx context.Context, event TxEvent)
+	// OnRejected is called when ConfirmTx finds that a transaction was
+	// rejected by the node.
+	OnRejected(ctx context.Context, event TxEvent)
+	// OnCommitted is called when ConfirmTx finds that a transaction was
+	// committed, whether or not it executed successfully.
+	OnCommitted(ctx context.Context, event TxEvent)
+	// OnSequenceMismatch is called when the node reports that the sequence of
+	// an account is out of sync. The event holds the sequence expected by the
+	// node.
+	OnSequenceMismatch(ctx context.Context, event TxEvent)
+}
+
+// NoopTxHooks implements TxHooks with hooks that do nothing.
+type NoopTxHooks struct{}
+
+var _ TxHooks = NoopTxHooks{}
+
+func (NoopTxHooks) OnSigned(context.Context, TxEvent)           {}
+func (NoopTxHooks) OnBroadcast(context.Context, TxEvent)        {}
+func (NoopTxHooks) OnEvicted(context.Context, TxEvent)          {}
+func (NoopTxHooks) OnRejected(context.Context, TxEvent)         {}
+func (NoopTxHooks) OnCommitted(context.Context, TxEvent)        {}
+func (NoopTxHooks) OnSequenceMismatch(context.Context, TxEvent) {}
+
+// WithTxHooks registers hooks that are called as transactions move through
+// their lifecycle.
+func WithTxHooks(hooks TxHooks) Option {
+	return func(c *TxClient) {
+		c.hooks = hooks
+	}
+}
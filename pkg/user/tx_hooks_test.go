@@ -0,0 +1,111 @@
+package user_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v10/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v10/pkg/user"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/rpc/core"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// recordingHooks records the names of the hooks that were called.
+type recordingHooks struct {
+	user.NoopTxHooks
+
+	mtx    sync.Mutex
+	calls  []string
+	events []user.TxEvent
+}
+
+func (h *recordingHooks) record(name string, event user.TxEvent) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	h.calls = append(h.calls, name)
+	h.events = append(h.events, event)
+}
+
+func (h *recordingHooks) OnSigned(_ context.Context, event user.TxEvent) {
+	h.record("signed", event)
+}
+
+func (h *recordingHooks) OnBroadcast(_ context.Context, event user.TxEvent) {
+	h.record("broadcast", event)
+}
+
+func (h *recordingHooks) OnEvicted(_ context.Context, event user.TxEvent) {
+	h.record("evicted", event)
+}
+
+func (h *recordingHooks) OnCommitted(_ context.Context, event user.TxEvent) {
+	h.record("committed", event)
+}
+
+func TestTxHooksAndMetrics(t *testing.T) {
+	hooks := &recordingHooks{}
+	reader := sdkmetric.NewManualReader()
+	meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test")
+
+	broadcastHandler := func(context.Context, *sdktx.BroadcastTxRequest) (*sdktx.BroadcastTxResponse, error) {
+		return &sdktx.BroadcastTxResponse{
+			TxResponse: &sdk.TxResponse{TxHash: "hooked-hash", Code: abci.CodeTypeOK},
+		}, nil
+	}
+	// The tx is evicted once and committed after it is resubmitted.
+	responses := map[string][]*tx.TxStatusResponse{
+		"hooked-hash": {
+			{Status: core.TxStatusEvicted},
+			{Status: core.TxStatusCommitted, Height: 7, ExecutionCode: abci.CodeTypeOK},
+		},
+	}
+	txClient, _ := setupTxClientWithMockServers(t, []BroadcastHandler{broadcastHandler}, responses,
+		user.WithPollTime(10*time.Millisecond),
+		user.WithTxHooks(hooks),
+		user.WithMeter(meter),
+	)
+
+	ctx := context.Background()
+	addr := txClient.DefaultAddress()
+	msg := bank.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1)))
+	resp, err := txClient.SubmitTx(ctx, []sdk.Msg{msg}, user.SetFee(1000), user.SetGasLimit(100_000))
+	require.NoError(t, err)
+	require.Equal(t, int64(7), resp.Height)
+
+	require.Equal(t, []string{"signed", "broadcast", "evicted", "broadcast", "committed"}, hooks.calls)
+	for _, event := range hooks.events {
+		require.Equal(t, txClient.DefaultAccountName(), event.Signer)
+	}
+	require.Equal(t, int64(7), hooks.events[4].Height)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(ctx, &rm))
+	counts := make(map[string]uint64)
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				for _, dp := range data.DataPoints {
+					counts[m.Name] += uint64(dp.Value)
+				}
+			case metricdata.Histogram[float64]:
+				for _, dp := range data.DataPoints {
+					counts[m.Name] += dp.Count
+				}
+			}
+		}
+	}
+	require.Equal(t, uint64(1), counts["txclient.submit.duration"])
+	require.Equal(t, uint64(1), counts["txclient.confirm.duration"])
+	require.Equal(t, uint64(1), counts["txclient.tx.evictions"])
+	require.Equal(t, uint64(1), counts["txclient.tx.resubmissions"])
+}
@@ -30,6 +30,24 @@ func TestIsAccountNotFound(t *testing.T) {
 	}
 }
 
+func TestIsFeeGrantNotFound(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "grpc NotFound", err: status.Error(codes.NotFound, "fee-grant not found"), want: true},
+		{name: "no allowance", err: status.Error(codes.Unknown, "fee-grant not found: no allowance"), want: true},
+		{name: "unavailable endpoint", err: status.Error(codes.Unavailable, "connection refused"), want: false},
+		{name: "unrelated error", err: errors.New("boom"), want: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, isFeeGrantNotFound(tc.err))
+		})
+	}
+}
+
 func TestQueryAccountWithRetry(t *testing.T) {
 	notFound := status.Error(codes.NotFound, "account not found")
 
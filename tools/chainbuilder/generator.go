@@ -0,0 +1,615 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/rand/v2"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v10/app"
+	"github.com/celestiaorg/celestia-app/v10/fibre"
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v10/pkg/da"
+	"github.com/celestiaorg/celestia-app/v10/pkg/user"
+	"github.com/celestiaorg/celestia-app/v10/test/util/genesis"
+	"github.com/celestiaorg/celestia-app/v10/test/util/testnode"
+	blobtypes "github.com/celestiaorg/celestia-app/v10/x/blob/types"
+	fibretypes "github.com/celestiaorg/celestia-app/v10/x/fibre/types"
+	signaltypes "github.com/celestiaorg/celestia-app/v10/x/signal/types"
+	"github.com/celestiaorg/go-square/v4/share"
+	"github.com/cometbft/cometbft/crypto"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cosmos/cosmos-sdk/client"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/go-bip39"
+)
+
+const (
+	// gas limits of the transactions that don't have an estimator.
+	sendGas            = 200_000
+	stakingGas         = 300_000
+	createValidatorGas = 400_000
+	signalGas          = 200_000
+
+	// maxUnbondingEntries is the default number of unbonding delegations a
+	// delegator can have with a single validator at a time.
+	maxUnbondingEntries = 7
+)
+
+// txKind is a transaction type of the mix of a workload.
+type txKind int
+
+const (
+	kindSend txKind = iota
+	kindPFB
+	kindPFF
+	kindDelegate
+	kindUndelegate
+)
+
+// workloadAccountName returns the keyring name of the i-th workload account.
+func workloadAccountName(i int) string {
+	return fmt.Sprintf("workload-%d", i)
+}
+
+// createWorkloadAccounts adds the accounts of the workload to kr and returns
+// them so that they can be funded in the genesis. The keys are derived from
+// the seed of the workload so that the accounts are the same for every chain
+// generated from the same workload and seed.
+func createWorkloadAccounts(kr keyring.Keyring, workload *Workload) ([]genesis.Account, error) {
+	var seed [32]byte
+	binary.BigEndian.PutUint64(seed[:], workload.Seed)
+	source := rand.NewChaCha8(seed)
+
+	accounts := make([]genesis.Account, workload.Accounts)
+	for i := range accounts {
+		entropy := make([]byte, 32)
+		if _, err := source.Read(entropy); err != nil {
+			return nil, err
+		}
+		mnemonic, err := bip39.NewMnemonic(entropy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create mnemonic: %w", err)
+		}
+		name := workloadAccountName(i)
+		record, err := kr.NewAccount(name, mnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create account %s: %w", name, err)
+		}
+		pubKey, err := record.GetPubKey()
+		if err != nil {
+			return nil, err
+		}
+		accounts[i] = genesis.Account{PubKey: pubKey, Balance: genesis.DefaultInitialBalance, Name: name}
+	}
+	return accounts, nil
+}
+
+// consensusKey returns the consensus key of the validator operated by the
+// account operator. It is derived from the chain ID and the account so that
+// the validators created by a workload can still sign when the chain is
+// extended.
+func consensusKey(chainID, operator string) crypto.PrivKey {
+	seed := sha256.Sum256([]byte(chainID + "/" + operator))
+	return genesis.GenerateEd25519(seed[:])
+}
+
+// delegation is a delegation made by the workload. Self delegations of the
+// validators are not tracked so that they are never undelegated.
+type delegation struct {
+	delegator  string
+	operator   string
+	amount     int64
+	unbondings int
+}
+
+// workloadGenerator generates the transactions of a workload. All random
+// choices are made with a source seeded by the workload so that the same
+// workload, seed and starting height produce the same transactions.
+type workloadGenerator struct {
+	workload  *Workload
+	blockSize int
+	chainID   string
+
+	rng    *rand.Rand
+	source *rand.ChaCha8
+
+	app      *app.App
+	kr       keyring.Keyring
+	txConfig client.TxConfig
+	signer   *user.Signer
+
+	accounts []string
+	// operators are the account names of the operators of the validators
+	// the generator knows the consensus keys of.
+	operators []string
+	// consensusKeys are the consensus keys of the validators by address.
+	consensusKeys map[string]crypto.PrivKey
+	delegations   []*delegation
+
+	pfbNamespaces []share.Namespace
+	pffNamespaces []share.Namespace
+}
+
+func newWorkloadGenerator(
+	cfg BuilderConfig,
+	chainID string,
+	simApp *app.App,
+	kr keyring.Keyring,
+	txConfig client.TxConfig,
+	validatorKey crypto.PrivKey,
+	lastHeight int64,
+) *workloadGenerator {
+	workload := cfg.Workload
+
+	// the starting height is mixed into the seed so that extending a chain
+	// does not repeat the transactions of the blocks before.
+	var seed [32]byte
+	binary.BigEndian.PutUint64(seed[:8], workload.Seed)
+	binary.BigEndian.PutUint64(seed[8:16], uint64(lastHeight))
+	source := rand.NewChaCha8(seed)
+
+	g := &workloadGenerator{
+		workload:      workload,
+		blockSize:     cfg.BlockSize,
+		chainID:       chainID,
+		rng:           rand.New(source),
+		source:        source,
+		app:           simApp,
+		kr:            kr,
+		txConfig:      txConfig,
+		operators:     []string{testnode.DefaultValidatorAccountName},
+		consensusKeys: map[string]crypto.PrivKey{string(validatorKey.PubKey().Address()): validatorKey},
+	}
+
+	for i := range workload.Accounts {
+		g.accounts = append(g.accounts, workloadAccountName(i))
+	}
+	for i := range workload.Validators {
+		key := consensusKey(chainID, g.accounts[i])
+		g.consensusKeys[string(key.PubKey().Address())] = key
+	}
+	for i := range workload.PFB.Namespaces {
+		g.pfbNamespaces = append(g.pfbNamespaces, share.MustNewV0Namespace(fmt.Appendf(nil, "pfb-%d", i)))
+	}
+	for i := range workload.PFF.Namespaces {
+		g.pffNamespaces = append(g.pffNamespaces, share.MustNewV0Namespace(fmt.Appendf(nil, "pff-%d", i)))
+	}
+	return g
+}
+
+// loadAccounts creates the signer from the account numbers and sequences of
+// the committed state and finds the validators the workload created before.
+func (g *workloadGenerator) loadAccounts(ctx sdk.Context) error {
+	names := append([]string{testnode.DefaultValidatorAccountName}, g.accounts...)
+	accounts := make([]*user.Account, len(names))
+	for i, name := range names {
+		addr, err := g.address(name)
+		if err != nil {
+			return err
+		}
+		acc := g.app.AccountKeeper.GetAccount(ctx, addr)
+		if acc == nil {
+			return fmt.Errorf("account %s (%s) not found: the chain must have been created with a workload of at least %d accounts", name, addr, len(g.accounts))
+		}
+		accounts[i] = user.NewAccount(name, acc.GetAccountNumber(), acc.GetSequence())
+	}
+	signer, err := user.NewSigner(g.kr, g.txConfig, g.chainID, accounts...)
+	if err != nil {
+		return fmt.Errorf("failed to create signer: %w", err)
+	}
+	g.signer = signer
+
+	for i := range g.workload.Validators {
+		addr := g.signer.Account(g.accounts[i]).Address()
+		if _, err := g.app.StakingKeeper.GetValidator(ctx, sdk.ValAddress(addr)); err == nil {
+			g.operators = append(g.operators, g.accounts[i])
+		}
+	}
+	return nil
+}
+
+func (g *workloadGenerator) address(name string) (sdk.AccAddress, error) {
+	record, err := g.kr.Key(name)
+	if err != nil {
+		return nil, fmt.Errorf("account %s not found in keyring: %w", name, err)
+	}
+	return record.GetAddress()
+}
+
+// NextData returns the data of the block at height.
+func (g *workloadGenerator) NextData(height int64, state sm.State) (*tmproto.Data, error) {
+	// the genesis accounts can only be queried once the first block has been
+	// committed, so the first block of a chain is empty.
+	var txs [][]byte
+	if state.LastBlockHeight > 0 {
+		var err error
+		txs, err = g.blockTxs(height, state)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate transactions of block %d: %w", height, err)
+		}
+	}
+
+	eds, err := da.ConstructEDS(txs, state.Version.Consensus.App, maxSquareSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct data square of block %d: %w", height, err)
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, err
+	}
+	return &tmproto.Data{
+		Txs:        txs,
+		Hash:       dah.Hash(),
+		SquareSize: uint64(eds.Width() / 2),
+	}, nil
+}
+
+// blockBuilder collects the transactions of a block in the order of a data
+// square: normal transactions, blob transactions and PayForFibre
+// transactions. Transactions are signed once the block is complete so that
+// the sequences of the accounts follow the order of execution.
+type blockBuilder struct {
+	state     sm.State
+	ctx       sdk.Context
+	gasPrice  float64
+	normalTxs []signTx
+	blobTxs   []signTx
+	pffTxs    []signTx
+	blobBytes int
+	// escrow is the available escrow balance of the accounts that paid for
+	// fibre blobs in this block.
+	escrow map[string]int64
+}
+
+// signTx signs a transaction of the block.
+type signTx func() ([]byte, error)
+
+func (b *blockBuilder) txs() ([][]byte, error) {
+	txs := make([][]byte, 0, len(b.normalTxs)+len(b.blobTxs)+len(b.pffTxs))
+	for _, section := range [][]signTx{b.normalTxs, b.blobTxs, b.pffTxs} {
+		for _, sign := range section {
+			tx, err := sign()
+			if err != nil {
+				return nil, err
+			}
+			txs = append(txs, tx)
+		}
+	}
+	return txs, nil
+}
+
+func (g *workloadGenerator) blockTxs(height int64, state sm.State) ([][]byte, error) {
+	ctx := g.app.NewContext(true)
+	if g.signer == nil {
+		if err := g.loadAccounts(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	gasPrice := max(g.app.MinFeeKeeper.GetNetworkMinGasPrice(ctx).MustFloat64(), appconsts.DefaultMinGasPrice) * 2
+	b := &blockBuilder{state: state, ctx: ctx, gasPrice: gasPrice, escrow: make(map[string]int64)}
+
+	for i, validator := range g.workload.Validators {
+		if validator.Height == height {
+			if err := g.createValidator(b, g.accounts[i], validator.Stake); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, upgrade := range g.workload.Upgrades {
+		if upgrade.Height == height {
+			g.upgrade(b, upgrade.AppVersion)
+		}
+	}
+
+	for range g.workload.TxsPerBlock {
+		var err error
+		switch g.pickKind() {
+		case kindSend:
+			err = g.send(b)
+		case kindPFB:
+			err = g.payForBlobs(b)
+		case kindPFF:
+			err = g.payForFibre(b, height)
+		case kindDelegate:
+			err = g.delegate(b)
+		case kindUndelegate:
+			err = g.undelegate(b)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b.txs()
+}
+
+// pickKind draws a transaction type according to the weights of the mix.
+func (g *workloadGenerator) pickKind() txKind {
+	mix := g.workload.Mix
+	weights := []int{mix.Send, mix.PFB, mix.PFF, mix.Delegate, mix.Undelegate}
+	total := 0
+	for _, weight := range weights {
+		total += weight
+	}
+	n := g.rng.IntN(total)
+	for kind, weight := range weights {
+		if n < weight {
+			return txKind(kind)
+		}
+		n -= weight
+	}
+	panic("unreachable")
+}
+
+// draw returns a value of r drawn uniformly.
+func (g *workloadGenerator) draw(r Range) int64 {
+	return r.Min + g.rng.Int64N(r.Max-r.Min+1)
+}
+
+func (g *workloadGenerator) randomAccount() string {
+	return g.accounts[g.rng.IntN(len(g.accounts))]
+}
+
+func (b *blockBuilder) fee(gas uint64) user.TxOption {
+	return user.SetFee(uint64(math.Ceil(float64(gas) * b.gasPrice)))
+}
+
+// createTx signs a transaction of msgs with the account of the first signer.
+func (g *workloadGenerator) createTx(b *blockBuilder, gas uint64, msgs ...sdk.Msg) ([]byte, error) {
+	tx, signed, err := g.signer.CreateTx(msgs, user.SetGasLimit(gas), b.fee(gas))
+	if err != nil {
+		return nil, err
+	}
+	signers, err := signed.GetSigners()
+	if err != nil {
+		return nil, err
+	}
+	return tx, g.signer.IncrementSequence(g.signer.AccountByAddress(sdk.AccAddress(signers[0])).Name())
+}
+
+func (g *workloadGenerator) addNormalTx(b *blockBuilder, gas uint64, msgs ...sdk.Msg) {
+	b.normalTxs = append(b.normalTxs, func() ([]byte, error) {
+		return g.createTx(b, gas, msgs...)
+	})
+}
+
+func (g *workloadGenerator) send(b *blockBuilder) error {
+	from := g.signer.Account(g.randomAccount()).Address()
+	to := g.signer.Account(g.randomAccount()).Address()
+	amount := sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, g.draw(g.workload.Send)))
+	g.addNormalTx(b, sendGas, banktypes.NewMsgSend(from, to, amount))
+	return nil
+}
+
+func (g *workloadGenerator) payForBlobs(b *blockBuilder) error {
+	name := g.randomAccount()
+	count := g.draw(g.workload.PFB.Blobs)
+	namespaces := make([]share.Namespace, count)
+	sizes := make([]int, count)
+	total := 0
+	for i := range count {
+		namespaces[i] = g.pfbNamespaces[g.rng.IntN(len(g.pfbNamespaces))]
+		sizes[i] = int(g.draw(g.workload.PFB.Size))
+		total += sizes[i]
+	}
+	// PFBs that don't fit into the block size are dropped.
+	if b.blobBytes+total > g.blockSize {
+		return nil
+	}
+	b.blobBytes += total
+
+	blobs := make([]*share.Blob, count)
+	for i := range blobs {
+		data := make([]byte, sizes[i])
+		if _, err := g.source.Read(data); err != nil {
+			return err
+		}
+		blob, err := share.NewV0Blob(namespaces[i], data)
+		if err != nil {
+			return err
+		}
+		blobs[i] = blob
+	}
+
+	msg, err := blobtypes.NewMsgPayForBlobs(g.signer.Account(name).Address().String(), b.state.Version.Consensus.App, blobs...)
+	if err != nil {
+		return err
+	}
+	gas := blobtypes.DefaultEstimateGas(msg)
+	b.blobTxs = append(b.blobTxs, func() ([]byte, error) {
+		tx, _, err := g.signer.CreatePayForBlobs(name, blobs, user.SetGasLimit(gas), b.fee(gas))
+		if err != nil {
+			return nil, err
+		}
+		return tx, g.signer.IncrementSequence(name)
+	})
+	return nil
+}
+
+// payForFibre pays for a fibre blob that validators signed for. The blob is
+// not uploaded anywhere. Accounts deposit to their escrow account first if
+// its balance does not cover the payment.
+func (g *workloadGenerator) payForFibre(b *blockBuilder, height int64) error {
+	name := g.randomAccount()
+	namespace := g.pffNamespaces[g.rng.IntN(len(g.pffNamespaces))]
+	size := uint32(g.draw(g.workload.PFF.Size))
+	var commitment fibre.Commitment
+	if _, err := g.source.Read(commitment[:]); err != nil {
+		return err
+	}
+	// fibre is only supported by the native app version and blocks are limited
+	// in the number of PayForFibre messages they can contain.
+	if b.state.Version.Consensus.App < appconsts.Version || len(b.pffTxs) >= appconsts.MaxPayForFibreMessages {
+		return nil
+	}
+
+	account := g.signer.Account(name)
+	addr := account.Address()
+	balance, ok := b.escrow[name]
+	if !ok {
+		if escrow, found := g.app.FibreKeeper.GetEscrowAccount(b.ctx, addr.String()); found {
+			balance = escrow.AvailableBalance.Amount.Int64()
+		}
+	}
+	payment := fibretypes.PaymentAmount(size).Amount.Int64()
+	if balance < payment {
+		deposit := max(g.workload.PFF.EscrowDeposit, payment)
+		msg := &fibretypes.MsgDepositToEscrow{Signer: addr.String(), Amount: sdk.NewInt64Coin(appconsts.BondDenom, deposit)}
+		g.addNormalTx(b, sendGas, msg)
+		balance += deposit
+	}
+	b.escrow[name] = balance - payment
+
+	pubKey, ok := account.PubKey().(*secp256k1.PubKey)
+	if !ok {
+		return fmt.Errorf("account %s does not have a secp256k1 key", name)
+	}
+	promise := &fibre.PaymentPromise{
+		SignerKey:         pubKey,
+		ChainID:           g.chainID,
+		Namespace:         namespace,
+		UploadSize:        size,
+		BlobVersion:       fibretypes.BlobVersionZero,
+		Commitment:        commitment,
+		CreationTimestamp: b.state.LastBlockTime,
+		Height:            uint64(height - 1),
+	}
+	signBytes, err := promise.SignBytes()
+	if err != nil {
+		return err
+	}
+	promise.Signature, _, err = g.kr.Sign(name, signBytes, signing.SignMode_SIGN_MODE_DIRECT)
+	if err != nil {
+		return fmt.Errorf("failed to sign payment promise: %w", err)
+	}
+
+	// the signatures are verified against the validator set of the height of
+	// the promise in the order of the historical info.
+	historicalInfo, err := g.app.StakingKeeper.GetHistoricalInfo(b.ctx, height-1)
+	if err != nil {
+		return fmt.Errorf("failed to get validator set of height %d: %w", height-1, err)
+	}
+	signatures := make([][]byte, len(historicalInfo.Valset))
+	for i, validator := range historicalInfo.Valset {
+		pk, err := validator.ConsPubKey()
+		if err != nil {
+			return err
+		}
+		key, ok := g.consensusKeys[string(pk.Address())]
+		if !ok {
+			continue
+		}
+		if signatures[i], err = key.Sign(signBytes); err != nil {
+			return err
+		}
+	}
+
+	promiseProto, err := promise.ToProto()
+	if err != nil {
+		return err
+	}
+	msg := &fibretypes.MsgPayForFibre{
+		Signer:              addr.String(),
+		PaymentPromise:      *promiseProto,
+		ValidatorSignatures: signatures,
+	}
+	gas := fibretypes.EstimateGasForPayForFibreSignatureVerification(uint64(len(signatures))) + fibretypes.EstimateGasForPayForFibre(size)
+	b.pffTxs = append(b.pffTxs, func() ([]byte, error) {
+		return g.createTx(b, gas, msg)
+	})
+	return nil
+}
+
+func (g *workloadGenerator) valAddress(operator string) string {
+	return sdk.ValAddress(g.signer.Account(operator).Address()).String()
+}
+
+func (g *workloadGenerator) delegate(b *blockBuilder) error {
+	delegator := g.randomAccount()
+	operator := g.operators[g.rng.IntN(len(g.operators))]
+	amount := g.draw(g.workload.Delegate)
+
+	msg := stakingtypes.NewMsgDelegate(
+		g.signer.Account(delegator).Address().String(),
+		g.valAddress(operator),
+		sdk.NewInt64Coin(appconsts.BondDenom, amount),
+	)
+	g.addNormalTx(b, stakingGas, msg)
+	for _, d := range g.delegations {
+		if d.delegator == delegator && d.operator == operator {
+			d.amount += amount
+			return nil
+		}
+	}
+	g.delegations = append(g.delegations, &delegation{delegator: delegator, operator: operator, amount: amount})
+	return nil
+}
+
+// undelegate undelegates part of a delegation the workload made. Nothing is
+// undelegated if there is no delegation that can be undelegated from.
+func (g *workloadGenerator) undelegate(b *blockBuilder) error {
+	var candidates []*delegation
+	for _, d := range g.delegations {
+		if d.amount > 0 && d.unbondings < maxUnbondingEntries {
+			candidates = append(candidates, d)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	d := candidates[g.rng.IntN(len(candidates))]
+	amount := 1 + g.rng.Int64N(d.amount)
+
+	msg := stakingtypes.NewMsgUndelegate(
+		g.signer.Account(d.delegator).Address().String(),
+		g.valAddress(d.operator),
+		sdk.NewInt64Coin(appconsts.BondDenom, amount),
+	)
+	g.addNormalTx(b, stakingGas, msg)
+	d.amount -= amount
+	d.unbondings++
+	return nil
+}
+
+func (g *workloadGenerator) createValidator(b *blockBuilder, operator string, stake int64) error {
+	key := consensusKey(g.chainID, operator)
+	pk, err := cryptocodec.FromCmtPubKeyInterface(key.PubKey())
+	if err != nil {
+		return fmt.Errorf("converting public key of validator %s: %w", operator, err)
+	}
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		g.valAddress(operator),
+		pk,
+		sdk.NewInt64Coin(appconsts.BondDenom, stake),
+		stakingtypes.NewDescription(operator, "", "", "", ""),
+		stakingtypes.NewCommissionRates(appconsts.MinCommissionRate, appconsts.MinCommissionRate, sdkmath.LegacyZeroDec()),
+		sdkmath.OneInt(),
+	)
+	if err != nil {
+		return err
+	}
+	msg.DelegatorAddress = g.signer.Account(operator).Address().String() //nolint:staticcheck // required for sdk 50
+	g.addNormalTx(b, createValidatorGas, msg)
+	g.operators = append(g.operators, operator)
+	return nil
+}
+
+// upgrade makes all validators signal appVersion and tries the upgrade.
+func (g *workloadGenerator) upgrade(b *blockBuilder, appVersion uint64) {
+	for _, operator := range g.operators {
+		g.addNormalTx(b, signalGas, signaltypes.NewMsgSignalVersion(g.valAddress(operator), appVersion))
+	}
+	addr := g.signer.Account(testnode.DefaultValidatorAccountName).Address()
+	g.addNormalTx(b, signalGas, signaltypes.NewMsgTryUpgrade(addr))
+}
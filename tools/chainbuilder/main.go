@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
@@ -59,6 +60,7 @@ func main() {
 			upToTime, _ := cmd.Flags().GetBool("up-to-now")
 			appVersion, _ := cmd.Flags().GetUint64("app-version")
 			chainID, _ := cmd.Flags().GetString("chain-id")
+			workloadPath, _ := cmd.Flags().GetString("workload")
 			var namespace share.Namespace
 			if namespaceStr == "" {
 				namespace = defaultNamespace
@@ -85,6 +87,20 @@ func main() {
 				cfg.ChainID = chainID
 			}
 
+			if workloadPath != "" {
+				workload, err := LoadWorkload(workloadPath)
+				if err != nil {
+					return err
+				}
+				if cmd.Flags().Changed("seed") {
+					workload.Seed, _ = cmd.Flags().GetUint64("seed")
+				}
+				if err := workload.Validate(appVersion); err != nil {
+					return fmt.Errorf("invalid workload: %w", err)
+				}
+				cfg.Workload = workload
+			}
+
 			dir, err := os.Getwd()
 			if err != nil {
 				return fmt.Errorf("failed to get current working directory: %w", err)
@@ -102,6 +118,8 @@ func main() {
 	rootCmd.Flags().Bool("up-to-now", false, "Tool will terminate if the block time reaches the current time")
 	rootCmd.Flags().Uint64("app-version", appconsts.Version, "App version to use for the chain")
 	rootCmd.Flags().String("chain-id", "", "Chain ID to use for the chain. Defaults to a random 6 character string")
+	rootCmd.Flags().String("workload", "", "Workload file declaring the transactions of the blocks. Defaults to one PFB of block-size bytes per block")
+	rootCmd.Flags().Uint64("seed", 0, "Seed of the workload. Overrides the seed of the workload file")
 	rootCmd.SilenceUsage = true
 	rootCmd.SilenceErrors = true
 	if err := rootCmd.Execute(); err != nil {
@@ -119,6 +137,9 @@ type BuilderConfig struct {
 	ChainID       string
 	AppVersion    uint64
 	UpToTime      bool
+	// Workload declares the transactions of the blocks. If nil, every block
+	// contains a single PFB of BlockSize bytes.
+	Workload *Workload
 }
 
 func Run(ctx context.Context, cfg BuilderConfig, dir string) error {
@@ -152,6 +173,18 @@ func Run(ctx context.Context, cfg BuilderConfig, dir string) error {
 			WithGenesisTime(startTime).
 			WithValidators(validator)
 
+		if cfg.Workload != nil {
+			accounts, err := createWorkloadAccounts(kr, cfg.Workload)
+			if err != nil {
+				return err
+			}
+			for _, account := range accounts {
+				if err := gen.AddAccount(account); err != nil {
+					return fmt.Errorf("failed to add workload account: %w", err)
+				}
+			}
+		}
+
 		if err := genesis.InitFiles(dir, tmCfg, appCfg, gen, 0); err != nil {
 			return fmt.Errorf("failed to initialize genesis files: %w", err)
 		}
@@ -170,7 +203,6 @@ func Run(ctx context.Context, cfg BuilderConfig, dir string) error {
 	}
 
 	validatorKey := privval.LoadFilePV(tmCfg.PrivValidatorKeyFile(), tmCfg.PrivValidatorStateFile())
-	validatorAddr := validatorKey.Key.Address
 
 	blockDB, err := dbm.NewDB("blockstore", dbm.BackendType(tmCfg.DBBackend), tmCfg.DBDir())
 	if err != nil {
@@ -281,18 +313,16 @@ func Run(ctx context.Context, cfg BuilderConfig, dir string) error {
 		return fmt.Errorf("last block height mismatch: state has %d, but block store has %d", state.LastBlockHeight, lastHeight)
 	}
 
-	validatorPower := state.Validators.Validators[0].VotingPower
-
-	signer, err := user.NewSigner(kr, encCfg.TxConfig, state.ChainID, user.NewAccount(testnode.DefaultValidatorAccountName, 0, uint64(lastHeight)+1))
-	if err != nil {
-		return fmt.Errorf("failed to create new signer: %w", err)
-	}
-
 	var (
 		errCh     = make(chan error, 2)
 		dataCh    = make(chan *tmproto.Data, 100)
 		persistCh = make(chan persistData, 100)
 		commit    = &types.Commit{}
+		routines  = 1
+		workload  *workloadGenerator
+		// consensusKeys are the keys of the validators other than the one of
+		// the node that sign the blocks.
+		consensusKeys map[string]crypto.PrivKey
 	)
 	if lastHeight > 0 {
 		commit = blockStore.LoadSeenCommit(lastHeight)
@@ -301,9 +331,21 @@ func Run(ctx context.Context, cfg BuilderConfig, dir string) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	go func() {
-		errCh <- generateSquareRoutine(ctx, signer, cfg, dataCh)
-	}()
+	if cfg.Workload != nil {
+		// the data of a workload depends on the state of the chain, so it is
+		// generated block by block.
+		workload = newWorkloadGenerator(cfg, state.ChainID, simApp, kr, encCfg.TxConfig, validatorKey.Key.PrivKey, lastHeight)
+		consensusKeys = workload.consensusKeys
+	} else {
+		signer, err := user.NewSigner(kr, encCfg.TxConfig, state.ChainID, user.NewAccount(testnode.DefaultValidatorAccountName, 0, uint64(lastHeight)+1))
+		if err != nil {
+			return fmt.Errorf("failed to create new signer: %w", err)
+		}
+		routines++
+		go func() {
+			errCh <- generateSquareRoutine(ctx, signer, cfg, dataCh)
+		}()
+	}
 
 	go func() {
 		errCh <- persistDataRoutine(ctx, stateStore, blockStore, persistCh)
@@ -317,111 +359,86 @@ func Run(ctx context.Context, cfg BuilderConfig, dir string) error {
 			break
 		}
 
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case dataPB := <-dataCh:
-			data, err := types.DataFromProto(dataPB)
-			if err != nil {
-				return fmt.Errorf("failed to convert data from protobuf: %w", err)
-			}
-
-			block, blockParts, err := state.MakeBlock(height, data, commit, nil, validatorAddr)
+		var dataPB *tmproto.Data
+		if workload != nil {
+			dataPB, err = workload.NextData(height, state)
 			if err != nil {
-				return fmt.Errorf("failed to make block: %w", err)
+				return err
 			}
-			blockID := types.BlockID{
-				Hash:          block.Hash(),
-				PartSetHeader: blockParts.Header(),
+		} else {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case dataPB = <-dataCh:
 			}
+		}
 
-			precommitVote := &tmproto.Vote{
-				Height:           height,
-				Round:            0,
-				Type:             tmproto.PrecommitType,
-				BlockID:          blockID.ToProto(),
-				ValidatorAddress: validatorAddr,
-				Timestamp:        currentTime,
-				Signature:        nil,
-			}
+		data, err := types.DataFromProto(dataPB)
+		if err != nil {
+			return fmt.Errorf("failed to convert data from protobuf: %w", err)
+		}
 
-			if err := validatorKey.SignVote(state.ChainID, precommitVote); err != nil {
-				return fmt.Errorf("failed to sign precommit vote (%s): %w", precommitVote.String(), err)
-			}
+		block, blockParts, err := state.MakeBlock(height, data, commit, nil, state.Validators.GetProposer().Address)
+		if err != nil {
+			return fmt.Errorf("failed to make block: %w", err)
+		}
+		blockID := types.BlockID{
+			Hash:          block.Hash(),
+			PartSetHeader: blockParts.Header(),
+		}
 
-			commitSig := types.CommitSig{
-				BlockIDFlag:      types.BlockIDFlagCommit,
-				ValidatorAddress: validatorAddr,
-				Timestamp:        currentTime,
-				Signature:        precommitVote.Signature,
-			}
-			commit = &types.Commit{Height: height, BlockID: blockID, Signatures: []types.CommitSig{commitSig}}
-
-			var lastCommitInfo abci.CommitInfo
-			if height > 1 {
-				lastCommitInfo = abci.CommitInfo{
-					Round: 0,
-					Votes: []abci.VoteInfo{
-						{
-							Validator: abci.Validator{
-								Address: validatorAddr,
-								Power:   validatorPower,
-							},
-							BlockIdFlag: tmproto.BlockIDFlagCommit,
-						},
-					},
-				}
-			}
+		var lastCommitInfo abci.CommitInfo
+		if height > 1 {
+			lastCommitInfo = commitInfo(commit, state.LastValidators)
+		}
 
-			txs := make([][]byte, len(block.Txs))
-			for idx, tx := range block.Txs {
-				blobTx, isBlobTx := types.UnmarshalBlobTx(tx)
-				if isBlobTx {
-					tx = blobTx.Tx
-				}
-				txs[idx] = tx
-			}
+		commitSigs, err := signPrecommits(state.ChainID, state.Validators, validatorKey, consensusKeys, height, blockID, currentTime)
+		if err != nil {
+			return err
+		}
+		commit = &types.Commit{Height: height, BlockID: blockID, Signatures: commitSigs}
 
-			resp, err := simApp.FinalizeBlock(&abci.RequestFinalizeBlock{
-				Height:            block.Height,
-				Hash:              block.Hash(),
-				DecidedLastCommit: lastCommitInfo,
-				Txs:               txs,
-			})
-			if err != nil {
-				return fmt.Errorf("failed to finalize block: %w", err)
+		txs := make([][]byte, len(block.Txs))
+		for idx, tx := range block.Txs {
+			blobTx, isBlobTx := types.UnmarshalBlobTx(tx)
+			if isBlobTx {
+				tx = blobTx.Tx
 			}
+			txs[idx] = tx
+		}
 
-			for _, tx := range resp.TxResults {
-				if tx.Code != abci.CodeTypeOK {
-					return fmt.Errorf("failed to deliver tx: %s", tx.Log)
-				}
-			}
+		resp, err := simApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+			Height:             block.Height,
+			Hash:               block.Hash(),
+			Time:               block.Time,
+			ProposerAddress:    block.ProposerAddress,
+			NextValidatorsHash: block.NextValidatorsHash,
+			DecidedLastCommit:  lastCommitInfo,
+			Txs:                txs,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to finalize block: %w", err)
+		}
 
-			_, err = simApp.Commit()
-			if err != nil {
-				return fmt.Errorf("failed to commit block: %w", err)
+		for _, tx := range resp.TxResults {
+			if tx.Code != abci.CodeTypeOK {
+				return fmt.Errorf("failed to deliver tx: %s", tx.Log)
 			}
+		}
 
-			state.LastBlockHeight = height
-			state.LastBlockID = blockID
-			state.LastBlockTime = block.Time
-			state.LastValidators = state.Validators
-			state.Validators = state.NextValidators
-			state.NextValidators = state.NextValidators.CopyIncrementProposerPriority(1)
-			state.AppHash = resp.AppHash
-			state.LastResultsHash = sm.TxResultsHash(resp.TxResults)
-			currentTime = currentTime.Add(cfg.BlockInterval)
-			persistCh <- persistData{
-				state: state.Copy(),
-				block: block,
-				seenCommit: &types.Commit{
-					Height:     commit.Height,
-					Round:      commit.Round,
-					BlockID:    commit.BlockID,
-					Signatures: []types.CommitSig{commitSig},
-				},
-			}
+		_, err = simApp.Commit()
+		if err != nil {
+			return fmt.Errorf("failed to commit block: %w", err)
+		}
+
+		if err := updateState(&state, height, blockID, block, resp); err != nil {
+			return err
+		}
+		currentTime = currentTime.Add(cfg.BlockInterval)
+		persistCh <- persistData{
+			state:      state.Copy(),
+			block:      block,
+			seenCommit: commit,
 		}
 	}
 
@@ -429,7 +446,7 @@ func Run(ctx context.Context, cfg BuilderConfig, dir string) error {
 	close(persistCh)
 
 	var firstErr error
-	for i := 0; i < cap(errCh); i++ {
+	for i := 0; i < routines; i++ {
 		err := <-errCh
 		if err != nil && firstErr == nil {
 			firstErr = err
@@ -451,6 +468,110 @@ func Run(ctx context.Context, cfg BuilderConfig, dir string) error {
 	return firstErr
 }
 
+// signPrecommits returns the signatures of the commit for blockID. The
+// validator of the node signs with its FilePV. Other validators sign if their
+// key is in consensusKeys and are absent otherwise.
+func signPrecommits(
+	chainID string,
+	valSet *types.ValidatorSet,
+	filePV *privval.FilePV,
+	consensusKeys map[string]crypto.PrivKey,
+	height int64,
+	blockID types.BlockID,
+	timestamp time.Time,
+) ([]types.CommitSig, error) {
+	sigs := make([]types.CommitSig, len(valSet.Validators))
+	for idx, val := range valSet.Validators {
+		precommitVote := &tmproto.Vote{
+			Height:           height,
+			Round:            0,
+			Type:             tmproto.PrecommitType,
+			BlockID:          blockID.ToProto(),
+			ValidatorAddress: val.Address,
+			ValidatorIndex:   int32(idx),
+			Timestamp:        timestamp,
+			Signature:        nil,
+		}
+
+		if bytes.Equal(val.Address, filePV.Key.Address) {
+			if err := filePV.SignVote(chainID, precommitVote); err != nil {
+				return nil, fmt.Errorf("failed to sign precommit vote (%s): %w", precommitVote.String(), err)
+			}
+		} else if key, ok := consensusKeys[string(val.Address)]; ok {
+			signature, err := key.Sign(types.VoteSignBytes(chainID, precommitVote))
+			if err != nil {
+				return nil, fmt.Errorf("failed to sign precommit vote (%s): %w", precommitVote.String(), err)
+			}
+			precommitVote.Signature = signature
+		} else {
+			sigs[idx] = types.NewCommitSigAbsent()
+			continue
+		}
+
+		sigs[idx] = types.CommitSig{
+			BlockIDFlag:      types.BlockIDFlagCommit,
+			ValidatorAddress: val.Address,
+			Timestamp:        timestamp,
+			Signature:        precommitVote.Signature,
+		}
+	}
+	return sigs, nil
+}
+
+// commitInfo returns the votes of commit which was signed by valSet.
+func commitInfo(commit *types.Commit, valSet *types.ValidatorSet) abci.CommitInfo {
+	votes := make([]abci.VoteInfo, len(commit.Signatures))
+	for idx, sig := range commit.Signatures {
+		val := valSet.Validators[idx]
+		votes[idx] = abci.VoteInfo{
+			Validator: abci.Validator{
+				Address: val.Address,
+				Power:   val.VotingPower,
+			},
+			BlockIdFlag: tmproto.BlockIDFlag(sig.BlockIDFlag),
+		}
+	}
+	return abci.CommitInfo{Round: commit.Round, Votes: votes}
+}
+
+// updateState applies the block and the response of the app to state like
+// CometBFT does, including validator and consensus param updates.
+func updateState(state *sm.State, height int64, blockID types.BlockID, block *types.Block, resp *abci.ResponseFinalizeBlock) error {
+	nextValidators := state.NextValidators.Copy()
+	if len(resp.ValidatorUpdates) > 0 {
+		updates, err := types.PB2TM.ValidatorUpdates(resp.ValidatorUpdates)
+		if err != nil {
+			return fmt.Errorf("failed to convert validator updates: %w", err)
+		}
+		if err := nextValidators.UpdateWithChangeSet(updates); err != nil {
+			return fmt.Errorf("failed to update validator set: %w", err)
+		}
+		// the updates take effect two blocks later.
+		state.LastHeightValidatorsChanged = height + 2
+	}
+	nextValidators.IncrementProposerPriority(1)
+
+	if resp.ConsensusParamUpdates != nil {
+		params := state.ConsensusParams.Update(resp.ConsensusParamUpdates)
+		if err := params.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid consensus params update: %w", err)
+		}
+		state.ConsensusParams = params
+		state.Version.Consensus.App = params.Version.App
+		state.LastHeightConsensusParamsChanged = height + 1
+	}
+
+	state.LastBlockHeight = height
+	state.LastBlockID = blockID
+	state.LastBlockTime = block.Time
+	state.LastValidators = state.Validators.Copy()
+	state.Validators = state.NextValidators.Copy()
+	state.NextValidators = nextValidators
+	state.AppHash = resp.AppHash
+	state.LastResultsHash = sm.TxResultsHash(resp.TxResults)
+	return nil
+}
+
 func generateSquareRoutine(
 	ctx context.Context,
 	signer *user.Signer,
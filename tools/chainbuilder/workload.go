@@ -0,0 +1,202 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v10/test/util/genesis"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
+// maxNamespaces bounds the number of namespaces of a transaction type so that
+// their IDs fit into a namespace.
+const maxNamespaces = 100_000
+
+// Workload declares the transactions of the blocks chainbuilder generates. The
+// transactions of every block are drawn from Mix using a random source seeded
+// with Seed so that the same workload and seed produce the same sequence of
+// transactions.
+type Workload struct {
+	// Seed seeds the random source. It can be overridden with --seed.
+	Seed uint64 `yaml:"seed"`
+	// Accounts is the number of funded accounts that send the transactions.
+	Accounts int `yaml:"accounts"`
+	// TxsPerBlock is the number of transactions drawn from Mix for every
+	// block. Blocks may contain fewer transactions if the blobs of a block
+	// exceed --block-size.
+	TxsPerBlock int   `yaml:"txs_per_block"`
+	Mix         TxMix `yaml:"mix"`
+
+	Send       Range          `yaml:"send"`
+	PFB        PFBConfig      `yaml:"pfb"`
+	PFF        PFFConfig      `yaml:"pff"`
+	Delegate   Range          `yaml:"delegate"`
+	Validators []NewValidator `yaml:"validators"`
+	Upgrades   []Upgrade      `yaml:"upgrades"`
+}
+
+// TxMix are the relative weights of the transaction types.
+type TxMix struct {
+	Send       int `yaml:"send"`
+	PFB        int `yaml:"pfb"`
+	PFF        int `yaml:"pff"`
+	Delegate   int `yaml:"delegate"`
+	Undelegate int `yaml:"undelegate"`
+}
+
+// Range is an inclusive range of values of which one is drawn uniformly.
+type Range struct {
+	Min int64 `yaml:"min"`
+	Max int64 `yaml:"max"`
+}
+
+// PFBConfig configures PayForBlobs transactions.
+type PFBConfig struct {
+	// Size is the size of every blob in bytes.
+	Size Range `yaml:"size"`
+	// Blobs is the number of blobs per transaction.
+	Blobs Range `yaml:"blobs"`
+	// Namespaces is the number of distinct namespaces blobs are sent to.
+	Namespaces int `yaml:"namespaces"`
+}
+
+// PFFConfig configures PayForFibre transactions.
+type PFFConfig struct {
+	// Size is the upload size of the paid for blob in bytes.
+	Size Range `yaml:"size"`
+	// Namespaces is the number of distinct namespaces blobs are paid for in.
+	Namespaces int `yaml:"namespaces"`
+	// EscrowDeposit is the amount in utia an account deposits to its escrow
+	// account when its balance no longer covers the next payment.
+	EscrowDeposit int64 `yaml:"escrow_deposit"`
+}
+
+// NewValidator creates a validator at Height with a self delegation of Stake
+// utia. Its operator is the next workload account that is not an operator yet.
+type NewValidator struct {
+	Height int64 `yaml:"height"`
+	Stake  int64 `yaml:"stake"`
+}
+
+// Upgrade makes all validators signal AppVersion and submits a MsgTryUpgrade
+// at Height. The upgrade happens after the upgrade height delay of the chain
+// ID, which is only a few blocks for the chain ID "test".
+type Upgrade struct {
+	Height     int64  `yaml:"height"`
+	AppVersion uint64 `yaml:"app_version"`
+}
+
+// LoadWorkload reads a workload file.
+func LoadWorkload(path string) (*Workload, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read workload file: %w", err)
+	}
+	var workload Workload
+	if err := yaml.UnmarshalStrict(bz, &workload); err != nil {
+		return nil, fmt.Errorf("failed to parse workload file: %w", err)
+	}
+	return &workload, nil
+}
+
+// Validate checks that the workload can be generated for a chain that starts
+// at appVersion.
+func (w *Workload) Validate(appVersion uint64) error {
+	if w.Accounts <= 0 {
+		return errors.New("accounts must be positive")
+	}
+	if w.TxsPerBlock < 0 {
+		return errors.New("txs_per_block must not be negative")
+	}
+	weights := []int{w.Mix.Send, w.Mix.PFB, w.Mix.PFF, w.Mix.Delegate, w.Mix.Undelegate}
+	total := 0
+	for _, weight := range weights {
+		if weight < 0 {
+			return errors.New("mix weights must not be negative")
+		}
+		total += weight
+	}
+	if w.TxsPerBlock > 0 && total == 0 {
+		return errors.New("mix must have at least one positive weight")
+	}
+
+	if w.Mix.Send > 0 {
+		if err := w.Send.validate("send", 1); err != nil {
+			return err
+		}
+	}
+	if w.Mix.PFB > 0 {
+		if err := w.PFB.Size.validate("pfb.size", 1); err != nil {
+			return err
+		}
+		if err := w.PFB.Blobs.validate("pfb.blobs", 1); err != nil {
+			return err
+		}
+		if w.PFB.Namespaces <= 0 || w.PFB.Namespaces > maxNamespaces {
+			return fmt.Errorf("pfb.namespaces must be between 1 and %d", maxNamespaces)
+		}
+	}
+	if w.Mix.PFF > 0 {
+		if err := w.PFF.Size.validate("pff.size", 1); err != nil {
+			return err
+		}
+		if w.PFF.Size.Max > int64(^uint32(0)) {
+			return fmt.Errorf("pff.size must not exceed %d", ^uint32(0))
+		}
+		if w.PFF.Namespaces <= 0 || w.PFF.Namespaces > maxNamespaces {
+			return fmt.Errorf("pff.namespaces must be between 1 and %d", maxNamespaces)
+		}
+		if w.PFF.EscrowDeposit <= 0 {
+			return errors.New("pff.escrow_deposit must be positive")
+		}
+	}
+	if w.Mix.Delegate > 0 || w.Mix.Undelegate > 0 {
+		if err := w.Delegate.validate("delegate", 1); err != nil {
+			return err
+		}
+	}
+
+	if len(w.Validators) > w.Accounts {
+		return fmt.Errorf("%d validators need at least as many accounts, got %d", len(w.Validators), w.Accounts)
+	}
+	for i, validator := range w.Validators {
+		if validator.Height < 2 {
+			return fmt.Errorf("validators[%d].height must be at least 2", i)
+		}
+		// validators with less stake than the power reduction have no voting
+		// power and never join the validator set.
+		if validator.Stake < sdk.DefaultPowerReduction.Int64() || validator.Stake >= genesis.DefaultInitialBalance {
+			return fmt.Errorf("validators[%d].stake must be at least %d and less than %d", i, sdk.DefaultPowerReduction.Int64(), int64(genesis.DefaultInitialBalance))
+		}
+	}
+
+	for i, upgrade := range w.Upgrades {
+		if upgrade.Height < 2 {
+			return fmt.Errorf("upgrades[%d].height must be at least 2", i)
+		}
+		// the native app only has an upgrade handler for its own version.
+		if upgrade.AppVersion != appconsts.Version {
+			return fmt.Errorf("upgrades[%d].app_version must be %d, the version of the native app", i, appconsts.Version)
+		}
+		if appVersion >= upgrade.AppVersion {
+			return fmt.Errorf("upgrades[%d].app_version %d must be greater than the app version of the chain %d", i, upgrade.AppVersion, appVersion)
+		}
+	}
+	if len(w.Upgrades) > 1 {
+		return errors.New("at most one upgrade is supported")
+	}
+	return nil
+}
+
+func (r Range) validate(name string, minimum int64) error {
+	if r.Min < minimum {
+		return fmt.Errorf("%s.min must be at least %d", name, minimum)
+	}
+	if r.Max < r.Min {
+		return fmt.Errorf("%s.max must not be less than %s.min", name, name)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadWorkload(t *testing.T) {
+	workload, err := LoadWorkload("workload.example.yaml")
+	require.NoError(t, err)
+	require.NoError(t, workload.Validate(appconsts.Version))
+	require.Equal(t, uint64(42), workload.Seed)
+	require.Equal(t, 20, workload.Accounts)
+	require.Equal(t, TxMix{Send: 40, PFB: 30, PFF: 10, Delegate: 15, Undelegate: 5}, workload.Mix)
+	require.Len(t, workload.Validators, 2)
+
+	path := filepath.Join(t.TempDir(), "workload.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("accounts: 1\nunknown: 1\n"), 0o600))
+	_, err = LoadWorkload(path)
+	require.ErrorContains(t, err, "unknown")
+}
+
+func TestWorkloadValidate(t *testing.T) {
+	valid := func() *Workload {
+		return &Workload{
+			Accounts:    2,
+			TxsPerBlock: 10,
+			Mix:         TxMix{Send: 1, PFB: 1},
+			Send:        Range{Min: 1, Max: 10},
+			PFB:         PFBConfig{Size: Range{Min: 1, Max: 10}, Blobs: Range{Min: 1, Max: 2}, Namespaces: 1},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		modify     func(*Workload)
+		appVersion uint64
+		wantErr    string
+	}{
+		{
+			name:   "valid",
+			modify: func(*Workload) {},
+		},
+		{
+			name:    "no accounts",
+			modify:  func(w *Workload) { w.Accounts = 0 },
+			wantErr: "accounts must be positive",
+		},
+		{
+			name:    "empty mix",
+			modify:  func(w *Workload) { w.Mix = TxMix{} },
+			wantErr: "at least one positive weight",
+		},
+		{
+			name:    "negative weight",
+			modify:  func(w *Workload) { w.Mix.PFF = -1 },
+			wantErr: "must not be negative",
+		},
+		{
+			name:    "inverted range",
+			modify:  func(w *Workload) { w.Send = Range{Min: 10, Max: 1} },
+			wantErr: "send.max must not be less than send.min",
+		},
+		{
+			name:    "pff without escrow deposit",
+			modify:  func(w *Workload) { w.Mix.PFF = 1; w.PFF = PFFConfig{Size: Range{Min: 1, Max: 1}, Namespaces: 1} },
+			wantErr: "pff.escrow_deposit must be positive",
+		},
+		{
+			name:    "more validators than accounts",
+			modify:  func(w *Workload) { w.Validators = make([]NewValidator, 3) },
+			wantErr: "3 validators need at least as many accounts",
+		},
+		{
+			name:    "validator without voting power",
+			modify:  func(w *Workload) { w.Validators = []NewValidator{{Height: 2, Stake: 1}} },
+			wantErr: "validators[0].stake must be at least",
+		},
+		{
+			name:       "upgrade",
+			modify:     func(w *Workload) { w.Upgrades = []Upgrade{{Height: 5, AppVersion: appconsts.Version}} },
+			appVersion: appconsts.Version - 1,
+		},
+		{
+			name:       "upgrade to the current version",
+			modify:     func(w *Workload) { w.Upgrades = []Upgrade{{Height: 5, AppVersion: appconsts.Version}} },
+			appVersion: appconsts.Version,
+			wantErr:    "must be greater than the app version of the chain",
+		},
+		{
+			name:       "upgrade to an unknown version",
+			modify:     func(w *Workload) { w.Upgrades = []Upgrade{{Height: 5, AppVersion: appconsts.Version + 1}} },
+			appVersion: appconsts.Version,
+			wantErr:    "the version of the native app",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			workload := valid()
+			tc.modify(workload)
+			appVersion := tc.appVersion
+			if appVersion == 0 {
+				appVersion = appconsts.Version
+			}
+			err := workload.Validate(appVersion)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestRunWorkload(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping chainbuilder workload test")
+	}
+
+	workload := &Workload{
+		Seed:        7,
+		Accounts:    5,
+		TxsPerBlock: 20,
+		Mix:         TxMix{Send: 4, PFB: 3, PFF: 1, Delegate: 2, Undelegate: 1},
+		Send:        Range{Min: 1, Max: 1000},
+		PFB:         PFBConfig{Size: Range{Min: 100, Max: 10_000}, Blobs: Range{Min: 1, Max: 3}, Namespaces: 3},
+		PFF:         PFFConfig{Size: Range{Min: 1000, Max: 1_000_000}, Namespaces: 2, EscrowDeposit: 10_000_000},
+		Delegate:    Range{Min: 1_000_000, Max: 10_000_000},
+		Validators:  []NewValidator{{Height: 3, Stake: 1_000_000_000}},
+	}
+	require.NoError(t, workload.Validate(appconsts.Version))
+
+	// run builds a chain from the workload and returns the number of
+	// transactions of every block.
+	run := func(chainID string) (txs []int) {
+		cfg := BuilderConfig{
+			NumBlocks:     10,
+			BlockSize:     appconsts.DefaultMaxBytes,
+			BlockInterval: time.Second,
+			ChainID:       chainID,
+			Namespace:     defaultNamespace,
+			AppVersion:    appconsts.Version,
+			Workload:      workload,
+		}
+		dir := t.TempDir()
+		require.NoError(t, Run(context.Background(), cfg, dir))
+
+		db, err := dbm.NewDB("blockstore", dbm.GoLevelDBBackend, filepath.Join(dir, fmt.Sprintf("testnode-%s", chainID), "data"))
+		require.NoError(t, err)
+		defer db.Close()
+		blockStore := store.NewBlockStore(db)
+		require.EqualValues(t, cfg.NumBlocks, blockStore.Height())
+
+		for height := int64(1); height <= blockStore.Height(); height++ {
+			block := blockStore.LoadBlock(height)
+			txs = append(txs, len(block.Txs))
+		}
+		// the validator created at height 3 signs from height 5 on.
+		commit := blockStore.LoadSeenCommit(blockStore.Height())
+		require.Len(t, commit.Signatures, 2)
+		return txs
+	}
+
+	txs := run("chain-a")
+	require.Zero(t, txs[0], "the first block is empty")
+	require.NotZero(t, txs[1])
+	// the same workload and seed produce the same transactions.
+	require.Equal(t, txs, run("chain-b"))
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v10/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v10/fibre"
 	"github.com/celestiaorg/celestia-app/v10/fibre/state"
+	"github.com/celestiaorg/celestia-app/v10/pkg/remotesigner"
 	"github.com/celestiaorg/celestia-app/v10/pkg/user"
 	fibretypes "github.com/celestiaorg/celestia-app/v10/x/fibre/types"
 	"github.com/celestiaorg/go-square/v4/share"
@@ -52,6 +53,8 @@ type config struct {
 	pyroscopeEndpoint string
 	pyroscopeUser     string
 	pyroscopePass     string
+	remoteSigner      string
+	remoteSignerTLS   remotesigner.TLSConfig
 }
 
 func main() {
@@ -69,6 +72,10 @@ func main() {
 	flag.StringVar(&cfg.pyroscopeEndpoint, "pyroscope-endpoint", "", "Pyroscope endpoint for continuous profiling (e.g. http://host:4040)")
 	flag.StringVar(&cfg.pyroscopeUser, "pyroscope-basic-auth-user", "", "Pyroscope basic auth username")
 	flag.StringVar(&cfg.pyroscopePass, "pyroscope-basic-auth-password", "", "Pyroscope basic auth password")
+	flag.StringVar(&cfg.remoteSigner, "remote-signer", "", "address of a remote signing service to sign with instead of the local keyring")
+	flag.StringVar(&cfg.remoteSignerTLS.CertFile, "remote-signer-tls-cert", "", "TLS client certificate for the remote signing service")
+	flag.StringVar(&cfg.remoteSignerTLS.KeyFile, "remote-signer-tls-key", "", "private key of the TLS client certificate")
+	flag.StringVar(&cfg.remoteSignerTLS.CAFile, "remote-signer-tls-ca", "", "certificate of the CA that issued the remote signing service's certificate")
 	chainID := flag.String("chain-id", "", "chain ID of the network (unused, accepted for compatibility)")
 	flag.Parse()
 	_ = chainID // accepted but unused
@@ -156,7 +163,7 @@ func run(cfg config) error {
 
 	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
 
-	kr, err := keyring.New(app.Name, keyring.BackendTest, cfg.keyringDir, nil, encCfg.Codec)
+	kr, err := newKeyring(cfg, encCfg)
 	if err != nil {
 		return fmt.Errorf("failed to initialize keyring: %w", err)
 	}
@@ -373,6 +380,19 @@ func run(cfg config) error {
 	return nil
 }
 
+// newKeyring returns the local test keyring or, if a remote signer is
+// configured, a keyring that signs with the remote signer's keys.
+func newKeyring(cfg config, encCfg encoding.Config) (keyring.Keyring, error) {
+	if cfg.remoteSigner == "" {
+		return keyring.New(app.Name, keyring.BackendTest, cfg.keyringDir, nil, encCfg.Codec)
+	}
+	conn, err := remotesigner.Dial(cfg.remoteSigner, cfg.remoteSignerTLS)
+	if err != nil {
+		return nil, err
+	}
+	return remotesigner.NewKeyring(context.Background(), conn, encCfg.Codec)
+}
+
 func setupOTelMetrics(ctx context.Context, endpoint string) (func(context.Context), error) {
 	exp, err := otlpmetrichttp.New(ctx, otlpmetrichttp.WithEndpointURL(endpoint))
 	if err != nil {
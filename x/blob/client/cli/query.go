@@ -19,7 +19,11 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(
+		CmdQueryParams(),
+		CmdQueryReservations(),
+		CmdQueryReservation(),
+	)
 
 	return cmd
 }
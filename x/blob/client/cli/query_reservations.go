@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"encoding/hex"
+	"errors"
+
+	"github.com/celestiaorg/celestia-app/v10/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagNamespace = "namespace"
+	FlagSigner    = "signer"
+)
+
+func CmdQueryReservations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reservations",
+		Short: "shows all square space reservations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Reservations(cmd.Context(), &types.QueryReservationsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "reservations")
+
+	return cmd
+}
+
+func CmdQueryReservation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reservation",
+		Short: "shows the square space reservation of a namespace or signer",
+		Example: `celestia-appd query blob reservation --namespace 000000000000000000000000000000000000000102030405060708090a
+celestia-appd query blob reservation --signer celestia1...`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			namespaceHex, err := cmd.Flags().GetString(FlagNamespace)
+			if err != nil {
+				return err
+			}
+			signer, err := cmd.Flags().GetString(FlagSigner)
+			if err != nil {
+				return err
+			}
+			if (namespaceHex == "") == (signer == "") {
+				return errors.New("exactly one of --namespace and --signer must be set")
+			}
+
+			req := &types.QueryReservationRequest{Signer: signer}
+			if namespaceHex != "" {
+				req.Namespace, err = hex.DecodeString(namespaceHex)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Reservation(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagNamespace, "", "Hex encoded namespace (version byte followed by the 28 byte ID)")
+	cmd.Flags().String(FlagSigner, "", "Bech32 address of the signer")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -15,6 +15,13 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		return fmt.Errorf("invalid blob genesis state parameters: %w", err)
 	}
 	k.SetParams(sdkCtx, genState.Params)
+
+	if err := types.ValidateReservations(genState.Reservations); err != nil {
+		return fmt.Errorf("invalid blob genesis state reservations: %w", err)
+	}
+	for _, reservation := range genState.Reservations {
+		k.setReservation(sdkCtx, reservation)
+	}
 	return nil
 }
 
@@ -23,5 +30,6 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(sdkCtx)
+	genesis.Reservations = k.GetReservations(sdkCtx)
 	return genesis
 }
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v10/x/blob/types"
 	"github.com/stretchr/testify/require"
@@ -11,6 +12,9 @@ import (
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		Reservations: []types.Reservation{
+			{Signer: "celestia15drmhzw5kwgenvemy30rqqqgq52axf5wwrruf7", ShareFraction: math.LegacyNewDecWithPrec(1, 1)},
+		},
 	}
 
 	k, _, ctx := CreateKeeper(t, appconsts.Version)
@@ -19,4 +23,5 @@ func TestGenesis(t *testing.T) {
 	got := k.ExportGenesis(ctx)
 	require.NotNil(t, got)
 	require.Equal(t, types.DefaultParams(), got.Params)
+	require.Equal(t, genesisState.Reservations, got.Reservations)
 }
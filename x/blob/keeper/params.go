@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v10/x/blob/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -23,6 +24,14 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return params
 }
 
+// MaxEffectiveSquareSize returns the max effective square size: the governance
+// max square size capped by the upper bound of the chain.
+func (k Keeper) MaxEffectiveSquareSize(ctx sdk.Context) int {
+	govMax := k.GetParams(ctx).GovMaxSquareSize
+	hardMax := appconsts.GetSquareSizeUpperBound(ctx.ChainID())
+	return min(int(govMax), hardMax)
+}
+
 // SetParams sets the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	store := ctx.KVStore(k.storeKey)
@@ -16,3 +16,16 @@ func TestGetParams(t *testing.T) {
 
 	require.EqualValues(t, params, k.GetParams(ctx))
 }
+
+func TestMaxEffectiveSquareSize(t *testing.T) {
+	k, _, ctx := CreateKeeper(t, appconsts.Version)
+	params := types.DefaultParams()
+
+	k.SetParams(ctx, params)
+	require.Equal(t, int(params.GovMaxSquareSize), k.MaxEffectiveSquareSize(ctx))
+
+	// The governance max square size is capped by the upper bound.
+	params.GovMaxSquareSize = uint64(appconsts.SquareSizeUpperBound) * 2
+	k.SetParams(ctx, params)
+	require.Equal(t, appconsts.SquareSizeUpperBound, k.MaxEffectiveSquareSize(ctx))
+}
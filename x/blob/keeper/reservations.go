@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-app/v10/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxReservationsPaginationLimit is the maximum number of reservations
+// returned by a single Reservations query.
+const maxReservationsPaginationLimit = 100
+
+func (k Keeper) reservationsStore(ctx sdk.Context) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReservationsKeyPrefix))
+}
+
+// GetReservation returns the reservation of namespace or signer.
+func (k Keeper) GetReservation(ctx sdk.Context, namespace []byte, signer string) (types.Reservation, bool) {
+	bz := k.reservationsStore(ctx).Get(types.ReservationKey(namespace, signer))
+	if len(bz) == 0 {
+		return types.Reservation{}, false
+	}
+
+	var reservation types.Reservation
+	k.cdc.MustUnmarshal(bz, &reservation)
+	return reservation, true
+}
+
+// GetReservations returns all reservations. Namespace reservations are
+// returned before signer reservations and each group is ordered by key, which
+// is the order in which blob txs are matched against them.
+func (k Keeper) GetReservations(ctx sdk.Context) []types.Reservation {
+	iterator := k.reservationsStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var reservations []types.Reservation
+	for ; iterator.Valid(); iterator.Next() {
+		var reservation types.Reservation
+		k.cdc.MustUnmarshal(iterator.Value(), &reservation)
+		reservations = append(reservations, reservation)
+	}
+	return reservations
+}
+
+// setReservation stores the reservation, replacing any existing reservation
+// for the same namespace or signer.
+func (k Keeper) setReservation(ctx sdk.Context, reservation types.Reservation) {
+	bz := k.cdc.MustMarshal(&reservation)
+	k.reservationsStore(ctx).Set(types.ReservationKey(reservation.Namespace, reservation.Signer), bz)
+}
+
+// deleteReservation removes the reservation of namespace or signer.
+func (k Keeper) deleteReservation(ctx sdk.Context, namespace []byte, signer string) {
+	k.reservationsStore(ctx).Delete(types.ReservationKey(namespace, signer))
+}
+
+// MaxSquareShares returns the number of shares in the max effective square,
+// which reservation fractions apply to.
+func (k Keeper) MaxSquareShares(ctx sdk.Context) int {
+	squareSize := k.MaxEffectiveSquareSize(ctx)
+	return squareSize * squareSize
+}
+
+// SetReservation creates or replaces a square space reservation.
+func (k Keeper) SetReservation(goCtx context.Context, msg *types.MsgSetReservation) (*types.MsgSetReservationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.GetAuthority() {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority: expected: %s, got: %s", k.authority, msg.Authority)
+	}
+
+	// Validate the reservation together with the existing ones, excluding the
+	// one it replaces, so that the total reserved fraction stays bounded.
+	key := string(types.ReservationKey(msg.Reservation.Namespace, msg.Reservation.Signer))
+	reservations := []types.Reservation{msg.Reservation}
+	for _, reservation := range k.GetReservations(ctx) {
+		if string(types.ReservationKey(reservation.Namespace, reservation.Signer)) != key {
+			reservations = append(reservations, reservation)
+		}
+	}
+	if err := types.ValidateReservations(reservations); err != nil {
+		return nil, err
+	}
+
+	k.setReservation(ctx, msg.Reservation)
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewSetReservationEvent(msg.Authority, msg.Reservation),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetReservationResponse{}, nil
+}
+
+// RemoveReservation removes a square space reservation.
+func (k Keeper) RemoveReservation(goCtx context.Context, msg *types.MsgRemoveReservation) (*types.MsgRemoveReservationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.GetAuthority() {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority: expected: %s, got: %s", k.authority, msg.Authority)
+	}
+
+	if err := types.ValidateReservationTarget(msg.Namespace, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	reservation, found := k.GetReservation(ctx, msg.Namespace, msg.Signer)
+	if !found {
+		return nil, errors.Wrapf(types.ErrReservationNotFound, "namespace %X signer %q", msg.Namespace, msg.Signer)
+	}
+	k.deleteReservation(ctx, msg.Namespace, msg.Signer)
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewRemoveReservationEvent(msg.Authority, reservation),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveReservationResponse{}, nil
+}
+
+// Reservations returns all square space reservations.
+func (k Keeper) Reservations(c context.Context, req *types.QueryReservationsRequest) (*types.QueryReservationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pagination := req.Pagination
+	if pagination == nil {
+		pagination = &query.PageRequest{Limit: maxReservationsPaginationLimit}
+	} else if pagination.Limit == 0 || pagination.Limit > maxReservationsPaginationLimit {
+		pagination = &query.PageRequest{
+			Key:        pagination.Key,
+			Offset:     pagination.Offset,
+			Limit:      maxReservationsPaginationLimit,
+			CountTotal: pagination.CountTotal,
+			Reverse:    pagination.Reverse,
+		}
+	}
+
+	var reservations []types.Reservation
+	pageRes, err := query.Paginate(k.reservationsStore(ctx), pagination, func(_, value []byte) error {
+		var reservation types.Reservation
+		if err := k.cdc.Unmarshal(value, &reservation); err != nil {
+			return err
+		}
+		reservations = append(reservations, reservation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryReservationsResponse{
+		Reservations:    reservations,
+		MaxSquareShares: uint64(k.MaxSquareShares(ctx)),
+		Pagination:      pageRes,
+	}, nil
+}
+
+// Reservation returns the square space reservation of a namespace or signer.
+func (k Keeper) Reservation(c context.Context, req *types.QueryReservationRequest) (*types.QueryReservationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateReservationTarget(req.Namespace, req.Signer); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	reservation, found := k.GetReservation(ctx, req.Namespace, req.Signer)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no reservation for namespace %X signer %q", req.Namespace, req.Signer)
+	}
+
+	return &types.QueryReservationResponse{
+		Reservation:    reservation,
+		ReservedShares: uint64(reservation.ReservedShares(k.MaxSquareShares(ctx))),
+	}, nil
+}
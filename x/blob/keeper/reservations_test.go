@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v10/x/blob/types"
+	"github.com/celestiaorg/go-square/v4/share"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReservations(t *testing.T) {
+	k, _, ctx := CreateKeeper(t, appconsts.Version)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	signer := "celestia15drmhzw5kwgenvemy30rqqqgq52axf5wwrruf7"
+	namespace := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize)).Bytes()
+
+	byNamespace := types.Reservation{Namespace: namespace, ShareFraction: math.LegacyNewDecWithPrec(1, 1)}
+	bySigner := types.Reservation{Signer: signer, ShareFraction: math.LegacyNewDecWithPrec(2, 1)}
+
+	_, err := k.SetReservation(ctx, types.NewMsgSetReservation(signer, byNamespace))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = k.SetReservation(ctx, types.NewMsgSetReservation(authority, byNamespace))
+	require.NoError(t, err)
+	_, err = k.SetReservation(ctx, types.NewMsgSetReservation(authority, bySigner))
+	require.NoError(t, err)
+	require.Equal(t, []types.Reservation{byNamespace, bySigner}, k.GetReservations(ctx))
+
+	// The total reserved fraction may not exceed the maximum.
+	tooLarge := types.Reservation{Signer: signer, ShareFraction: math.LegacyNewDecWithPrec(45, 2)}
+	_, err = k.SetReservation(ctx, types.NewMsgSetReservation(authority, tooLarge))
+	require.ErrorIs(t, err, types.ErrInvalidReservation)
+
+	// Replacing a reservation does not count the replaced fraction.
+	bySigner.ShareFraction = math.LegacyNewDecWithPrec(4, 1)
+	_, err = k.SetReservation(ctx, types.NewMsgSetReservation(authority, bySigner))
+	require.NoError(t, err)
+
+	maxSquareShares := appconsts.DefaultGovMaxSquareSize * appconsts.DefaultGovMaxSquareSize
+	resp, err := k.Reservation(ctx, &types.QueryReservationRequest{Signer: signer})
+	require.NoError(t, err)
+	require.Equal(t, bySigner, resp.Reservation)
+	require.Equal(t, uint64(maxSquareShares*4/10), resp.ReservedShares)
+
+	all, err := k.Reservations(ctx, &types.QueryReservationsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.Reservation{byNamespace, bySigner}, all.Reservations)
+	require.Equal(t, uint64(maxSquareShares), all.MaxSquareShares)
+
+	_, err = k.RemoveReservation(ctx, types.NewMsgRemoveReservation(authority, namespace, ""))
+	require.NoError(t, err)
+	_, err = k.RemoveReservation(ctx, types.NewMsgRemoveReservation(authority, namespace, ""))
+	require.ErrorIs(t, err, types.ErrReservationNotFound)
+
+	_, err = k.Reservation(ctx, &types.QueryReservationRequest{Namespace: namespace})
+	require.Error(t, err)
+	require.Equal(t, []types.Reservation{bySigner}, k.GetReservations(ctx))
+}
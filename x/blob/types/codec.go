@@ -18,6 +18,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPayForBlobs{},
 		&MsgUpdateBlobParams{},
+		&MsgSetReservation{},
+		&MsgRemoveReservation{},
 	)
 
 	registry.RegisterInterface(
@@ -38,4 +38,6 @@ var (
 	ErrBlobsTooLarge         = errors.Register(ModuleName, 11139, "blob(s) too large")
 	ErrInvalidBlobSigner     = errors.Register(ModuleName, 11140, "invalid blob signer")
 	ErrShareVersionMismatch  = errors.Register(ModuleName, 11141, "share version of blob and its respective MsgPayForBlobs differ")
+	ErrInvalidReservation    = errors.Register(ModuleName, 11142, "invalid reservation")
+	ErrReservationNotFound   = errors.Register(ModuleName, 11143, "reservation not found")
 )
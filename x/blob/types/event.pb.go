@@ -142,33 +142,147 @@ func (m *EventUpdateBlobParams) GetParams() Params {
 	return Params{}
 }
 
+// EventSetReservation defines an event that is emitted when a square space
+// reservation is created or updated by governance.
+type EventSetReservation struct {
+	Signer      string      `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Reservation Reservation `protobuf:"bytes,2,opt,name=reservation,proto3" json:"reservation"`
+}
+
+func (m *EventSetReservation) Reset()         { *m = EventSetReservation{} }
+func (m *EventSetReservation) String() string { return proto.CompactTextString(m) }
+func (*EventSetReservation) ProtoMessage()    {}
+func (*EventSetReservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d90f0a63835a06e, []int{2}
+}
+func (m *EventSetReservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetReservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetReservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetReservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetReservation.Merge(m, src)
+}
+func (m *EventSetReservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetReservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetReservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetReservation proto.InternalMessageInfo
+
+func (m *EventSetReservation) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *EventSetReservation) GetReservation() Reservation {
+	if m != nil {
+		return m.Reservation
+	}
+	return Reservation{}
+}
+
+// EventRemoveReservation defines an event that is emitted when a square space
+// reservation is removed by governance.
+type EventRemoveReservation struct {
+	Signer      string      `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Reservation Reservation `protobuf:"bytes,2,opt,name=reservation,proto3" json:"reservation"`
+}
+
+func (m *EventRemoveReservation) Reset()         { *m = EventRemoveReservation{} }
+func (m *EventRemoveReservation) String() string { return proto.CompactTextString(m) }
+func (*EventRemoveReservation) ProtoMessage()    {}
+func (*EventRemoveReservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d90f0a63835a06e, []int{3}
+}
+func (m *EventRemoveReservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRemoveReservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRemoveReservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRemoveReservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRemoveReservation.Merge(m, src)
+}
+func (m *EventRemoveReservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRemoveReservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRemoveReservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRemoveReservation proto.InternalMessageInfo
+
+func (m *EventRemoveReservation) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *EventRemoveReservation) GetReservation() Reservation {
+	if m != nil {
+		return m.Reservation
+	}
+	return Reservation{}
+}
+
 func init() {
 	proto.RegisterType((*EventPayForBlobs)(nil), "celestia.blob.v1.EventPayForBlobs")
 	proto.RegisterType((*EventUpdateBlobParams)(nil), "celestia.blob.v1.EventUpdateBlobParams")
+	proto.RegisterType((*EventSetReservation)(nil), "celestia.blob.v1.EventSetReservation")
+	proto.RegisterType((*EventRemoveReservation)(nil), "celestia.blob.v1.EventRemoveReservation")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/event.proto", fileDescriptor_9d90f0a63835a06e) }
 
 var fileDescriptor_9d90f0a63835a06e = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b,
-	0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xea, 0x81, 0x64, 0xf5,
-	0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94,
-	0x2c, 0x86, 0x29, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x10, 0x69, 0xa5, 0x4c, 0x2e, 0x01, 0x57,
-	0x90, 0xa9, 0x01, 0x89, 0x95, 0x6e, 0xf9, 0x45, 0x4e, 0x39, 0xf9, 0x49, 0xc5, 0x42, 0x62, 0x5c,
-	0x6c, 0xc5, 0x99, 0xe9, 0x79, 0xa9, 0x45, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x50, 0x9e,
-	0x90, 0x2c, 0x17, 0x17, 0xc8, 0x8c, 0xf8, 0xe2, 0xcc, 0xaa, 0xd4, 0x62, 0x09, 0x26, 0x05, 0x66,
-	0x0d, 0xde, 0x20, 0x4e, 0x90, 0x48, 0x30, 0x48, 0x40, 0x48, 0x8e, 0x8b, 0x2b, 0x2f, 0x31, 0x37,
-	0xb5, 0xb8, 0x20, 0x31, 0x39, 0xb5, 0x58, 0x82, 0x59, 0x81, 0x59, 0x83, 0x27, 0x08, 0x49, 0x44,
-	0x29, 0x9d, 0x4b, 0x14, 0x6c, 0x55, 0x68, 0x41, 0x4a, 0x62, 0x49, 0x2a, 0xc8, 0xaa, 0x00, 0xb0,
-	0x4b, 0x70, 0xda, 0x67, 0xc6, 0xc5, 0x06, 0x71, 0xab, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xb7, 0x91,
-	0x84, 0x1e, 0xba, 0x9f, 0xf5, 0x20, 0x26, 0x38, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0x55,
-	0xed, 0xe4, 0x75, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x06, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x30, 0xb3, 0xf2, 0x8b, 0xd2, 0xe1, 0x6c,
-	0xdd, 0xc4, 0x82, 0x02, 0xfd, 0x0a, 0x48, 0x48, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81,
-	0x83, 0xc9, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x10, 0x54, 0xa1, 0x8a, 0x8d, 0x01, 0x00, 0x00,
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xc1, 0x4a, 0xfb, 0x40,
+	0x10, 0xc6, 0x93, 0xf6, 0x4f, 0xa1, 0xd3, 0xbf, 0x50, 0xa2, 0x96, 0x50, 0xec, 0x1a, 0x72, 0xca,
+	0xc5, 0xc4, 0x2a, 0xf8, 0x00, 0x85, 0x7a, 0xf0, 0x54, 0x52, 0xbc, 0x78, 0x91, 0x4d, 0x1d, 0x62,
+	0xa0, 0xc9, 0x2e, 0xbb, 0x6b, 0xb4, 0x3e, 0x85, 0x8f, 0xd5, 0x63, 0x8f, 0x9e, 0x44, 0xda, 0x17,
+	0x91, 0x4d, 0x52, 0x0d, 0x96, 0x5e, 0xbd, 0x4d, 0xe6, 0xfb, 0xf2, 0xfd, 0x76, 0x86, 0x81, 0x93,
+	0x19, 0xce, 0x51, 0xaa, 0x84, 0x06, 0xd1, 0x9c, 0x45, 0x41, 0x3e, 0x0c, 0x30, 0xc7, 0x4c, 0xf9,
+	0x5c, 0x30, 0xc5, 0xac, 0xee, 0x56, 0xf5, 0xb5, 0xea, 0xe7, 0xc3, 0xfe, 0x51, 0xcc, 0x62, 0x56,
+	0x88, 0x81, 0xae, 0x4a, 0x5f, 0x7f, 0xb0, 0x93, 0xc2, 0xa9, 0xa0, 0xa9, 0xac, 0x64, 0x77, 0x47,
+	0x16, 0x28, 0x51, 0xe4, 0x54, 0x25, 0x2c, 0x2b, 0x3d, 0x6e, 0x02, 0xdd, 0xb1, 0x26, 0x4f, 0xe8,
+	0xe2, 0x9a, 0x89, 0xd1, 0x9c, 0x45, 0xd2, 0xea, 0x41, 0x4b, 0x26, 0x71, 0x86, 0xc2, 0x36, 0x1d,
+	0xd3, 0x6b, 0x87, 0xd5, 0x97, 0x35, 0x00, 0xd0, 0x41, 0xf7, 0x32, 0x79, 0x45, 0x69, 0x37, 0x9c,
+	0xa6, 0x77, 0x10, 0xb6, 0x75, 0x67, 0xaa, 0x1b, 0x16, 0x01, 0xc8, 0x68, 0x8a, 0x92, 0xd3, 0x19,
+	0x4a, 0xbb, 0xe9, 0x34, 0xbd, 0xff, 0x61, 0xad, 0xe3, 0xc6, 0x70, 0x5c, 0xa0, 0x6e, 0xf9, 0x03,
+	0x55, 0xa8, 0x51, 0x93, 0xe2, 0xb5, 0x7b, 0x79, 0x57, 0xd0, 0x2a, 0xe7, 0xb1, 0x1b, 0x8e, 0xe9,
+	0x75, 0x2e, 0x6c, 0xff, 0xf7, 0x5e, 0xfc, 0x32, 0x61, 0xf4, 0x6f, 0xf9, 0x71, 0x6a, 0x84, 0x95,
+	0xdb, 0x55, 0x70, 0x58, 0x80, 0xa6, 0xa8, 0xc2, 0x9f, 0x81, 0xf7, 0x62, 0xc6, 0xd0, 0xa9, 0xed,
+	0xa5, 0x62, 0x0d, 0x76, 0x59, 0xb5, 0xac, 0x0a, 0x58, 0xff, 0xcf, 0x7d, 0x86, 0x5e, 0x41, 0x0d,
+	0x31, 0x65, 0x39, 0xfe, 0x1d, 0x78, 0x74, 0xb3, 0x5c, 0x13, 0x73, 0xb5, 0x26, 0xe6, 0xe7, 0x9a,
+	0x98, 0x6f, 0x1b, 0x62, 0xac, 0x36, 0xc4, 0x78, 0xdf, 0x10, 0xe3, 0xee, 0x3c, 0x4e, 0xd4, 0xe3,
+	0x53, 0xe4, 0xcf, 0x58, 0x1a, 0x6c, 0x53, 0x99, 0x88, 0xbf, 0xeb, 0x33, 0xca, 0x79, 0xf0, 0x52,
+	0x5e, 0x87, 0x5a, 0x70, 0x94, 0x51, 0xab, 0xb8, 0x8a, 0xcb, 0xaf, 0x01, 0x00, 0x4f, 0x25, 0xeb,
+	0x4a, 0xa0, 0x02, 0x00, 0x00,
 }
 
 func (m *EventPayForBlobs) Marshal() (dAtA []byte, err error) {
@@ -268,6 +382,86 @@ func (m *EventUpdateBlobParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSetReservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetReservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetReservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reservation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRemoveReservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRemoveReservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRemoveReservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reservation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -320,6 +514,36 @@ func (m *EventUpdateBlobParams) Size() (n int) {
 	return n
 }
 
+func (m *EventSetReservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Reservation.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventRemoveReservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Reservation.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -631,6 +855,236 @@ func (m *EventUpdateBlobParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventSetReservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetReservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetReservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reservation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reservation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRemoveReservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRemoveReservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRemoveReservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reservation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reservation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,8 +5,10 @@ import (
 )
 
 var (
-	EventTypePayForBlob       = proto.MessageName(&EventPayForBlobs{})
-	EventTypeUpdateBlobParams = proto.MessageName(&EventUpdateBlobParams{})
+	EventTypePayForBlob        = proto.MessageName(&EventPayForBlobs{})
+	EventTypeUpdateBlobParams  = proto.MessageName(&EventUpdateBlobParams{})
+	EventTypeSetReservation    = proto.MessageName(&EventSetReservation{})
+	EventTypeRemoveReservation = proto.MessageName(&EventRemoveReservation{})
 )
 
 // NewPayForBlobsEvent returns a new EventPayForBlobs
@@ -25,3 +27,19 @@ func NewUpdateBlobParamsEvent(authority string, params Params) *EventUpdateBlobP
 		Params: params,
 	}
 }
+
+// NewSetReservationEvent returns a new EventSetReservation
+func NewSetReservationEvent(authority string, reservation Reservation) *EventSetReservation {
+	return &EventSetReservation{
+		Signer:      authority,
+		Reservation: reservation,
+	}
+}
+
+// NewRemoveReservationEvent returns a new EventRemoveReservation
+func NewRemoveReservationEvent(authority string, reservation Reservation) *EventRemoveReservation {
+	return &EventRemoveReservation{
+		Signer:      authority,
+		Reservation: reservation,
+	}
+}
@@ -12,6 +12,19 @@ const (
 
 	// ParamsKey defines the key used for storing module parameters
 	ParamsKey = "params"
+
+	// ReservationsKeyPrefix defines the prefix of the keys used for storing
+	// square space reservations
+	ReservationsKeyPrefix = "reservations/"
+)
+
+const (
+	// reservationNamespaceKeyPrefix prefixes the keys of namespace
+	// reservations within the reservations store.
+	reservationNamespaceKeyPrefix byte = 0x01
+	// reservationSignerKeyPrefix prefixes the keys of signer reservations
+	// within the reservations store.
+	reservationSignerKeyPrefix byte = 0x02
 )
 
 func KeyPrefix(p string) []byte {
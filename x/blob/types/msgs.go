@@ -5,6 +5,8 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 var (
 	_ sdk.Msg = (*MsgPayForBlobs)(nil)
 	_ sdk.Msg = (*MsgUpdateBlobParams)(nil)
+	_ sdk.Msg = (*MsgSetReservation)(nil)
+	_ sdk.Msg = (*MsgRemoveReservation)(nil)
 )
 
 // NewMsgUpdateBlobParams creates a new MsgUpdateBlobParams instance.
@@ -14,3 +16,20 @@ func NewMsgUpdateBlobParams(authority string, params Params) *MsgUpdateBlobParam
 		Params:    params,
 	}
 }
+
+// NewMsgSetReservation creates a new MsgSetReservation instance.
+func NewMsgSetReservation(authority string, reservation Reservation) *MsgSetReservation {
+	return &MsgSetReservation{
+		Authority:   authority,
+		Reservation: reservation,
+	}
+}
+
+// NewMsgRemoveReservation creates a new MsgRemoveReservation instance.
+func NewMsgRemoveReservation(authority string, namespace []byte, signer string) *MsgRemoveReservation {
+	return &MsgRemoveReservation{
+		Authority: authority,
+		Namespace: namespace,
+		Signer:    signer,
+	}
+}
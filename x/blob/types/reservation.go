@@ -0,0 +1,218 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/go-square/v4/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxReservedShareFraction is the maximum fraction of the square that can be
+// reserved by all reservations combined. The rest of the square is always
+// available to the open market.
+var MaxReservedShareFraction = math.LegacyNewDecWithPrec(5, 1) // 50%
+
+// Validate returns an error if the reservation is invalid.
+func (r Reservation) Validate() error {
+	if err := ValidateReservationTarget(r.Namespace, r.Signer); err != nil {
+		return err
+	}
+	if r.ShareFraction.IsNil() || !r.ShareFraction.IsPositive() || r.ShareFraction.GT(MaxReservedShareFraction) {
+		return errors.Wrapf(ErrInvalidReservation, "share fraction must be in (0, %s]: %v", MaxReservedShareFraction, r.ShareFraction)
+	}
+	return nil
+}
+
+// ValidateReservationTarget returns an error unless exactly one of namespace
+// and signer is set and valid.
+func ValidateReservationTarget(namespace []byte, signer string) error {
+	switch {
+	case len(namespace) == 0 && signer == "":
+		return errors.Wrap(ErrInvalidReservation, "either namespace or signer must be set")
+	case len(namespace) != 0 && signer != "":
+		return errors.Wrap(ErrInvalidReservation, "only one of namespace and signer can be set")
+	case len(namespace) != 0:
+		ns, err := share.NewNamespaceFromBytes(namespace)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidReservation, "invalid namespace: %v", err)
+		}
+		if err := ValidateBlobNamespace(ns); err != nil {
+			return errors.Wrapf(ErrInvalidReservation, "invalid namespace: %v", err)
+		}
+	default:
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return errors.Wrapf(ErrInvalidReservation, "invalid signer: %v", err)
+		}
+	}
+	return nil
+}
+
+// ValidateReservations returns an error if any reservation is invalid, if two
+// reservations apply to the same namespace or signer, or if together they
+// reserve more than MaxReservedShareFraction of the square.
+func ValidateReservations(reservations []Reservation) error {
+	seen := make(map[string]struct{}, len(reservations))
+	total := math.LegacyZeroDec()
+	for _, r := range reservations {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		key := string(ReservationKey(r.Namespace, r.Signer))
+		if _, ok := seen[key]; ok {
+			return errors.Wrapf(ErrInvalidReservation, "duplicate reservation for namespace %X signer %q", r.Namespace, r.Signer)
+		}
+		seen[key] = struct{}{}
+		total = total.Add(r.ShareFraction)
+	}
+	if total.GT(MaxReservedShareFraction) {
+		return errors.Wrapf(ErrInvalidReservation, "reservations reserve %s of the square which exceeds the maximum of %s", total, MaxReservedShareFraction)
+	}
+	return nil
+}
+
+// ReservedShares returns the number of shares reserved per block in a square
+// with maxSquareShares shares.
+func (r Reservation) ReservedShares(maxSquareShares int) int {
+	return int(r.ShareFraction.MulInt64(int64(maxSquareShares)).TruncateInt64())
+}
+
+// Matches returns true if the blobs paid for by msg are covered by the
+// reservation.
+func (r Reservation) Matches(msg *MsgPayForBlobs) bool {
+	if r.Signer != "" {
+		return msg.Signer == r.Signer
+	}
+	if len(msg.Namespaces) == 0 {
+		return false
+	}
+	for _, ns := range msg.Namespaces {
+		if !bytes.Equal(ns, r.Namespace) {
+			return false
+		}
+	}
+	return true
+}
+
+// BlobSharesNeeded returns the number of shares occupied by the blobs paid for
+// by msg. It excludes the shares occupied by the tx itself.
+func BlobSharesNeeded(msg *MsgPayForBlobs) (sum int) {
+	for i, blobSize := range msg.BlobSizes {
+		containsSigner := i < len(msg.ShareVersions) && msg.ShareVersions[i] == uint32(share.ShareVersionOne)
+		sum += share.SparseSharesNeeded(blobSize, containsSigner)
+	}
+	return sum
+}
+
+// ReservationLanes tracks the shares left in each reservation while a square
+// is built or validated. Blob txs that fit in a reservation form a priority
+// lane which must precede all other blob txs in the block. The blobs of all
+// other blob txs may only occupy the shares that are not reserved, so that a
+// proposer cannot sell reserved space to the open market.
+//
+// Blob txs from the same signer must stay in sequence order, so once a blob
+// tx of a signer is part of the open market all later blob txs of that signer
+// are too, even if they would fit in a reservation.
+type ReservationLanes struct {
+	reservations []Reservation
+	remaining    []int
+	// open is the number of shares left for blob txs outside of a
+	// reservation.
+	open     int
+	openSeen bool
+	// openSigners are the signers of the blob txs outside of a reservation.
+	openSigners map[string]struct{}
+}
+
+// NewReservationLanes returns the lanes for a square with maxSquareShares
+// shares. Reservations are matched in the order given.
+func NewReservationLanes(reservations []Reservation, maxSquareShares int) *ReservationLanes {
+	remaining := make([]int, len(reservations))
+	open := maxSquareShares
+	for i, r := range reservations {
+		remaining[i] = r.ReservedShares(maxSquareShares)
+		open -= remaining[i]
+	}
+	return &ReservationLanes{
+		reservations: reservations,
+		remaining:    remaining,
+		open:         open,
+		openSigners:  make(map[string]struct{}),
+	}
+}
+
+// match returns the index of the first reservation that matches msg and has
+// enough shares left for its blobs.
+func (l *ReservationLanes) match(msg *MsgPayForBlobs) (int, int, bool) {
+	shares := BlobSharesNeeded(msg)
+	for i, r := range l.reservations {
+		if r.Matches(msg) && l.remaining[i] >= shares {
+			return i, shares, true
+		}
+	}
+	return 0, 0, false
+}
+
+// CanReserve returns true if msg fits in one of the reservations.
+func (l *ReservationLanes) CanReserve(msg *MsgPayForBlobs) bool {
+	_, _, ok := l.match(msg)
+	return ok
+}
+
+// Reserve deducts the shares of msg from the first reservation it fits in. It
+// returns false if msg does not fit in any reservation.
+func (l *ReservationLanes) Reserve(msg *MsgPayForBlobs) bool {
+	i, shares, ok := l.match(msg)
+	if !ok {
+		return false
+	}
+	l.remaining[i] -= shares
+	return true
+}
+
+// CanAdmit returns whether msg would be admitted to the reserved lane by
+// Admit, or an error if Admit would reject it. It does not modify the lanes.
+func (l *ReservationLanes) CanAdmit(msg *MsgPayForBlobs) (reserved bool, err error) {
+	if _, ok := l.openSigners[msg.Signer]; !ok && l.CanReserve(msg) {
+		if l.openSeen {
+			return false, fmt.Errorf("blob tx from %s fits in a reservation but follows blob txs outside of a reservation", msg.Signer)
+		}
+		return true, nil
+	}
+	if shares := BlobSharesNeeded(msg); shares > l.open {
+		return false, fmt.Errorf("blob tx from %s needs %d shares but only %d unreserved shares are left", msg.Signer, shares, l.open)
+	}
+	return false, nil
+}
+
+// Admit processes the next blob tx of a proposed block. Blob txs that fit in a
+// reservation are deducted from it, all other blob txs from the unreserved
+// shares. It returns an error if a blob tx fits in a reservation but follows a
+// blob tx that did not, because the proposer must include all reserved blob
+// txs ahead of the open market, or if the open market exceeds the unreserved
+// shares.
+func (l *ReservationLanes) Admit(msg *MsgPayForBlobs) error {
+	reserved, err := l.CanAdmit(msg)
+	if err != nil {
+		return err
+	}
+	if reserved {
+		l.Reserve(msg)
+		return nil
+	}
+	l.openSeen = true
+	l.openSigners[msg.Signer] = struct{}{}
+	l.open -= BlobSharesNeeded(msg)
+	return nil
+}
+
+// ReservationKey returns the store key of the reservation for namespace or
+// signer.
+func ReservationKey(namespace []byte, signer string) []byte {
+	if len(namespace) != 0 {
+		return append([]byte{reservationNamespaceKeyPrefix}, namespace...)
+	}
+	return append([]byte{reservationSignerKeyPrefix}, []byte(signer)...)
+}
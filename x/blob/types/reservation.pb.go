@@ -0,0 +1,439 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/blob/v1/reservation.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Reservation reserves a fraction of the shares of every square for the blobs
+// of a namespace or of a signer. Exactly one of namespace and signer must be
+// set.
+type Reservation struct {
+	// namespace is the namespace that the reservation applies to. A blob tx
+	// matches the reservation if all of its blobs belong to this namespace.
+	Namespace []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// signer is the bech32 encoded address that the reservation applies to. A
+	// blob tx matches the reservation if its MsgPayForBlobs is signed by this
+	// address.
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	// share_fraction is the fraction of the shares of the max effective square
+	// that is reserved. Reserved shares that are not used in a block are
+	// available to all other blob txs.
+	ShareFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=share_fraction,json=shareFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share_fraction"`
+}
+
+func (m *Reservation) Reset()         { *m = Reservation{} }
+func (m *Reservation) String() string { return proto.CompactTextString(m) }
+func (*Reservation) ProtoMessage()    {}
+func (*Reservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12dc8c7b87640fbc, []int{0}
+}
+func (m *Reservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Reservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Reservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Reservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reservation.Merge(m, src)
+}
+func (m *Reservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Reservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reservation proto.InternalMessageInfo
+
+func (m *Reservation) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *Reservation) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Reservation)(nil), "celestia.blob.v1.Reservation")
+}
+
+func init() {
+	proto.RegisterFile("celestia/blob/v1/reservation.proto", fileDescriptor_12dc8c7b87640fbc)
+}
+
+var fileDescriptor_12dc8c7b87640fbc = []byte{
+	// 297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xcd, 0x6a, 0x32, 0x31,
+	0x14, 0x86, 0x27, 0xdf, 0x07, 0x82, 0xe9, 0x0f, 0x65, 0x70, 0x31, 0xb5, 0x25, 0x8a, 0x2b, 0x37,
+	0x26, 0x4a, 0xaf, 0xa0, 0x22, 0x5d, 0x94, 0xae, 0xa6, 0x9b, 0xd2, 0x8d, 0x64, 0xe2, 0x69, 0x0c,
+	0x75, 0x26, 0x43, 0x92, 0x4a, 0xbd, 0x8b, 0x5e, 0x8c, 0xd0, 0x5b, 0x70, 0x29, 0xae, 0x4a, 0x17,
+	0x52, 0xf4, 0x46, 0xca, 0x4c, 0xc6, 0xba, 0x3b, 0xc9, 0xfb, 0x9c, 0xe7, 0xc0, 0x8b, 0x3b, 0x02,
+	0x66, 0x60, 0x9d, 0xe2, 0x2c, 0x99, 0xe9, 0x84, 0xcd, 0x07, 0xcc, 0x80, 0x05, 0x33, 0xe7, 0x4e,
+	0xe9, 0x8c, 0xe6, 0x46, 0x3b, 0x1d, 0x5e, 0x1c, 0x18, 0x5a, 0x30, 0x74, 0x3e, 0x68, 0x36, 0xa4,
+	0x96, 0xba, 0x0c, 0x59, 0x31, 0x79, 0xae, 0x79, 0x29, 0xb4, 0x4d, 0xb5, 0x1d, 0xfb, 0xc0, 0x3f,
+	0x7c, 0xd4, 0xf9, 0x44, 0xf8, 0x24, 0x3e, 0x8a, 0xc3, 0x6b, 0x5c, 0xcf, 0x78, 0x0a, 0x36, 0xe7,
+	0x02, 0x22, 0xd4, 0x46, 0xdd, 0xd3, 0xf8, 0xf8, 0x11, 0xf6, 0x71, 0xcd, 0x2a, 0x99, 0x81, 0x89,
+	0xfe, 0xb5, 0x51, 0xb7, 0x3e, 0x8c, 0x36, 0xcb, 0x5e, 0xa3, 0xf2, 0xdd, 0x4e, 0x26, 0x06, 0xac,
+	0x7d, 0x74, 0x46, 0x65, 0x32, 0xae, 0xb8, 0xf0, 0x09, 0x9f, 0xdb, 0x29, 0x37, 0x30, 0x7e, 0x31,
+	0x5c, 0x14, 0x17, 0xa2, 0xff, 0xe5, 0xe6, 0x60, 0xb5, 0x6d, 0x05, 0xdf, 0xdb, 0xd6, 0x95, 0xdf,
+	0xb6, 0x93, 0x57, 0xaa, 0x34, 0x4b, 0xb9, 0x9b, 0xd2, 0x07, 0x90, 0x5c, 0x2c, 0x46, 0x20, 0x36,
+	0xcb, 0x1e, 0xae, 0xe4, 0x23, 0x10, 0xf1, 0x59, 0x29, 0xba, 0xab, 0x3c, 0xc3, 0xfb, 0xd5, 0x8e,
+	0xa0, 0xf5, 0x8e, 0xa0, 0x9f, 0x1d, 0x41, 0x1f, 0x7b, 0x12, 0xac, 0xf7, 0x24, 0xf8, 0xda, 0x93,
+	0xe0, 0xb9, 0x2f, 0x95, 0x9b, 0xbe, 0x25, 0x54, 0xe8, 0x94, 0x1d, 0x1a, 0xd2, 0x46, 0xfe, 0xcd,
+	0x3d, 0x9e, 0xe7, 0xec, 0xdd, 0xf7, 0xea, 0x16, 0x39, 0xd8, 0xa4, 0x56, 0x96, 0x71, 0xf3, 0x3b,
+	0x00, 0x47, 0x5e, 0x6a, 0xcc, 0x75, 0x01, 0x00, 0x00,
+}
+
+func (m *Reservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareFraction.Size()
+		i -= size
+		if _, err := m.ShareFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReservation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintReservation(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintReservation(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReservation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReservation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Reservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovReservation(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovReservation(uint64(l))
+	}
+	l = m.ShareFraction.Size()
+	n += 1 + l + sovReservation(uint64(l))
+	return n
+}
+
+func sovReservation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReservation(x uint64) (n int) {
+	return sovReservation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Reservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReservation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthReservation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReservation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReservation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReservation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReservation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReservation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReservation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReservation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReservation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReservation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReservation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReservation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReservation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReservation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReservation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,228 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v10/x/blob/types"
+	"github.com/celestiaorg/go-square/v4/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	reservationSigner      = "celestia15drmhzw5kwgenvemy30rqqqgq52axf5wwrruf7"
+	otherReservationSigner = "celestia1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3y3clr6"
+)
+
+func reservationNamespace(b byte) []byte {
+	return share.MustNewV0Namespace(bytes.Repeat([]byte{b}, share.NamespaceVersionZeroIDSize)).Bytes()
+}
+
+func TestReservationValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		reservation types.Reservation
+		wantErr     bool
+	}{
+		{
+			name:        "valid namespace reservation",
+			reservation: types.Reservation{Namespace: reservationNamespace(1), ShareFraction: math.LegacyNewDecWithPrec(1, 1)},
+		},
+		{
+			name:        "valid signer reservation",
+			reservation: types.Reservation{Signer: reservationSigner, ShareFraction: math.LegacyNewDecWithPrec(1, 1)},
+		},
+		{
+			name:        "neither namespace nor signer",
+			reservation: types.Reservation{ShareFraction: math.LegacyNewDecWithPrec(1, 1)},
+			wantErr:     true,
+		},
+		{
+			name:        "both namespace and signer",
+			reservation: types.Reservation{Namespace: reservationNamespace(1), Signer: reservationSigner, ShareFraction: math.LegacyNewDecWithPrec(1, 1)},
+			wantErr:     true,
+		},
+		{
+			name:        "reserved namespace",
+			reservation: types.Reservation{Namespace: share.TxNamespace.Bytes(), ShareFraction: math.LegacyNewDecWithPrec(1, 1)},
+			wantErr:     true,
+		},
+		{
+			name:        "invalid signer",
+			reservation: types.Reservation{Signer: "celestia1invalid", ShareFraction: math.LegacyNewDecWithPrec(1, 1)},
+			wantErr:     true,
+		},
+		{
+			name:        "zero share fraction",
+			reservation: types.Reservation{Signer: reservationSigner, ShareFraction: math.LegacyZeroDec()},
+			wantErr:     true,
+		},
+		{
+			name:        "share fraction above the maximum",
+			reservation: types.Reservation{Signer: reservationSigner, ShareFraction: math.LegacyNewDecWithPrec(6, 1)},
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.reservation.Validate()
+			if tc.wantErr {
+				require.ErrorIs(t, err, types.ErrInvalidReservation)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateReservations(t *testing.T) {
+	tenth := math.LegacyNewDecWithPrec(1, 1)
+	tests := []struct {
+		name         string
+		reservations []types.Reservation
+		wantErr      bool
+	}{
+		{
+			name: "no reservations",
+		},
+		{
+			name: "distinct reservations within the maximum",
+			reservations: []types.Reservation{
+				{Namespace: reservationNamespace(1), ShareFraction: tenth},
+				{Namespace: reservationNamespace(2), ShareFraction: tenth},
+				{Signer: reservationSigner, ShareFraction: tenth},
+			},
+		},
+		{
+			name: "duplicate namespace",
+			reservations: []types.Reservation{
+				{Namespace: reservationNamespace(1), ShareFraction: tenth},
+				{Namespace: reservationNamespace(1), ShareFraction: tenth},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate signer",
+			reservations: []types.Reservation{
+				{Signer: reservationSigner, ShareFraction: tenth},
+				{Signer: reservationSigner, ShareFraction: tenth},
+			},
+			wantErr: true,
+		},
+		{
+			name: "total exceeds the maximum",
+			reservations: []types.Reservation{
+				{Namespace: reservationNamespace(1), ShareFraction: math.LegacyNewDecWithPrec(3, 1)},
+				{Signer: reservationSigner, ShareFraction: math.LegacyNewDecWithPrec(3, 1)},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateReservations(tc.reservations)
+			if tc.wantErr {
+				require.ErrorIs(t, err, types.ErrInvalidReservation)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestReservationMatches(t *testing.T) {
+	msg := &types.MsgPayForBlobs{
+		Signer:     reservationSigner,
+		Namespaces: [][]byte{reservationNamespace(1), reservationNamespace(1)},
+	}
+	mixed := &types.MsgPayForBlobs{
+		Signer:     otherReservationSigner,
+		Namespaces: [][]byte{reservationNamespace(1), reservationNamespace(2)},
+	}
+
+	byNamespace := types.Reservation{Namespace: reservationNamespace(1)}
+	assert.True(t, byNamespace.Matches(msg))
+	assert.False(t, byNamespace.Matches(mixed), "every blob must be in the reserved namespace")
+
+	bySigner := types.Reservation{Signer: reservationSigner}
+	assert.True(t, bySigner.Matches(msg))
+	assert.False(t, bySigner.Matches(mixed))
+}
+
+func TestReservationLanes(t *testing.T) {
+	// 10% of a 100 share square leaves 10 shares for the signer.
+	reservations := []types.Reservation{{Signer: reservationSigner, ShareFraction: math.LegacyNewDecWithPrec(1, 1)}}
+	blobSize := uint32(share.AvailableBytesFromSparseShares(4))
+	reserved := &types.MsgPayForBlobs{
+		Signer:        reservationSigner,
+		Namespaces:    [][]byte{reservationNamespace(1)},
+		BlobSizes:     []uint32{blobSize},
+		ShareVersions: []uint32{uint32(share.ShareVersionZero)},
+	}
+	open := &types.MsgPayForBlobs{
+		Signer:        otherReservationSigner,
+		Namespaces:    [][]byte{reservationNamespace(1)},
+		BlobSizes:     []uint32{blobSize},
+		ShareVersions: []uint32{uint32(share.ShareVersionZero)},
+	}
+	require.Equal(t, 4, types.BlobSharesNeeded(reserved))
+
+	t.Run("reserve deducts until the reservation is exhausted", func(t *testing.T) {
+		lanes := types.NewReservationLanes(reservations, 100)
+		assert.False(t, lanes.CanReserve(open))
+		assert.True(t, lanes.Reserve(reserved))
+		assert.True(t, lanes.Reserve(reserved))
+		// Only 2 of the 10 reserved shares are left.
+		assert.False(t, lanes.CanReserve(reserved))
+		assert.False(t, lanes.Reserve(reserved))
+	})
+
+	t.Run("admit accepts reserved blob txs ahead of open blob txs", func(t *testing.T) {
+		lanes := types.NewReservationLanes(reservations, 100)
+		require.NoError(t, lanes.Admit(reserved))
+		require.NoError(t, lanes.Admit(reserved))
+		require.NoError(t, lanes.Admit(open))
+		// The reservation is exhausted so this blob tx is part of the open market.
+		require.NoError(t, lanes.Admit(reserved))
+	})
+
+	t.Run("admit rejects reserved blob txs after open blob txs", func(t *testing.T) {
+		lanes := types.NewReservationLanes(reservations, 100)
+		require.NoError(t, lanes.Admit(open))
+		require.Error(t, lanes.Admit(reserved))
+	})
+
+	t.Run("admit keeps the blob txs of a signer in the open market once one is", func(t *testing.T) {
+		lanes := types.NewReservationLanes(reservations, 100)
+		tooLarge := &types.MsgPayForBlobs{
+			Signer:        reservationSigner,
+			Namespaces:    [][]byte{reservationNamespace(1)},
+			BlobSizes:     []uint32{uint32(share.AvailableBytesFromSparseShares(12))},
+			ShareVersions: []uint32{uint32(share.ShareVersionZero)},
+		}
+		require.NoError(t, lanes.Admit(tooLarge))
+		// The blob tx fits in the reservation but must follow the earlier
+		// blob tx of its signer in the open market.
+		isReserved, err := lanes.CanAdmit(reserved)
+		require.NoError(t, err)
+		assert.False(t, isReserved)
+		require.NoError(t, lanes.Admit(reserved))
+	})
+
+	t.Run("admit rejects open blob txs beyond the unreserved shares", func(t *testing.T) {
+		// 90 shares are not reserved.
+		lanes := types.NewReservationLanes(reservations, 100)
+		large := &types.MsgPayForBlobs{
+			Signer:        otherReservationSigner,
+			Namespaces:    [][]byte{reservationNamespace(1)},
+			BlobSizes:     []uint32{uint32(share.AvailableBytesFromSparseShares(88))},
+			ShareVersions: []uint32{uint32(share.ShareVersionZero)},
+		}
+		require.NoError(t, lanes.Admit(large))
+		require.Error(t, lanes.Admit(open))
+	})
+}
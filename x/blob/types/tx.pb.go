@@ -251,50 +251,257 @@ func (m *MsgUpdateBlobParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateBlobParamsResponse proto.InternalMessageInfo
 
+// MsgSetReservation creates or replaces the square space reservation of a
+// namespace or signer.
+type MsgSetReservation struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// reservation is the reservation to set. It replaces any existing
+	// reservation for the same namespace or signer.
+	Reservation Reservation `protobuf:"bytes,2,opt,name=reservation,proto3" json:"reservation"`
+}
+
+func (m *MsgSetReservation) Reset()         { *m = MsgSetReservation{} }
+func (m *MsgSetReservation) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReservation) ProtoMessage()    {}
+func (*MsgSetReservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{4}
+}
+func (m *MsgSetReservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetReservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReservation.Merge(m, src)
+}
+func (m *MsgSetReservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetReservation proto.InternalMessageInfo
+
+func (m *MsgSetReservation) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetReservation) GetReservation() Reservation {
+	if m != nil {
+		return m.Reservation
+	}
+	return Reservation{}
+}
+
+// MsgSetReservationResponse defines the MsgSetReservation response type.
+type MsgSetReservationResponse struct {
+}
+
+func (m *MsgSetReservationResponse) Reset()         { *m = MsgSetReservationResponse{} }
+func (m *MsgSetReservationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReservationResponse) ProtoMessage()    {}
+func (*MsgSetReservationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{5}
+}
+func (m *MsgSetReservationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReservationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReservationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetReservationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReservationResponse.Merge(m, src)
+}
+func (m *MsgSetReservationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReservationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReservationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetReservationResponse proto.InternalMessageInfo
+
+// MsgRemoveReservation removes the square space reservation of a namespace or
+// signer. Exactly one of namespace and signer must be set.
+type MsgRemoveReservation struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// namespace is the namespace of the reservation to remove.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// signer is the signer of the reservation to remove.
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgRemoveReservation) Reset()         { *m = MsgRemoveReservation{} }
+func (m *MsgRemoveReservation) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveReservation) ProtoMessage()    {}
+func (*MsgRemoveReservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{6}
+}
+func (m *MsgRemoveReservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveReservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveReservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveReservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveReservation.Merge(m, src)
+}
+func (m *MsgRemoveReservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveReservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveReservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveReservation proto.InternalMessageInfo
+
+func (m *MsgRemoveReservation) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveReservation) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *MsgRemoveReservation) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// MsgRemoveReservationResponse defines the MsgRemoveReservation response type.
+type MsgRemoveReservationResponse struct {
+}
+
+func (m *MsgRemoveReservationResponse) Reset()         { *m = MsgRemoveReservationResponse{} }
+func (m *MsgRemoveReservationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveReservationResponse) ProtoMessage()    {}
+func (*MsgRemoveReservationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{7}
+}
+func (m *MsgRemoveReservationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveReservationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveReservationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveReservationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveReservationResponse.Merge(m, src)
+}
+func (m *MsgRemoveReservationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveReservationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveReservationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveReservationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgPayForBlobs)(nil), "celestia.blob.v1.MsgPayForBlobs")
 	proto.RegisterType((*MsgPayForBlobsResponse)(nil), "celestia.blob.v1.MsgPayForBlobsResponse")
 	proto.RegisterType((*MsgUpdateBlobParams)(nil), "celestia.blob.v1.MsgUpdateBlobParams")
 	proto.RegisterType((*MsgUpdateBlobParamsResponse)(nil), "celestia.blob.v1.MsgUpdateBlobParamsResponse")
+	proto.RegisterType((*MsgSetReservation)(nil), "celestia.blob.v1.MsgSetReservation")
+	proto.RegisterType((*MsgSetReservationResponse)(nil), "celestia.blob.v1.MsgSetReservationResponse")
+	proto.RegisterType((*MsgRemoveReservation)(nil), "celestia.blob.v1.MsgRemoveReservation")
+	proto.RegisterType((*MsgRemoveReservationResponse)(nil), "celestia.blob.v1.MsgRemoveReservationResponse")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/tx.proto", fileDescriptor_9157fbf3d3cd004d) }
 
 var fileDescriptor_9157fbf3d3cd004d = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x6a, 0xdb, 0x40,
-	0x10, 0xf5, 0xc6, 0xa9, 0xa9, 0xd7, 0x8d, 0x71, 0x55, 0xd3, 0xca, 0x6e, 0xac, 0x18, 0x41, 0x40,
-	0xb8, 0x58, 0x4a, 0x52, 0xe8, 0xc1, 0xb7, 0xba, 0xd0, 0x43, 0xc1, 0x10, 0x14, 0xda, 0x43, 0x2f,
-	0x66, 0x25, 0x6f, 0xd7, 0x02, 0x4b, 0x2b, 0x76, 0x36, 0x26, 0x4e, 0x2f, 0x25, 0x5f, 0x50, 0xe8,
-	0x8f, 0xe4, 0xd0, 0x8f, 0xc8, 0x31, 0xb4, 0x97, 0x9e, 0x4a, 0xb1, 0x0b, 0xb9, 0xf7, 0x0b, 0x8a,
-	0xb4, 0x52, 0xec, 0xc4, 0x81, 0xf6, 0x36, 0x7a, 0xef, 0xcd, 0x9b, 0x79, 0x9a, 0xc5, 0x0d, 0x9f,
-	0x4e, 0x28, 0xc8, 0x80, 0x38, 0xde, 0x84, 0x7b, 0xce, 0x74, 0xdf, 0x91, 0x27, 0x76, 0x2c, 0xb8,
-	0xe4, 0x5a, 0x2d, 0xa7, 0xec, 0x84, 0xb2, 0xa7, 0xfb, 0xcd, 0xd6, 0x9a, 0x38, 0x26, 0x82, 0x84,
-	0xa0, 0x1a, 0x9a, 0x75, 0xc6, 0x19, 0x4f, 0x4b, 0x27, 0xa9, 0x32, 0x74, 0x9b, 0x71, 0xce, 0x26,
-	0xd4, 0x21, 0x71, 0xe0, 0x90, 0x28, 0xe2, 0x92, 0xc8, 0x80, 0x47, 0x79, 0xcf, 0x13, 0x9f, 0x43,
-	0xc8, 0xc1, 0x09, 0x81, 0x25, 0x7e, 0x21, 0xb0, 0x8c, 0x68, 0x28, 0x62, 0xa8, 0xfc, 0xd4, 0x87,
-	0xa2, 0xcc, 0x39, 0xc2, 0xd5, 0x01, 0xb0, 0x43, 0x32, 0x7b, 0xcd, 0x45, 0x7f, 0xc2, 0x3d, 0xd0,
-	0xf6, 0x70, 0x09, 0x02, 0x16, 0x51, 0xa1, 0xa3, 0x36, 0xb2, 0xca, 0x7d, 0xfd, 0xdb, 0xd7, 0x6e,
-	0x3d, 0x6b, 0x7a, 0x39, 0x1a, 0x09, 0x0a, 0x70, 0x24, 0x45, 0x10, 0x31, 0x37, 0xd3, 0x69, 0x06,
-	0xc6, 0x11, 0x09, 0x29, 0xc4, 0xc4, 0xa7, 0xa0, 0x6f, 0xb4, 0x8b, 0xd6, 0x03, 0x77, 0x05, 0xd1,
-	0x5a, 0x18, 0x27, 0x21, 0x87, 0x10, 0x9c, 0x52, 0xd0, 0x8b, 0xed, 0xa2, 0xb5, 0xe5, 0x96, 0x13,
-	0xe4, 0x28, 0x01, 0xb4, 0x67, 0xf8, 0x21, 0x8c, 0x89, 0xa0, 0x43, 0x9f, 0x87, 0x61, 0x20, 0x43,
-	0x1a, 0x49, 0xd0, 0x37, 0x53, 0x97, 0x5a, 0x4a, 0xbc, 0x5a, 0xe2, 0xda, 0x2e, 0xae, 0x2a, 0xf1,
-	0x94, 0x0a, 0x48, 0xc2, 0xeb, 0xf7, 0x53, 0xbf, 0xad, 0x14, 0x7d, 0x97, 0x81, 0xbd, 0xca, 0xd9,
-	0xd5, 0x79, 0x27, 0xdb, 0xcf, 0xd4, 0xf1, 0xe3, 0x9b, 0x19, 0x5d, 0x0a, 0x31, 0x8f, 0x80, 0x9a,
-	0x1f, 0xf1, 0xa3, 0x01, 0xb0, 0xb7, 0xf1, 0x88, 0x48, 0x9a, 0x30, 0x87, 0xe9, 0x0d, 0xb4, 0x6d,
-	0x5c, 0x26, 0xc7, 0x72, 0xcc, 0x45, 0x20, 0x67, 0xea, 0x2f, 0xb8, 0x4b, 0x40, 0x7b, 0x81, 0x4b,
-	0xea, 0x56, 0xfa, 0x46, 0x1b, 0x59, 0x95, 0x03, 0xdd, 0xbe, 0x7d, 0x5d, 0x5b, 0xf9, 0xf4, 0x37,
-	0x2f, 0x7e, 0xee, 0x14, 0xdc, 0x4c, 0xdd, 0xab, 0x26, 0x3b, 0x2d, 0x7d, 0xcc, 0x16, 0x7e, 0x7a,
-	0xc7, 0xf0, 0x7c, 0xb7, 0x83, 0x3f, 0x08, 0x17, 0x07, 0xc0, 0xb4, 0x53, 0x5c, 0x59, 0x3d, 0x4f,
-	0x7b, 0x7d, 0xda, 0xcd, 0x70, 0x4d, 0xeb, 0x5f, 0x8a, 0xeb, 0xf8, 0x3b, 0x67, 0xdf, 0x7f, 0x7f,
-	0xd9, 0x68, 0xf4, 0x50, 0xc7, 0xac, 0xaf, 0xbc, 0xc3, 0xd9, 0x07, 0x2e, 0xbc, 0x74, 0xd8, 0x18,
-	0xd7, 0xd6, 0x7e, 0xce, 0xee, 0x9d, 0xf6, 0xb7, 0x65, 0xcd, 0xee, 0x7f, 0xc9, 0xf2, 0x55, 0x9a,
-	0xf7, 0x3e, 0x5d, 0x9d, 0x77, 0x50, 0xff, 0xcd, 0xc5, 0xdc, 0x40, 0x97, 0x73, 0x03, 0xfd, 0x9a,
-	0x1b, 0xe8, 0xf3, 0xc2, 0x28, 0x5c, 0x2e, 0x8c, 0xc2, 0x8f, 0x85, 0x51, 0x78, 0xbf, 0xc7, 0x02,
-	0x39, 0x3e, 0xf6, 0x6c, 0x9f, 0x87, 0x4e, 0xee, 0xcc, 0x05, 0xbb, 0xae, 0xbb, 0x24, 0x8e, 0x9d,
-	0x13, 0x95, 0x42, 0xce, 0x62, 0x0a, 0x5e, 0x29, 0x7d, 0xe2, 0xcf, 0xff, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0xb3, 0xf0, 0x8e, 0x82, 0x98, 0x03, 0x00, 0x00,
+	// 640 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x4f, 0x13, 0x41,
+	0x1c, 0xed, 0x52, 0x24, 0xf6, 0x57, 0x68, 0x60, 0x6d, 0x74, 0xbb, 0xb4, 0x4b, 0x53, 0x83, 0x69,
+	0x20, 0xec, 0x02, 0x26, 0x1e, 0xb8, 0x59, 0xa3, 0x07, 0x93, 0x26, 0x64, 0x89, 0x1e, 0xbc, 0x90,
+	0x69, 0x19, 0xa7, 0x1b, 0xbb, 0x3b, 0x9b, 0xf9, 0x0d, 0x0d, 0xc5, 0x8b, 0xe1, 0xe4, 0xd1, 0x44,
+	0x3f, 0x08, 0x07, 0xbf, 0x81, 0x17, 0x8e, 0x44, 0x2f, 0x9e, 0x8c, 0x29, 0x26, 0x7c, 0x0d, 0xb3,
+	0x7f, 0xba, 0x5d, 0xd8, 0x26, 0xf4, 0x36, 0xfb, 0xde, 0xfb, 0xbd, 0x79, 0x33, 0xf3, 0xb2, 0x50,
+	0xe9, 0xd2, 0x3e, 0x45, 0xe9, 0x10, 0xab, 0xd3, 0xe7, 0x1d, 0x6b, 0xb0, 0x63, 0xc9, 0x13, 0xd3,
+	0x17, 0x5c, 0x72, 0x75, 0x79, 0x4c, 0x99, 0x01, 0x65, 0x0e, 0x76, 0xf4, 0x5a, 0x46, 0xec, 0x13,
+	0x41, 0x5c, 0x8c, 0x06, 0xf4, 0x46, 0x86, 0x16, 0x14, 0xa9, 0x18, 0x10, 0xe9, 0x70, 0x2f, 0xd6,
+	0x94, 0x19, 0x67, 0x3c, 0x5c, 0x5a, 0xc1, 0x2a, 0x46, 0xab, 0x8c, 0x73, 0xd6, 0xa7, 0x16, 0xf1,
+	0x1d, 0x8b, 0x78, 0x1e, 0x97, 0xe1, 0xc8, 0xd8, 0xf7, 0x51, 0x97, 0xa3, 0xcb, 0xd1, 0x72, 0x91,
+	0x05, 0xa6, 0x2e, 0xb2, 0x98, 0xa8, 0x44, 0xc4, 0x61, 0xe4, 0x17, 0x7d, 0x44, 0x54, 0x63, 0xa4,
+	0x40, 0xa9, 0x8d, 0x6c, 0x9f, 0x0c, 0x5f, 0x71, 0xd1, 0xea, 0xf3, 0x0e, 0xaa, 0xdb, 0xb0, 0x80,
+	0x0e, 0xf3, 0xa8, 0xd0, 0x94, 0xba, 0xd2, 0x2c, 0xb4, 0xb4, 0x9f, 0xdf, 0xb7, 0xca, 0xf1, 0xd0,
+	0xf3, 0xa3, 0x23, 0x41, 0x11, 0x0f, 0xa4, 0x70, 0x3c, 0x66, 0xc7, 0x3a, 0xd5, 0x00, 0xf0, 0x88,
+	0x4b, 0xd1, 0x27, 0x5d, 0x8a, 0xda, 0x5c, 0x3d, 0xdf, 0x5c, 0xb4, 0x53, 0x88, 0x5a, 0x03, 0x08,
+	0x4e, 0x7a, 0x88, 0xce, 0x29, 0x45, 0x2d, 0x5f, 0xcf, 0x37, 0x97, 0xec, 0x42, 0x80, 0x1c, 0x04,
+	0x80, 0xba, 0x09, 0x2b, 0xd8, 0x23, 0x82, 0x1e, 0x76, 0xb9, 0xeb, 0x3a, 0xd2, 0xa5, 0x9e, 0x44,
+	0x6d, 0x3e, 0x74, 0x59, 0x0e, 0x89, 0x17, 0x13, 0x5c, 0x5d, 0x87, 0x52, 0x24, 0x1e, 0x50, 0x81,
+	0xc1, 0xe1, 0xb5, 0xfb, 0xa1, 0xdf, 0x52, 0x88, 0xbe, 0x8d, 0xc1, 0xbd, 0xe2, 0xd9, 0xf5, 0xf9,
+	0x46, 0x9c, 0xaf, 0xa1, 0xc1, 0xc3, 0x9b, 0x67, 0xb4, 0x29, 0xfa, 0xdc, 0x43, 0xda, 0xf8, 0x08,
+	0x0f, 0xda, 0xc8, 0xde, 0xf8, 0x47, 0x44, 0xd2, 0x80, 0xd9, 0x0f, 0xdf, 0x49, 0xad, 0x42, 0x81,
+	0x1c, 0xcb, 0x1e, 0x17, 0x8e, 0x1c, 0x46, 0xb7, 0x60, 0x4f, 0x00, 0xf5, 0x19, 0x2c, 0x44, 0xef,
+	0xa9, 0xcd, 0xd5, 0x95, 0x66, 0x71, 0x57, 0x33, 0x6f, 0x37, 0xc0, 0x8c, 0x7c, 0x5a, 0xf3, 0x17,
+	0x7f, 0xd6, 0x72, 0x76, 0xac, 0xde, 0x2b, 0x05, 0x99, 0x26, 0x3e, 0x8d, 0x1a, 0xac, 0x4e, 0xd9,
+	0x3c, 0xc9, 0xf6, 0x59, 0x81, 0x95, 0x36, 0xb2, 0x03, 0x2a, 0xed, 0x49, 0x3d, 0xee, 0x88, 0xf6,
+	0x12, 0x8a, 0xa9, 0x2e, 0xc5, 0xf9, 0x6a, 0xd9, 0x7c, 0x29, 0xc7, 0x38, 0x64, 0x7a, 0x2e, 0x93,
+	0x74, 0x15, 0x2a, 0x99, 0x24, 0x49, 0xce, 0x6f, 0x0a, 0x94, 0xdb, 0xc8, 0x6c, 0xea, 0xf2, 0x01,
+	0x9d, 0x3d, 0x6a, 0x15, 0x0a, 0x49, 0x45, 0xc2, 0xa0, 0x8b, 0xf6, 0x04, 0x48, 0x95, 0x30, 0x3f,
+	0x5b, 0x09, 0x33, 0x99, 0x0d, 0xa8, 0x4e, 0x4b, 0x35, 0x8e, 0xbd, 0xfb, 0x23, 0x0f, 0xf9, 0x36,
+	0x32, 0xf5, 0x14, 0x8a, 0xe9, 0xf6, 0xd7, 0xb3, 0x97, 0x75, 0xb3, 0x3b, 0x7a, 0xf3, 0x2e, 0x45,
+	0x72, 0x33, 0x6b, 0x67, 0xbf, 0xfe, 0x7d, 0x9d, 0xab, 0x34, 0xca, 0xa9, 0xff, 0xc0, 0xf0, 0x3d,
+	0x17, 0xc1, 0x17, 0xee, 0x29, 0x1b, 0x6a, 0x0f, 0x96, 0x33, 0xdd, 0x5b, 0x9f, 0x6a, 0x7f, 0x5b,
+	0xa6, 0x6f, 0xcd, 0x24, 0x1b, 0x47, 0x51, 0x3b, 0x50, 0xba, 0x55, 0xa4, 0xc7, 0x53, 0x0d, 0x6e,
+	0x8a, 0xf4, 0xcd, 0x19, 0x44, 0xc9, 0x1e, 0x1f, 0x60, 0x25, 0x5b, 0x82, 0x27, 0x53, 0x1d, 0x32,
+	0x3a, 0xdd, 0x9c, 0x4d, 0x37, 0xde, 0x4c, 0xbf, 0xf7, 0xe9, 0xfa, 0x7c, 0x43, 0x69, 0xbd, 0xbe,
+	0x18, 0x19, 0xca, 0xe5, 0xc8, 0x50, 0xfe, 0x8e, 0x0c, 0xe5, 0xcb, 0x95, 0x91, 0xbb, 0xbc, 0x32,
+	0x72, 0xbf, 0xaf, 0x8c, 0xdc, 0xbb, 0x6d, 0xe6, 0xc8, 0xde, 0x71, 0xc7, 0xec, 0x72, 0xd7, 0x1a,
+	0x5b, 0x73, 0xc1, 0x92, 0xf5, 0x16, 0xf1, 0x7d, 0xeb, 0x24, 0x7a, 0x19, 0x39, 0xf4, 0x29, 0x76,
+	0x16, 0xc2, 0x5f, 0xe2, 0xd3, 0xff, 0x03, 0x00, 0x5b, 0xa4, 0x66, 0x78, 0xec, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -313,6 +520,10 @@ type MsgClient interface {
 	PayForBlobs(ctx context.Context, in *MsgPayForBlobs, opts ...grpc.CallOption) (*MsgPayForBlobsResponse, error)
 	// UpdateBlobParams defines a rpc handler method for MsgUpdateBlobParams.
 	UpdateBlobParams(ctx context.Context, in *MsgUpdateBlobParams, opts ...grpc.CallOption) (*MsgUpdateBlobParamsResponse, error)
+	// SetReservation defines a rpc handler method for MsgSetReservation.
+	SetReservation(ctx context.Context, in *MsgSetReservation, opts ...grpc.CallOption) (*MsgSetReservationResponse, error)
+	// RemoveReservation defines a rpc handler method for MsgRemoveReservation.
+	RemoveReservation(ctx context.Context, in *MsgRemoveReservation, opts ...grpc.CallOption) (*MsgRemoveReservationResponse, error)
 }
 
 type msgClient struct {
@@ -341,12 +552,34 @@ func (c *msgClient) UpdateBlobParams(ctx context.Context, in *MsgUpdateBlobParam
 	return out, nil
 }
 
+func (c *msgClient) SetReservation(ctx context.Context, in *MsgSetReservation, opts ...grpc.CallOption) (*MsgSetReservationResponse, error) {
+	out := new(MsgSetReservationResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/SetReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveReservation(ctx context.Context, in *MsgRemoveReservation, opts ...grpc.CallOption) (*MsgRemoveReservationResponse, error) {
+	out := new(MsgRemoveReservationResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/RemoveReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// PayForBlobs allows the user to pay for the inclusion of one or more blobs
 	PayForBlobs(context.Context, *MsgPayForBlobs) (*MsgPayForBlobsResponse, error)
 	// UpdateBlobParams defines a rpc handler method for MsgUpdateBlobParams.
 	UpdateBlobParams(context.Context, *MsgUpdateBlobParams) (*MsgUpdateBlobParamsResponse, error)
+	// SetReservation defines a rpc handler method for MsgSetReservation.
+	SetReservation(context.Context, *MsgSetReservation) (*MsgSetReservationResponse, error)
+	// RemoveReservation defines a rpc handler method for MsgRemoveReservation.
+	RemoveReservation(context.Context, *MsgRemoveReservation) (*MsgRemoveReservationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -359,6 +592,12 @@ func (*UnimplementedMsgServer) PayForBlobs(ctx context.Context, req *MsgPayForBl
 func (*UnimplementedMsgServer) UpdateBlobParams(ctx context.Context, req *MsgUpdateBlobParams) (*MsgUpdateBlobParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlobParams not implemented")
 }
+func (*UnimplementedMsgServer) SetReservation(ctx context.Context, req *MsgSetReservation) (*MsgSetReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReservation not implemented")
+}
+func (*UnimplementedMsgServer) RemoveReservation(ctx context.Context, req *MsgRemoveReservation) (*MsgRemoveReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReservation not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -400,6 +639,42 @@ func _Msg_UpdateBlobParams_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetReservation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/SetReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetReservation(ctx, req.(*MsgSetReservation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveReservation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/RemoveReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveReservation(ctx, req.(*MsgRemoveReservation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.blob.v1.Msg",
@@ -413,6 +688,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateBlobParams",
 			Handler:    _Msg_UpdateBlobParams_Handler,
 		},
+		{
+			MethodName: "SetReservation",
+			Handler:    _Msg_SetReservation_Handler,
+		},
+		{
+			MethodName: "RemoveReservation",
+			Handler:    _Msg_RemoveReservation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/blob/v1/tx.proto",
@@ -588,71 +871,201 @@ func (m *MsgUpdateBlobParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetReservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgPayForBlobs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetReservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Namespaces) > 0 {
-		for _, b := range m.Namespaces {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.BlobSizes) > 0 {
-		l = 0
-		for _, e := range m.BlobSizes {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	if len(m.ShareCommitments) > 0 {
-		for _, b := range m.ShareCommitments {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Reservation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.ShareVersions) > 0 {
-		l = 0
-		for _, e := range m.ShareVersions {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgPayForBlobsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetReservationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReservationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReservationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateBlobParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveReservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveReservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveReservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveReservationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveReservationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveReservationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgPayForBlobs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Namespaces) > 0 {
+		for _, b := range m.Namespaces {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.BlobSizes) > 0 {
+		l = 0
+		for _, e := range m.BlobSizes {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.ShareCommitments) > 0 {
+		for _, b := range m.ShareCommitments {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ShareVersions) > 0 {
+		l = 0
+		for _, e := range m.ShareVersions {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgPayForBlobsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateBlobParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.Authority)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -671,6 +1084,60 @@ func (m *MsgUpdateBlobParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetReservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Reservation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetReservationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveReservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveReservationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1190,6 +1657,369 @@ func (m *MsgUpdateBlobParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetReservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetReservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetReservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reservation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reservation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetReservationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetReservationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetReservationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveReservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveReservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveReservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveReservationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveReservationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveReservationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v10/x/minfee/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetNetworkMinGasPrice returns the network minimum gas price that
+// transactions must pay. If the dynamic network minimum gas price is enabled
+// this is the value last computed by the end blocker, otherwise it is the
+// NetworkMinGasPrice param.
+func (k Keeper) GetNetworkMinGasPrice(ctx sdk.Context) math.LegacyDec {
+	params := k.GetParams(ctx)
+	if !params.DynamicEnabled {
+		return params.NetworkMinGasPrice
+	}
+
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.NetworkMinGasPriceKey))
+	if len(bz) == 0 {
+		return params.NetworkMinGasPrice
+	}
+
+	var price math.LegacyDec
+	if err := price.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return price
+}
+
+// setNetworkMinGasPrice sets the dynamic network minimum gas price.
+func (k Keeper) setNetworkMinGasPrice(ctx sdk.Context, price math.LegacyDec) {
+	bz, err := price.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set([]byte(types.NetworkMinGasPriceKey), bz)
+}
+
+// resetNetworkMinGasPrice brings the dynamic network minimum gas price in line
+// with updated params. If the dynamic network minimum gas price was disabled,
+// the stored value is removed so that the next time it is enabled it starts
+// from the NetworkMinGasPrice param. Otherwise the current value is clamped to
+// the new bounds.
+func (k Keeper) resetNetworkMinGasPrice(ctx sdk.Context, params types.Params) {
+	if !params.DynamicEnabled {
+		ctx.KVStore(k.storeKey).Delete([]byte(types.NetworkMinGasPriceKey))
+		return
+	}
+
+	price := k.GetNetworkMinGasPrice(ctx)
+	price = math.LegacyMaxDec(price, params.MinNetworkMinGasPrice)
+	price = math.LegacyMinDec(price, params.MaxNetworkMinGasPrice)
+	k.setNetworkMinGasPrice(ctx, price)
+}
+
+// SetBlockFill records the fraction of the maximum block size that the
+// current block filled. It is cleared at the end of the block.
+func (k Keeper) SetBlockFill(ctx sdk.Context, blockFill math.LegacyDec) {
+	bz, err := blockFill.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.TransientStore(k.transientStoreKey).Set([]byte(types.BlockFillKey), bz)
+}
+
+// getBlockFill returns the fraction of the maximum block size that the current
+// block filled. It returns zero if it was not recorded.
+func (k Keeper) getBlockFill(ctx sdk.Context) math.LegacyDec {
+	bz := ctx.TransientStore(k.transientStoreKey).Get([]byte(types.BlockFillKey))
+	if len(bz) == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	var blockFill math.LegacyDec
+	if err := blockFill.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return blockFill
+}
+
+// EndBlocker adjusts the dynamic network minimum gas price for the next block
+// based on how full the current block was. It does nothing if the dynamic
+// network minimum gas price is disabled.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params := k.GetParams(sdkCtx)
+	if !params.DynamicEnabled {
+		return nil
+	}
+
+	price := params.NextNetworkMinGasPrice(k.GetNetworkMinGasPrice(sdkCtx), k.getBlockFill(sdkCtx))
+	k.setNetworkMinGasPrice(sdkCtx, price)
+
+	if priceFloat, err := price.Float64(); err == nil {
+		telemetry.ModuleSetGauge(types.ModuleName, float32(priceFloat), "network_min_gas_price")
+	}
+	return nil
+}
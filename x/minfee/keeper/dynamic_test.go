@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v10/app"
+	testutil "github.com/celestiaorg/celestia-app/v10/test/util"
+	"github.com/celestiaorg/celestia-app/v10/x/minfee/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+func dynamicParams() types.Params {
+	params := types.DefaultParams()
+	params.DynamicEnabled = true
+	params.NetworkMinGasPrice = sdkmath.LegacyMustNewDecFromStr("0.002")
+	params.MinNetworkMinGasPrice = sdkmath.LegacyMustNewDecFromStr("0.001")
+	params.MaxNetworkMinGasPrice = sdkmath.LegacyMustNewDecFromStr("0.004")
+	return params
+}
+
+func TestNextNetworkMinGasPrice(t *testing.T) {
+	params := dynamicParams()
+	current := sdkmath.LegacyMustNewDecFromStr("0.002")
+
+	tests := []struct {
+		name      string
+		current   sdkmath.LegacyDec
+		blockFill sdkmath.LegacyDec
+		want      sdkmath.LegacyDec
+	}{
+		{
+			name:      "block at target leaves the price unchanged",
+			current:   current,
+			blockFill: params.TargetBlockFill,
+			want:      current,
+		},
+		{
+			name:      "full block increases the price by the max change rate",
+			current:   current,
+			blockFill: sdkmath.LegacyOneDec(),
+			want:      sdkmath.LegacyMustNewDecFromStr("0.00225"),
+		},
+		{
+			name:      "empty block decreases the price by the max change rate",
+			current:   current,
+			blockFill: sdkmath.LegacyZeroDec(),
+			want:      sdkmath.LegacyMustNewDecFromStr("0.00175"),
+		},
+		{
+			name:      "block between empty and target decreases the price proportionally",
+			current:   current,
+			blockFill: sdkmath.LegacyMustNewDecFromStr("0.25"),
+			want:      sdkmath.LegacyMustNewDecFromStr("0.001875"),
+		},
+		{
+			name:      "block fill above one is treated as a full block",
+			current:   current,
+			blockFill: sdkmath.LegacyNewDec(2),
+			want:      sdkmath.LegacyMustNewDecFromStr("0.00225"),
+		},
+		{
+			name:      "price is bounded by the max",
+			current:   params.MaxNetworkMinGasPrice,
+			blockFill: sdkmath.LegacyOneDec(),
+			want:      params.MaxNetworkMinGasPrice,
+		},
+		{
+			name:      "price is bounded by the min",
+			current:   params.MinNetworkMinGasPrice,
+			blockFill: sdkmath.LegacyZeroDec(),
+			want:      params.MinNetworkMinGasPrice,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := params.NextNetworkMinGasPrice(tc.current, tc.blockFill)
+			require.True(t, tc.want.Equal(got), "want %v got %v", tc.want, got)
+		})
+	}
+}
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+	require.NoError(t, types.NewParams(types.DefaultNetworkMinGasPrice).Validate(), "dynamic params may be unset when disabled")
+	require.NoError(t, dynamicParams().Validate())
+
+	invalid := []func(*types.Params){
+		func(p *types.Params) { p.MinNetworkMinGasPrice = sdkmath.LegacyZeroDec() },
+		func(p *types.Params) { p.MaxNetworkMinGasPrice = sdkmath.LegacyMustNewDecFromStr("0.0001") },
+		func(p *types.Params) { p.TargetBlockFill = sdkmath.LegacyZeroDec() },
+		func(p *types.Params) { p.TargetBlockFill = sdkmath.LegacyMustNewDecFromStr("1.5") },
+		func(p *types.Params) { p.MaxChangeRate = sdkmath.LegacyZeroDec() },
+		func(p *types.Params) { p.MaxChangeRate = sdkmath.LegacyNewDec(2) },
+		func(p *types.Params) { p.NetworkMinGasPrice = sdkmath.LegacyZeroDec() },
+	}
+	for i, modify := range invalid {
+		params := dynamicParams()
+		modify(&params)
+		require.Error(t, params.Validate(), "case %d", i)
+	}
+}
+
+func TestDynamicNetworkMinGasPrice(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	sdkCtx := testApp.NewContext(false)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	k := testApp.MinFeeKeeper
+
+	// The end blocker does nothing while the dynamic network min gas price is
+	// disabled.
+	k.SetBlockFill(sdkCtx, sdkmath.LegacyOneDec())
+	require.NoError(t, k.EndBlocker(sdkCtx))
+	require.Equal(t, types.DefaultNetworkMinGasPrice, k.GetNetworkMinGasPrice(sdkCtx))
+
+	params := dynamicParams()
+	_, err := k.UpdateMinfeeParams(sdkCtx, &types.MsgUpdateMinfeeParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+	require.Equal(t, params.NetworkMinGasPrice, k.GetNetworkMinGasPrice(sdkCtx))
+
+	// A full block increases the price.
+	require.NoError(t, k.EndBlocker(sdkCtx))
+	require.True(t, sdkmath.LegacyMustNewDecFromStr("0.00225").Equal(k.GetNetworkMinGasPrice(sdkCtx)))
+
+	resp, err := k.NetworkMinGasPrice(sdkCtx, &types.QueryNetworkMinGasPrice{})
+	require.NoError(t, err)
+	require.True(t, k.GetNetworkMinGasPrice(sdkCtx).Equal(resp.NetworkMinGasPrice))
+
+	// Tightening the bounds clamps the current price.
+	params.MaxNetworkMinGasPrice = sdkmath.LegacyMustNewDecFromStr("0.0021")
+	_, err = k.UpdateMinfeeParams(sdkCtx, &types.MsgUpdateMinfeeParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+	require.True(t, params.MaxNetworkMinGasPrice.Equal(k.GetNetworkMinGasPrice(sdkCtx)))
+
+	// Disabling falls back to the static param.
+	params.DynamicEnabled = false
+	_, err = k.UpdateMinfeeParams(sdkCtx, &types.MsgUpdateMinfeeParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+	require.Equal(t, params.NetworkMinGasPrice, k.GetNetworkMinGasPrice(sdkCtx))
+
+	// Re-enabling starts from the static param again.
+	params.DynamicEnabled = true
+	_, err = k.UpdateMinfeeParams(sdkCtx, &types.MsgUpdateMinfeeParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+	require.True(t, params.NetworkMinGasPrice.Equal(k.GetNetworkMinGasPrice(sdkCtx)))
+}
@@ -9,16 +9,18 @@ import (
 )
 
 type Keeper struct {
-	cdc            codec.Codec
-	storeKey       storetypes.StoreKey
-	paramsKeeper   params.Keeper
-	legacySubspace paramtypes.Subspace
-	authority      string
+	cdc               codec.Codec
+	storeKey          storetypes.StoreKey
+	transientStoreKey storetypes.StoreKey
+	paramsKeeper      params.Keeper
+	legacySubspace    paramtypes.Subspace
+	authority         string
 }
 
 func NewKeeper(
 	cdc codec.Codec,
 	storeKey storetypes.StoreKey,
+	transientStoreKey storetypes.StoreKey,
 	paramsKeeper params.Keeper,
 	legacySubspace paramtypes.Subspace,
 	authority string,
@@ -28,11 +30,12 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		cdc:            cdc,
-		storeKey:       storeKey,
-		paramsKeeper:   paramsKeeper,
-		legacySubspace: legacySubspace,
-		authority:      authority,
+		cdc:               cdc,
+		storeKey:          storeKey,
+		transientStoreKey: transientStoreKey,
+		paramsKeeper:      paramsKeeper,
+		legacySubspace:    legacySubspace,
+		authority:         authority,
 	}
 }
 
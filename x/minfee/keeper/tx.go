@@ -25,6 +25,7 @@ func (k Keeper) UpdateMinfeeParams(goCtx context.Context, msg *types.MsgUpdateMi
 	}
 
 	k.SetParams(ctx, msg.Params)
+	k.resetNetworkMinGasPrice(ctx, msg.Params)
 
 	// Emit an event indicating successful parameter update.
 	if err := ctx.EventManager().EmitTypedEvent(
@@ -8,9 +8,7 @@ import (
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		NetworkMinGasPrice: DefaultNetworkMinGasPrice, // TODO: remove this field
-		Params: Params{
-			NetworkMinGasPrice: DefaultNetworkMinGasPrice,
-		},
+		Params:             DefaultParams(),
 	}
 }
 
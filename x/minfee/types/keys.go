@@ -7,6 +7,18 @@ const (
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
 
+	// TransientStoreKey defines the transient store key used to pass the size
+	// of the current block to the end blocker
+	TransientStoreKey = "transient_" + ModuleName
+
 	// ParamsKey defines the key used for storing module parameters
 	ParamsKey = "params"
+
+	// NetworkMinGasPriceKey defines the key used for storing the dynamic
+	// network minimum gas price
+	NetworkMinGasPriceKey = "network_min_gas_price"
+
+	// BlockFillKey defines the transient store key used for storing the
+	// fraction of the maximum block size filled by the current block
+	BlockFillKey = "block_fill"
 )
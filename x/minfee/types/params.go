@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/math"
@@ -9,6 +10,18 @@ import (
 
 var DefaultNetworkMinGasPrice math.LegacyDec
 
+var (
+	// DefaultMaxNetworkMinGasPrice is the default upper bound of the dynamic
+	// network minimum gas price.
+	DefaultMaxNetworkMinGasPrice = math.LegacyNewDecWithPrec(1, 1) // 0.1 utia/gas
+	// DefaultTargetBlockFill is the default fraction of the maximum block size
+	// targeted by the dynamic network minimum gas price.
+	DefaultTargetBlockFill = math.LegacyNewDecWithPrec(5, 1) // 50%
+	// DefaultMaxChangeRate is the default maximum change of the dynamic network
+	// minimum gas price per block. It matches EIP-1559.
+	DefaultMaxChangeRate = math.LegacyNewDecWithPrec(125, 3) // 12.5%
+)
+
 func init() {
 	DefaultNetworkMinGasPriceDec, err := math.LegacyNewDecFromStr(fmt.Sprintf("%f", appconsts.DefaultNetworkMinGasPrice))
 	if err != nil {
@@ -19,13 +32,38 @@ func init() {
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if !p.DynamicEnabled {
+		// The dynamic parameters are not used so they may be unset.
+		return nil
+	}
+
+	if p.NetworkMinGasPrice.IsNil() || !p.NetworkMinGasPrice.IsPositive() {
+		return errors.New("network min gas price must be positive when the dynamic network min gas price is enabled")
+	}
+	if p.MinNetworkMinGasPrice.IsNil() || !p.MinNetworkMinGasPrice.IsPositive() {
+		return fmt.Errorf("min network min gas price must be positive: %v", p.MinNetworkMinGasPrice)
+	}
+	if p.MaxNetworkMinGasPrice.IsNil() || p.MaxNetworkMinGasPrice.LT(p.MinNetworkMinGasPrice) {
+		return fmt.Errorf("max network min gas price %v must not be less than min network min gas price %v", p.MaxNetworkMinGasPrice, p.MinNetworkMinGasPrice)
+	}
+	if p.TargetBlockFill.IsNil() || !p.TargetBlockFill.IsPositive() || p.TargetBlockFill.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("target block fill must be in (0, 1]: %v", p.TargetBlockFill)
+	}
+	if p.MaxChangeRate.IsNil() || !p.MaxChangeRate.IsPositive() || p.MaxChangeRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max change rate must be in (0, 1]: %v", p.MaxChangeRate)
+	}
 	return nil
 }
 
 // DefaultParams returns the default parameters for the module.
 func DefaultParams() Params {
 	return Params{
-		NetworkMinGasPrice: DefaultNetworkMinGasPrice,
+		NetworkMinGasPrice:    DefaultNetworkMinGasPrice,
+		DynamicEnabled:        false,
+		MinNetworkMinGasPrice: DefaultNetworkMinGasPrice,
+		MaxNetworkMinGasPrice: DefaultMaxNetworkMinGasPrice,
+		TargetBlockFill:       DefaultTargetBlockFill,
+		MaxChangeRate:         DefaultMaxChangeRate,
 	}
 }
 
@@ -35,3 +73,31 @@ func NewParams(networkMinGasPrice math.LegacyDec) Params {
 		NetworkMinGasPrice: networkMinGasPrice,
 	}
 }
+
+// NextNetworkMinGasPrice returns the network minimum gas price for the next
+// block given the current price and the fraction of the maximum block size
+// that the current block filled. Like EIP-1559, the price moves by up to
+// MaxChangeRate in proportion to how far the fill is from TargetBlockFill and
+// is bounded by MinNetworkMinGasPrice and MaxNetworkMinGasPrice.
+func (p Params) NextNetworkMinGasPrice(current, blockFill math.LegacyDec) math.LegacyDec {
+	blockFill = math.LegacyMaxDec(math.LegacyZeroDec(), math.LegacyMinDec(blockFill, math.LegacyOneDec()))
+
+	// deviation is in [-1, 1]: -1 for an empty block, 0 at the target and 1 for
+	// a full block.
+	var deviation math.LegacyDec
+	switch {
+	case blockFill.GT(p.TargetBlockFill):
+		deviation = blockFill.Sub(p.TargetBlockFill).Quo(math.LegacyOneDec().Sub(p.TargetBlockFill))
+	default:
+		deviation = blockFill.Sub(p.TargetBlockFill).Quo(p.TargetBlockFill)
+	}
+
+	next := current.Mul(math.LegacyOneDec().Add(p.MaxChangeRate.Mul(deviation)))
+	if next.LT(p.MinNetworkMinGasPrice) {
+		return p.MinNetworkMinGasPrice
+	}
+	if next.GT(p.MaxNetworkMinGasPrice) {
+		return p.MaxNetworkMinGasPrice
+	}
+	return next
+}
@@ -28,6 +28,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the module.
 type Params struct {
 	NetworkMinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=network_min_gas_price,json=networkMinGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"network_min_gas_price"`
+	// DynamicEnabled enables adjusting the network minimum gas price every block
+	// based on how full the block was. When enabled, network_min_gas_price is
+	// the starting value of the adjustment.
+	DynamicEnabled bool `protobuf:"varint,2,opt,name=dynamic_enabled,json=dynamicEnabled,proto3" json:"dynamic_enabled,omitempty"`
+	// MinNetworkMinGasPrice is the lower bound of the dynamic network minimum
+	// gas price.
+	MinNetworkMinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_network_min_gas_price,json=minNetworkMinGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_network_min_gas_price"`
+	// MaxNetworkMinGasPrice is the upper bound of the dynamic network minimum
+	// gas price.
+	MaxNetworkMinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_network_min_gas_price,json=maxNetworkMinGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_network_min_gas_price"`
+	// TargetBlockFill is the fraction of the maximum block size that the
+	// dynamic network minimum gas price aims for. The price increases when
+	// blocks are fuller than the target and decreases when they are emptier.
+	TargetBlockFill cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=target_block_fill,json=targetBlockFill,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_block_fill"`
+	// MaxChangeRate is the maximum fraction by which the dynamic network
+	// minimum gas price can change in a single block. It is reached when a
+	// block is either full or empty.
+	MaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -63,6 +81,13 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetDynamicEnabled() bool {
+	if m != nil {
+		return m.DynamicEnabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "celestia.minfee.v1.Params")
 }
@@ -70,23 +95,31 @@ func init() {
 func init() { proto.RegisterFile("celestia/minfee/v1/params.proto", fileDescriptor_821eedeb4e2f93bf) }
 
 var fileDescriptor_821eedeb4e2f93bf = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0xcf, 0xcd, 0xcc, 0x4b, 0x4b, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x29, 0xd0,
-	0x83, 0x28, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58,
-	0x10, 0x95, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0x10, 0x09, 0x08, 0x07, 0x22,
-	0xa5, 0x94, 0xc7, 0xc5, 0x16, 0x00, 0x36, 0x54, 0x28, 0x85, 0x4b, 0x34, 0x2f, 0xb5, 0xa4, 0x3c,
-	0xbf, 0x28, 0x3b, 0x3e, 0x37, 0x33, 0x2f, 0x3e, 0x3d, 0x11, 0xa4, 0x3e, 0x33, 0x39, 0x55, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0xd3, 0xc9, 0xf0, 0xc4, 0x3d, 0x79, 0x86, 0x5b, 0xf7, 0xe4, 0xa5, 0x21,
-	0xda, 0x8b, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x73, 0x13, 0x4b, 0x32, 0xf4, 0x7c, 0x52, 0xd3,
-	0x13, 0x93, 0x2b, 0x5d, 0x52, 0x93, 0x2f, 0x6d, 0xd1, 0xe5, 0x82, 0x9a, 0xee, 0x92, 0x9a, 0x1c,
-	0x24, 0x04, 0x35, 0xcf, 0x37, 0x33, 0xcf, 0x3d, 0xb1, 0x38, 0x00, 0x64, 0x98, 0x93, 0xcf, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xc3, 0x7c, 0x96, 0x5f, 0x94, 0x0e, 0x67, 0xeb, 0x26, 0x16, 0x14,
-	0xe8, 0x57, 0xc0, 0x02, 0xa3, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x09, 0x63, 0x40,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0x21, 0xaa, 0xfb, 0x2c, 0x01, 0x00, 0x00,
+	// 373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0xae, 0xd2, 0x40,
+	0x14, 0xc6, 0x5b, 0x45, 0xa2, 0x93, 0x28, 0xb1, 0x91, 0xa4, 0x60, 0x52, 0x88, 0x1b, 0xd9, 0xd0,
+	0x06, 0x7d, 0x03, 0x44, 0xdd, 0xa0, 0x21, 0xec, 0x34, 0x31, 0xcd, 0xe9, 0xf4, 0x30, 0x4c, 0xda,
+	0x99, 0x69, 0xda, 0x11, 0xcb, 0x5b, 0xf8, 0x1e, 0x6e, 0x7d, 0x08, 0x96, 0xc4, 0x95, 0x71, 0x41,
+	0x0c, 0xbc, 0xc8, 0x4d, 0x3b, 0x70, 0x37, 0x97, 0xbb, 0xe9, 0xee, 0xcc, 0xf9, 0xf3, 0xfb, 0xbe,
+	0x49, 0x3e, 0x32, 0xa0, 0x98, 0x62, 0xa1, 0x39, 0x04, 0x82, 0xcb, 0x15, 0x62, 0xb0, 0x99, 0x04,
+	0x19, 0xe4, 0x20, 0x0a, 0x3f, 0xcb, 0x95, 0x56, 0x8e, 0x73, 0x59, 0xf0, 0xcd, 0x82, 0xbf, 0x99,
+	0xf4, 0x5f, 0x30, 0xc5, 0x54, 0x3d, 0x0e, 0xaa, 0xca, 0x6c, 0xf6, 0x7b, 0x54, 0x15, 0x42, 0x15,
+	0xa1, 0x19, 0x98, 0x87, 0x19, 0xbd, 0xfa, 0xd5, 0x22, 0xed, 0x45, 0x4d, 0x75, 0x62, 0xd2, 0x95,
+	0xa8, 0x7f, 0xa8, 0x3c, 0x09, 0x05, 0x97, 0x21, 0x83, 0xea, 0x80, 0x53, 0x74, 0xed, 0xa1, 0x3d,
+	0x7a, 0x32, 0x9d, 0xec, 0x0e, 0x03, 0xeb, 0xdf, 0x61, 0xf0, 0xd2, 0xdc, 0x17, 0x71, 0xe2, 0x73,
+	0x15, 0x08, 0xd0, 0x6b, 0x7f, 0x8e, 0x0c, 0xe8, 0x76, 0x86, 0xf4, 0xcf, 0xef, 0x31, 0x39, 0xe3,
+	0x67, 0x48, 0x97, 0xce, 0x99, 0xf7, 0x89, 0xcb, 0x8f, 0x50, 0x2c, 0x2a, 0x98, 0xf3, 0x9a, 0x74,
+	0xe2, 0xad, 0x04, 0xc1, 0x69, 0x88, 0x12, 0xa2, 0x14, 0x63, 0xf7, 0xc1, 0xd0, 0x1e, 0x3d, 0x5e,
+	0x3e, 0x3b, 0xb7, 0xdf, 0x9b, 0xae, 0x93, 0x90, 0x5e, 0x65, 0xe3, 0xba, 0xa5, 0x87, 0x4d, 0x2d,
+	0x75, 0x05, 0x97, 0x9f, 0xef, 0xba, 0xaa, 0xc4, 0xa0, 0xbc, 0x47, 0xac, 0xd5, 0x5c, 0x0c, 0xca,
+	0x2b, 0x62, 0xdf, 0xc8, 0x73, 0x0d, 0x39, 0x43, 0x1d, 0x46, 0xa9, 0xa2, 0x49, 0xb8, 0xe2, 0x69,
+	0xea, 0x3e, 0x6a, 0x2a, 0xd2, 0x31, 0xac, 0x69, 0x85, 0xfa, 0xc0, 0xd3, 0xd4, 0xf9, 0x42, 0x3a,
+	0xd5, 0x5f, 0xe8, 0x1a, 0x24, 0xc3, 0x30, 0x07, 0x8d, 0x6e, 0xbb, 0x29, 0xfc, 0xa9, 0x80, 0xf2,
+	0x5d, 0x0d, 0x5a, 0x82, 0xc6, 0xe9, 0x7c, 0x77, 0xf4, 0xec, 0xfd, 0xd1, 0xb3, 0xff, 0x1f, 0x3d,
+	0xfb, 0xe7, 0xc9, 0xb3, 0xf6, 0x27, 0xcf, 0xfa, 0x7b, 0xf2, 0xac, 0xaf, 0x6f, 0x18, 0xd7, 0xeb,
+	0xef, 0x91, 0x4f, 0x95, 0x08, 0x2e, 0xb9, 0x54, 0x39, 0xbb, 0xad, 0xc7, 0x90, 0x65, 0x41, 0x79,
+	0x89, 0xb2, 0xde, 0x66, 0x58, 0x44, 0xed, 0x3a, 0x82, 0x6f, 0x6f, 0x06, 0x00, 0xbb, 0x94, 0x63,
+	0xff, 0xea, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -109,6 +142,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TargetBlockFill.Size()
+		i -= size
+		if _, err := m.TargetBlockFill.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxNetworkMinGasPrice.Size()
+		i -= size
+		if _, err := m.MaxNetworkMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinNetworkMinGasPrice.Size()
+		i -= size
+		if _, err := m.MinNetworkMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DynamicEnabled {
+		i--
+		if m.DynamicEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.NetworkMinGasPrice.Size()
 		i -= size
@@ -141,6 +224,17 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.NetworkMinGasPrice.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.DynamicEnabled {
+		n += 2
+	}
+	l = m.MinNetworkMinGasPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxNetworkMinGasPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TargetBlockFill.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -213,6 +307,162 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DynamicEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinNetworkMinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinNetworkMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNetworkMinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxNetworkMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockFill", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetBlockFill.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
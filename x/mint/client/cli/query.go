@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v10/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -9,6 +11,12 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagTime        = "time"
+	FlagStartHeight = "start-height"
+	FlagEndHeight   = "end-height"
+)
+
 // GetQueryCmd returns the CLI query commands for the mint module.
 func GetQueryCmd() *cobra.Command {
 	mintQueryCmd := &cobra.Command{
@@ -23,6 +31,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryInflationRate(),
 		GetCmdQueryAnnualProvisions(),
 		GetCmdQueryGenesisTime(),
+		GetCmdQueryProjection(),
 	)
 
 	return mintQueryCmd
@@ -113,3 +122,65 @@ func GetCmdQueryGenesisTime() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryProjection implements a command to return the projected inflation
+// rate, annual provisions and total supply at a future time or over a future
+// height range.
+func GetCmdQueryProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projection",
+		Short: "Query the projected inflation rate, annual provisions and total supply",
+		Long: `Query the projected inflation rate, annual provisions and total supply at a
+future time (--time) or over a future height range (--start-height and
+--end-height). Times of future heights are estimated using the expected block
+time.`,
+		Example: `celestia-appd query mint projection --time 2030-01-01T00:00:00Z
+celestia-appd query mint projection --end-height 10000000`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request := &types.QueryProjectionRequest{}
+			timeStr, err := cmd.Flags().GetString(FlagTime)
+			if err != nil {
+				return err
+			}
+			if timeStr != "" {
+				t, err := time.Parse(time.RFC3339, timeStr)
+				if err != nil {
+					return fmt.Errorf("invalid time %q: %w", timeStr, err)
+				}
+				request.Time = &t
+			}
+			request.StartHeight, err = cmd.Flags().GetInt64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+			request.EndHeight, err = cmd.Flags().GetInt64(FlagEndHeight)
+			if err != nil {
+				return err
+			}
+			if request.Time == nil && request.EndHeight == 0 {
+				return errors.New("either --time or --end-height must be set")
+			}
+
+			res, err := queryClient.Projection(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagTime, "", "Future time to project to in RFC3339 format")
+	cmd.Flags().Int64(FlagStartHeight, 0, "First height of the range to project over (defaults to the current height)")
+	cmd.Flags().Int64(FlagEndHeight, 0, "Last height of the range to project over")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
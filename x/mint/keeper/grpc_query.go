@@ -2,13 +2,21 @@ package keeper
 
 import (
 	"context"
+	"time"
 
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v10/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = Keeper{}
 
+// expectedBlockTime is the block time used to estimate the time of a future
+// height.
+const expectedBlockTime = appconsts.DelayedPrecommitTimeout + appconsts.TimeoutCommit
+
 // InflationRate returns minter.InflationRate of the mint module.
 func (k Keeper) InflationRate(c context.Context, _ *types.QueryInflationRateRequest) (*types.QueryInflationRateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -32,3 +40,68 @@ func (k Keeper) GenesisTime(c context.Context, _ *types.QueryGenesisTimeRequest)
 
 	return &types.QueryGenesisTimeResponse{GenesisTime: genesisTime}, nil
 }
+
+// Projection returns the projected state of the mint module at a future time
+// or over a future height range.
+func (k Keeper) Projection(c context.Context, req *types.QueryProjectionRequest) (*types.QueryProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Time != nil && (req.StartHeight != 0 || req.EndHeight != 0) {
+		return nil, status.Error(codes.InvalidArgument, "time and height range are mutually exclusive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	currentHeight, currentTime := ctx.BlockHeight(), ctx.BlockTime()
+
+	var (
+		startHeight, endHeight int64
+		startTime, endTime     time.Time
+	)
+	switch {
+	case req.Time != nil:
+		startHeight, startTime = currentHeight, currentTime
+		endTime = *req.Time
+	case req.EndHeight != 0:
+		startHeight, endHeight = req.StartHeight, req.EndHeight
+		if startHeight == 0 {
+			startHeight = currentHeight
+		}
+		if startHeight < currentHeight {
+			return nil, status.Errorf(codes.InvalidArgument, "start height %d cannot be before current height %d", startHeight, currentHeight)
+		}
+		if endHeight < startHeight {
+			return nil, status.Errorf(codes.InvalidArgument, "end height %d cannot be before start height %d", endHeight, startHeight)
+		}
+		startTime = estimateTime(currentHeight, currentTime, startHeight)
+		endTime = estimateTime(currentHeight, currentTime, endHeight)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either time or end height must be set")
+	}
+
+	minter := k.GetMinter(ctx)
+	genesisTime := *k.GetGenesisTime(ctx).GenesisTime
+	totalSupply := k.StakingTokenSupply(ctx)
+
+	start, err := minter.Project(genesisTime, currentTime, startTime, totalSupply)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	end, err := minter.Project(genesisTime, currentTime, endTime, totalSupply)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	start.Height, end.Height = startHeight, endHeight
+
+	return &types.QueryProjectionResponse{
+		Start:  start,
+		End:    end,
+		Minted: end.TotalSupply.Sub(start.TotalSupply),
+	}, nil
+}
+
+// estimateTime returns the expected time of height assuming blocks are
+// produced every expectedBlockTime after the current block.
+func estimateTime(currentHeight int64, currentTime time.Time, height int64) time.Time {
+	return currentTime.Add(time.Duration(height-currentHeight) * expectedBlockTime)
+}
@@ -3,6 +3,7 @@ package keeper_test //nolint:all
 import (
 	gocontext "context"
 	"testing"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v10/app"
 	testutil "github.com/celestiaorg/celestia-app/v10/test/util"
@@ -31,3 +32,51 @@ func TestGRPC(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, genesisTime.GenesisTime, testApp.MintKeeper.GetGenesisTime(ctx).GenesisTime)
 }
+
+func TestProjection(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := testApp.NewContext(true).WithBlockHeight(10).WithBlockTime(time.Now().UTC())
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, testApp.GetEncodingConfig().InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, testApp.MintKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	totalSupply := testApp.MintKeeper.StakingTokenSupply(ctx)
+
+	t.Run("by time", func(t *testing.T) {
+		target := ctx.BlockTime().Add(24 * time.Hour)
+		res, err := queryClient.Projection(gocontext.Background(), &types.QueryProjectionRequest{Time: &target})
+		require.NoError(t, err)
+		require.Equal(t, ctx.BlockHeight(), res.Start.Height)
+		require.True(t, totalSupply.Equal(res.Start.TotalSupply))
+		require.Equal(t, int64(0), res.End.Height)
+		require.True(t, target.Equal(res.End.Time))
+		require.True(t, res.Minted.IsPositive())
+		require.True(t, res.End.TotalSupply.Equal(res.Start.TotalSupply.Add(res.Minted)))
+	})
+
+	t.Run("by height range", func(t *testing.T) {
+		res, err := queryClient.Projection(gocontext.Background(), &types.QueryProjectionRequest{StartHeight: 100, EndHeight: 200})
+		require.NoError(t, err)
+		require.Equal(t, int64(100), res.Start.Height)
+		require.Equal(t, int64(200), res.End.Height)
+		require.True(t, res.Start.Time.After(ctx.BlockTime()))
+		require.True(t, res.End.Time.After(res.Start.Time))
+		require.True(t, res.Start.TotalSupply.GT(totalSupply))
+		require.True(t, res.Minted.IsPositive())
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		past := ctx.BlockTime().Add(-time.Hour)
+		for _, req := range []*types.QueryProjectionRequest{
+			{},
+			{Time: &past},
+			{EndHeight: 5},
+			{StartHeight: 200, EndHeight: 100},
+			{Time: &past, EndHeight: 100},
+		} {
+			_, err := queryClient.Projection(gocontext.Background(), req)
+			require.Error(t, err)
+		}
+	})
+}
@@ -42,7 +42,13 @@ func (m Minter) Validate() error {
 // the current block time in context. The inflation rate is expected to
 // decrease every year according to the schedule specified in the README.
 func (m Minter) CalculateInflationRate(ctx sdk.Context, genesisTime time.Time) math.LegacyDec {
-	yearsSinceGenesis := yearsSinceGenesis(genesisTime, ctx.BlockTime())
+	return inflationRateAt(genesisTime, ctx.BlockTime())
+}
+
+// inflationRateAt returns the inflation rate for the year that blockTime falls
+// in.
+func inflationRateAt(genesisTime, blockTime time.Time) math.LegacyDec {
+	yearsSinceGenesis := yearsSinceGenesis(genesisTime, blockTime)
 	inflationRate := InitialInflationRateAsDec().Mul(math.LegacyOneDec().Sub(DisinflationRateAsDec()).Power(uint64(yearsSinceGenesis)))
 	if inflationRate.LT(TargetInflationRateAsDec()) {
 		return TargetInflationRateAsDec()
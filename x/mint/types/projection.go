@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// Project returns the projected inflation rate, annual provisions and total
+// supply at target, given the total supply at current. It follows the same
+// schedule as BeginBlocker: tokens are minted continuously at the current
+// annual provisions and the inflation rate and annual provisions are
+// recalculated on every anniversary of genesis. The projection ignores the
+// truncation of each block provision so it may slightly overestimate the
+// total supply.
+func (m Minter) Project(genesisTime, current, target time.Time, totalSupply math.Int) (Projection, error) {
+	if target.Before(current) {
+		return Projection{}, fmt.Errorf("target time %v cannot be before current time %v", target, current)
+	}
+
+	inflationRate := m.InflationRate
+	annualProvisions := m.AnnualProvisions
+	supply := math.LegacyNewDecFromInt(totalSupply)
+
+	// update mirrors maybeUpdateMinter in the keeper.
+	update := func(blockTime time.Time) {
+		newInflationRate := inflationRateAt(genesisTime, blockTime)
+		if newInflationRate.Equal(inflationRate) && !annualProvisions.IsZero() {
+			return
+		}
+		inflationRate = newInflationRate
+		annualProvisions = newInflationRate.MulInt(supply.TruncateInt())
+	}
+	update(current)
+
+	anniversary := genesisTime.Add(time.Duration((yearsSinceGenesis(genesisTime, current) + 1) * NanosecondsPerYear))
+	for blockTime := current; blockTime.Before(target); anniversary = anniversary.Add(time.Duration(NanosecondsPerYear)) {
+		end := target
+		if anniversary.Before(target) {
+			end = anniversary
+		}
+		portionOfYear := math.LegacyNewDec(end.Sub(blockTime).Nanoseconds()).Quo(math.LegacyNewDec(NanosecondsPerYear))
+		supply = supply.Add(annualProvisions.Mul(portionOfYear))
+
+		blockTime = end
+		if blockTime.Equal(anniversary) {
+			update(blockTime)
+		}
+	}
+
+	return Projection{
+		Time:             target,
+		InflationRate:    inflationRate,
+		AnnualProvisions: annualProvisions,
+		TotalSupply:      supply.TruncateInt(),
+	}, nil
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProject(t *testing.T) {
+	genesisTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	oneYear := time.Duration(NanosecondsPerYear)
+	totalSupply := math.NewInt(1_000_000_000_000) // 1 trillion utia
+
+	// minter reflects the state at the start of year 2.
+	current := genesisTime.Add(2 * oneYear)
+	minter := DefaultMinter()
+	minter.InflationRate = inflationRateAt(genesisTime, current)
+	minter.AnnualProvisions = minter.InflationRate.MulInt(totalSupply)
+
+	t.Run("projecting to the current time returns the current state", func(t *testing.T) {
+		got, err := minter.Project(genesisTime, current, current, totalSupply)
+		require.NoError(t, err)
+		assert.Equal(t, current, got.Time)
+		assert.True(t, minter.InflationRate.Equal(got.InflationRate))
+		assert.True(t, minter.AnnualProvisions.Equal(got.AnnualProvisions))
+		assert.True(t, totalSupply.Equal(got.TotalSupply))
+	})
+
+	t.Run("half a year mints half of the annual provisions", func(t *testing.T) {
+		got, err := minter.Project(genesisTime, current, current.Add(oneYear/2), totalSupply)
+		require.NoError(t, err)
+		assert.True(t, minter.InflationRate.Equal(got.InflationRate))
+		want := totalSupply.Add(minter.AnnualProvisions.QuoInt64(2).TruncateInt())
+		assert.True(t, want.Equal(got.TotalSupply), "want %v got %v", want, got.TotalSupply)
+	})
+
+	t.Run("anniversary recalculates inflation rate and annual provisions", func(t *testing.T) {
+		got, err := minter.Project(genesisTime, current, current.Add(oneYear), totalSupply)
+		require.NoError(t, err)
+		supply := totalSupply.Add(minter.AnnualProvisions.TruncateInt())
+		wantInflationRate := inflationRateAt(genesisTime, current.Add(oneYear))
+		assert.True(t, wantInflationRate.Equal(got.InflationRate))
+		assert.True(t, wantInflationRate.MulInt(supply).Equal(got.AnnualProvisions))
+		assert.True(t, supply.Equal(got.TotalSupply), "want %v got %v", supply, got.TotalSupply)
+	})
+
+	t.Run("inflation rate stops at the target inflation rate", func(t *testing.T) {
+		got, err := minter.Project(genesisTime, current, genesisTime.Add(20*oneYear), totalSupply)
+		require.NoError(t, err)
+		assert.True(t, TargetInflationRateAsDec().Equal(got.InflationRate))
+		assert.True(t, got.TotalSupply.GT(totalSupply))
+	})
+
+	t.Run("zero annual provisions are calculated from the total supply", func(t *testing.T) {
+		minter := DefaultMinter()
+		got, err := minter.Project(genesisTime, genesisTime, genesisTime, totalSupply)
+		require.NoError(t, err)
+		assert.True(t, InitialInflationRateAsDec().MulInt(totalSupply).Equal(got.AnnualProvisions))
+	})
+
+	t.Run("want error when target is before current", func(t *testing.T) {
+		_, err := minter.Project(genesisTime, current, current.Add(-time.Second), totalSupply)
+		assert.Error(t, err)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -275,6 +276,194 @@ func (m *QueryGenesisTimeResponse) GetGenesisTime() *time.Time {
 	return nil
 }
 
+// QueryProjectionRequest is the request type for the Query/Projection RPC
+// method. Exactly one of time or end_height must be set.
+type QueryProjectionRequest struct {
+	// Time is the future time to project to.
+	Time *time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+	// StartHeight is the first height of the range to project over. If zero,
+	// the current height is used.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// EndHeight is the last height of the range to project over.
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *QueryProjectionRequest) Reset()         { *m = QueryProjectionRequest{} }
+func (m *QueryProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionRequest) ProtoMessage()    {}
+func (*QueryProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{6}
+}
+func (m *QueryProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionRequest.Merge(m, src)
+}
+func (m *QueryProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionRequest proto.InternalMessageInfo
+
+func (m *QueryProjectionRequest) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *QueryProjectionRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryProjectionRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// QueryProjectionResponse is the response type for the Query/Projection RPC
+// method.
+type QueryProjectionResponse struct {
+	// Start is the projection at the start of the range.
+	Start Projection `protobuf:"bytes,1,opt,name=start,proto3" json:"start"`
+	// End is the projection at the end of the range.
+	End Projection `protobuf:"bytes,2,opt,name=end,proto3" json:"end"`
+	// Minted is the number of tokens projected to be minted between start and
+	// end.
+	Minted cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted"`
+}
+
+func (m *QueryProjectionResponse) Reset()         { *m = QueryProjectionResponse{} }
+func (m *QueryProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionResponse) ProtoMessage()    {}
+func (*QueryProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{7}
+}
+func (m *QueryProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionResponse.Merge(m, src)
+}
+func (m *QueryProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryProjectionResponse) GetStart() Projection {
+	if m != nil {
+		return m.Start
+	}
+	return Projection{}
+}
+
+func (m *QueryProjectionResponse) GetEnd() Projection {
+	if m != nil {
+		return m.End
+	}
+	return Projection{}
+}
+
+// Projection is the projected state of the minter at a point in time.
+type Projection struct {
+	// Height is the estimated height of the projection. It is zero if the
+	// projection was requested by time.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Time is the time of the projection. Times derived from heights are
+	// estimated using the expected block time.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// InflationRate is the projected inflation rate.
+	InflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=inflation_rate,json=inflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_rate"`
+	// AnnualProvisions is the projected annual provisions.
+	AnnualProvisions cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"annual_provisions"`
+	// TotalSupply is the projected total supply of the bond denom.
+	TotalSupply cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=total_supply,json=totalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"total_supply"`
+}
+
+func (m *Projection) Reset()         { *m = Projection{} }
+func (m *Projection) String() string { return proto.CompactTextString(m) }
+func (*Projection) ProtoMessage()    {}
+func (*Projection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{8}
+}
+func (m *Projection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Projection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Projection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Projection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Projection.Merge(m, src)
+}
+func (m *Projection) XXX_Size() int {
+	return m.Size()
+}
+func (m *Projection) XXX_DiscardUnknown() {
+	xxx_messageInfo_Projection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Projection proto.InternalMessageInfo
+
+func (m *Projection) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Projection) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*QueryInflationRateRequest)(nil), "celestia.mint.v1.QueryInflationRateRequest")
 	proto.RegisterType((*QueryInflationRateResponse)(nil), "celestia.mint.v1.QueryInflationRateResponse")
@@ -282,43 +471,62 @@ func init() {
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "celestia.mint.v1.QueryAnnualProvisionsResponse")
 	proto.RegisterType((*QueryGenesisTimeRequest)(nil), "celestia.mint.v1.QueryGenesisTimeRequest")
 	proto.RegisterType((*QueryGenesisTimeResponse)(nil), "celestia.mint.v1.QueryGenesisTimeResponse")
+	proto.RegisterType((*QueryProjectionRequest)(nil), "celestia.mint.v1.QueryProjectionRequest")
+	proto.RegisterType((*QueryProjectionResponse)(nil), "celestia.mint.v1.QueryProjectionResponse")
+	proto.RegisterType((*Projection)(nil), "celestia.mint.v1.Projection")
 }
 
 func init() { proto.RegisterFile("celestia/mint/v1/query.proto", fileDescriptor_a1ed5b0ae449a133) }
 
 var fileDescriptor_a1ed5b0ae449a133 = []byte{
-	// 495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
-	0x14, 0x85, 0x33, 0xfc, 0x2d, 0x26, 0x2d, 0x0a, 0x23, 0x24, 0x5a, 0x37, 0x38, 0xe0, 0x08, 0xd4,
-	0x52, 0x98, 0x21, 0xe5, 0x09, 0x08, 0x48, 0x88, 0x8a, 0x45, 0x89, 0xba, 0x62, 0x13, 0x4d, 0xcc,
-	0x74, 0x32, 0xc2, 0x9e, 0x71, 0x3c, 0xe3, 0x88, 0x6c, 0x79, 0x01, 0x2a, 0xb1, 0xe0, 0x01, 0x78,
-	0x99, 0x2e, 0x2b, 0xb1, 0x01, 0x16, 0x05, 0x25, 0x3c, 0x08, 0xf2, 0xd8, 0x0e, 0xf9, 0xb3, 0x94,
-	0xee, 0xae, 0x7d, 0xae, 0xef, 0xf9, 0x7c, 0xcf, 0x85, 0x75, 0x9f, 0x05, 0x4c, 0x1b, 0x41, 0x49,
-	0x28, 0xa4, 0x21, 0xc3, 0x16, 0x19, 0x24, 0x2c, 0x1e, 0xe1, 0x28, 0x56, 0x46, 0xa1, 0x5a, 0xa1,
-	0xe2, 0x54, 0xc5, 0xc3, 0x96, 0x73, 0x9b, 0x2b, 0xae, 0xac, 0x48, 0xd2, 0x2a, 0xeb, 0x73, 0xea,
-	0x5c, 0x29, 0x1e, 0x30, 0x42, 0x23, 0x41, 0xa8, 0x94, 0xca, 0x50, 0x23, 0x94, 0xd4, 0xb9, 0xda,
-	0xc8, 0x55, 0xfb, 0xd4, 0x4b, 0x4e, 0x88, 0x11, 0x21, 0xd3, 0x86, 0x86, 0x51, 0xd6, 0xe0, 0xed,
-	0xc0, 0xed, 0xb7, 0xa9, 0xeb, 0x6b, 0x79, 0x12, 0xd8, 0x2f, 0x3b, 0xd4, 0xb0, 0x0e, 0x1b, 0x24,
-	0x4c, 0x1b, 0xaf, 0x0f, 0x9d, 0x55, 0xa2, 0x8e, 0x94, 0xd4, 0x0c, 0x1d, 0xc2, 0x9b, 0xa2, 0x10,
-	0xba, 0x31, 0x35, 0x6c, 0x0b, 0xdc, 0x03, 0xbb, 0x1b, 0xed, 0xe6, 0xd9, 0x45, 0xa3, 0xf2, 0xeb,
-	0xa2, 0xb1, 0xe3, 0x2b, 0x1d, 0x2a, 0xad, 0xdf, 0x7f, 0xc0, 0x42, 0x91, 0x90, 0x9a, 0x3e, 0x7e,
-	0xc3, 0x38, 0xf5, 0x47, 0x2f, 0x99, 0xdf, 0xd9, 0x14, 0xb3, 0x33, 0x3d, 0x17, 0xd6, 0xad, 0xd3,
-	0x73, 0x29, 0x13, 0x1a, 0x1c, 0xc5, 0x6a, 0x28, 0x74, 0xfa, 0x1b, 0x05, 0xc9, 0x00, 0xde, 0x2d,
-	0xd1, 0x73, 0x98, 0x23, 0x78, 0x8b, 0x5a, 0xad, 0x1b, 0x4d, 0xc5, 0xcb, 0xf0, 0xd4, 0xe8, 0xc2,
-	0x64, 0x6f, 0x1b, 0xde, 0xb1, 0x96, 0xaf, 0x98, 0x64, 0x5a, 0xe8, 0x63, 0x11, 0x4e, 0xf7, 0xd2,
-	0x85, 0x5b, 0xcb, 0x52, 0x0e, 0xf2, 0x02, 0x6e, 0xf0, 0xec, 0x75, 0x37, 0xdd, 0xb5, 0x65, 0xa8,
-	0x1e, 0x38, 0x38, 0x0b, 0x02, 0x17, 0x41, 0xe0, 0xe3, 0x22, 0x88, 0xf6, 0xb5, 0xd3, 0xdf, 0x0d,
-	0xd0, 0xa9, 0xf2, 0xff, 0xc3, 0x0e, 0x7e, 0x5e, 0x85, 0xd7, 0xad, 0x03, 0xfa, 0x0a, 0xe0, 0xe6,
-	0xdc, 0xfa, 0xd1, 0x3e, 0x5e, 0xbc, 0x0c, 0x5c, 0x9a, 0xa0, 0xf3, 0x78, 0xbd, 0xe6, 0x8c, 0xdd,
-	0xdb, 0xff, 0xf4, 0xfd, 0xef, 0x97, 0x2b, 0x0f, 0x50, 0x93, 0x64, 0xab, 0x2a, 0x0e, 0xb3, 0xc7,
-	0x0c, 0x6d, 0x91, 0xf9, 0xb0, 0xd1, 0x37, 0x00, 0x6b, 0x8b, 0x71, 0x20, 0x5c, 0xe2, 0x57, 0x92,
-	0xab, 0x43, 0xd6, 0xee, 0xcf, 0x11, 0xb1, 0x45, 0xdc, 0x45, 0x0f, 0x57, 0x22, 0x2e, 0x9d, 0x00,
-	0xfa, 0x0c, 0x60, 0x75, 0x26, 0x26, 0xb4, 0x57, 0x62, 0xb8, 0x9c, 0xb2, 0xf3, 0x68, 0x9d, 0xd6,
-	0x1c, 0x6b, 0xcf, 0x62, 0x35, 0xd1, 0xfd, 0x95, 0x58, 0xb3, 0x07, 0xd1, 0x3e, 0x3c, 0x1b, 0xbb,
-	0xe0, 0x7c, 0xec, 0x82, 0x3f, 0x63, 0x17, 0x9c, 0x4e, 0xdc, 0xca, 0xf9, 0xc4, 0xad, 0xfc, 0x98,
-	0xb8, 0x95, 0x77, 0x4f, 0xb9, 0x30, 0xfd, 0xa4, 0x87, 0x7d, 0x15, 0x92, 0xc2, 0x5a, 0xc5, 0x7c,
-	0x5a, 0x3f, 0xa1, 0x51, 0x44, 0x3e, 0x66, 0xa3, 0xcd, 0x28, 0x62, 0xba, 0x77, 0xc3, 0x9e, 0xd3,
-	0xb3, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x1e, 0xd5, 0xdc, 0x4b, 0x04, 0x00, 0x00,
+	// 744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0x3b, 0xa5, 0x90, 0x1f, 0x4f, 0xcb, 0x2f, 0xfc, 0x26, 0x3f, 0xa1, 0x2c, 0xa5, 0x85,
+	0x25, 0x6a, 0x11, 0xd9, 0xb5, 0xc8, 0x81, 0xab, 0xc5, 0x44, 0x21, 0xc6, 0x60, 0xe5, 0x60, 0x3c,
+	0xd8, 0x4c, 0xdb, 0x61, 0xbb, 0xda, 0xee, 0x2c, 0x9d, 0x29, 0xb1, 0x57, 0x8f, 0x5e, 0x24, 0xf1,
+	0xe0, 0x0b, 0xf0, 0x2d, 0xf8, 0x22, 0x38, 0x19, 0xa2, 0x17, 0xe3, 0x01, 0x0d, 0x18, 0xe3, 0xcb,
+	0x30, 0x3b, 0x33, 0x4b, 0x0b, 0xdb, 0xc6, 0xe2, 0x6d, 0x77, 0xbe, 0xcf, 0x9f, 0xcf, 0x3c, 0xcf,
+	0x33, 0x0f, 0x64, 0xaa, 0xb4, 0x41, 0xb9, 0x70, 0x89, 0xdd, 0x74, 0x3d, 0x61, 0xef, 0x17, 0xec,
+	0xbd, 0x36, 0x6d, 0x75, 0x2c, 0xbf, 0xc5, 0x04, 0xc3, 0x93, 0xa1, 0x6a, 0x05, 0xaa, 0xb5, 0x5f,
+	0x30, 0x66, 0xaa, 0x8c, 0x37, 0x19, 0x2f, 0x4b, 0xdd, 0x56, 0x3f, 0xca, 0xd8, 0xf8, 0xdf, 0x61,
+	0x0e, 0x53, 0xe7, 0xc1, 0x97, 0x3e, 0xcd, 0x38, 0x8c, 0x39, 0x0d, 0x6a, 0x13, 0xdf, 0xb5, 0x89,
+	0xe7, 0x31, 0x41, 0x84, 0xcb, 0xbc, 0xd0, 0x27, 0xa7, 0x55, 0xf9, 0x57, 0x69, 0xef, 0xda, 0xc2,
+	0x6d, 0x52, 0x2e, 0x48, 0xd3, 0x57, 0x06, 0xe6, 0x2c, 0xcc, 0x3c, 0x0a, 0x80, 0x36, 0xbd, 0xdd,
+	0x86, 0xf4, 0x2c, 0x11, 0x41, 0x4b, 0x74, 0xaf, 0x4d, 0xb9, 0x30, 0xeb, 0x60, 0xf4, 0x13, 0xb9,
+	0xcf, 0x3c, 0x4e, 0xf1, 0x16, 0xfc, 0xeb, 0x86, 0x42, 0xb9, 0x45, 0x04, 0x4d, 0xa3, 0x79, 0x94,
+	0x4f, 0x15, 0x17, 0x0f, 0x8f, 0x73, 0xb1, 0xaf, 0xc7, 0xb9, 0x59, 0x45, 0xcf, 0x6b, 0x2f, 0x2c,
+	0x97, 0xd9, 0x4d, 0x22, 0xea, 0xd6, 0x03, 0xea, 0x90, 0x6a, 0xe7, 0x2e, 0xad, 0x96, 0x26, 0xdc,
+	0xde, 0x98, 0x66, 0x16, 0x32, 0x32, 0xd3, 0x1d, 0xcf, 0x6b, 0x93, 0xc6, 0x76, 0x8b, 0xed, 0xbb,
+	0x3c, 0xb8, 0x46, 0x48, 0xb2, 0x07, 0x73, 0x03, 0x74, 0x0d, 0xb3, 0x0d, 0xff, 0x11, 0xa9, 0x95,
+	0xfd, 0x33, 0xf1, 0x32, 0x3c, 0x93, 0xe4, 0x42, 0x64, 0x73, 0x06, 0xa6, 0x65, 0xca, 0x7b, 0xd4,
+	0xa3, 0xdc, 0xe5, 0x3b, 0x6e, 0xf3, 0xac, 0x2e, 0x65, 0x48, 0x47, 0x25, 0x0d, 0xb2, 0x01, 0x29,
+	0x47, 0x1d, 0x97, 0x83, 0x5a, 0x4b, 0x86, 0xe4, 0xaa, 0x61, 0xa9, 0x46, 0x58, 0x61, 0x23, 0xac,
+	0x9d, 0xb0, 0x11, 0xc5, 0xc4, 0xc1, 0xb7, 0x1c, 0x2a, 0x25, 0x9d, 0x6e, 0x30, 0xf3, 0x00, 0xc1,
+	0x94, 0xcc, 0xb0, 0xdd, 0x62, 0xcf, 0x69, 0x55, 0x96, 0x49, 0xe5, 0xc6, 0x6b, 0x90, 0xb8, 0x54,
+	0x5c, 0x69, 0x8d, 0x17, 0x20, 0xc5, 0x05, 0x69, 0x89, 0x72, 0x9d, 0xba, 0x4e, 0x5d, 0xa4, 0xe3,
+	0xf3, 0x28, 0x3f, 0x52, 0x4a, 0xca, 0xb3, 0xfb, 0xf2, 0x08, 0xcf, 0x01, 0x50, 0xaf, 0x16, 0x1a,
+	0x8c, 0x48, 0x83, 0x71, 0xea, 0xd5, 0x94, 0x6c, 0x7e, 0x44, 0x30, 0x1d, 0x41, 0xd2, 0x77, 0x5e,
+	0x87, 0x51, 0x19, 0x49, 0x43, 0x65, 0xac, 0x8b, 0x63, 0x6d, 0x75, 0x9d, 0x8a, 0x89, 0xa0, 0x1d,
+	0x25, 0xe5, 0x80, 0xd7, 0x60, 0x84, 0x7a, 0xb5, 0x74, 0x7c, 0x68, 0xbf, 0xc0, 0x1c, 0x6f, 0xc0,
+	0x58, 0x60, 0x40, 0x6b, 0x12, 0x73, 0xbc, 0xb8, 0xac, 0x3b, 0x7c, 0x25, 0xda, 0xe1, 0x4d, 0x4f,
+	0x7c, 0xfa, 0xb0, 0x02, 0x4a, 0x08, 0xfe, 0x4a, 0xda, 0xd5, 0xfc, 0x15, 0x07, 0xe8, 0x86, 0xc7,
+	0x53, 0x30, 0xa6, 0xaf, 0x8e, 0xe4, 0xd5, 0xf5, 0x1f, 0x5e, 0xd7, 0xf5, 0x8e, 0xff, 0xb1, 0xde,
+	0xff, 0x04, 0x14, 0x3d, 0x35, 0x7f, 0x12, 0x79, 0x1f, 0x8a, 0xb6, 0x30, 0xc4, 0x3c, 0xf6, 0x30,
+	0x47, 0x5f, 0x0b, 0x7e, 0xd6, 0x6f, 0xd8, 0x13, 0x7f, 0x1b, 0x3c, 0x32, 0xfa, 0xf8, 0x21, 0xa4,
+	0x04, 0x13, 0xa4, 0x51, 0xe6, 0x6d, 0xdf, 0x6f, 0x74, 0xd2, 0xa3, 0x97, 0xaf, 0x72, 0x52, 0x06,
+	0x78, 0x2c, 0xfd, 0x57, 0x7f, 0x26, 0x60, 0x54, 0xce, 0x0e, 0x7e, 0x87, 0x60, 0xe2, 0xdc, 0x36,
+	0xc1, 0xcb, 0xd1, 0xa6, 0x0f, 0x5c, 0x48, 0xc6, 0xcd, 0xe1, 0x8c, 0xd5, 0x58, 0x9a, 0xcb, 0xaf,
+	0x3e, 0xff, 0x78, 0x1b, 0xbf, 0x8a, 0x17, 0xf5, 0x1e, 0x0d, 0x57, 0x70, 0x85, 0x0a, 0x52, 0xb0,
+	0xcf, 0xf7, 0x06, 0xbf, 0x47, 0x30, 0x79, 0x71, 0xbb, 0x60, 0x6b, 0x40, 0xbe, 0x01, 0x6b, 0xca,
+	0xb0, 0x87, 0xb6, 0xd7, 0x88, 0x96, 0x44, 0xcc, 0xe3, 0x6b, 0x7d, 0x11, 0x23, 0x4d, 0xc6, 0x6f,
+	0x10, 0x24, 0x7b, 0xb6, 0x0e, 0x5e, 0x1a, 0x90, 0x30, 0xba, 0xb4, 0x8c, 0x1b, 0xc3, 0x98, 0x6a,
+	0xac, 0x25, 0x89, 0xb5, 0x88, 0x17, 0xfa, 0x62, 0xf5, 0xee, 0x37, 0xfc, 0x1a, 0x9d, 0x7b, 0x46,
+	0xf9, 0x01, 0x59, 0x22, 0x8b, 0xcc, 0x58, 0x1a, 0xc2, 0x52, 0xe3, 0x5c, 0x97, 0x38, 0x0b, 0x38,
+	0xd7, 0x17, 0xc7, 0xef, 0xee, 0x88, 0xad, 0xc3, 0x93, 0x2c, 0x3a, 0x3a, 0xc9, 0xa2, 0xef, 0x27,
+	0x59, 0x74, 0x70, 0x9a, 0x8d, 0x1d, 0x9d, 0x66, 0x63, 0x5f, 0x4e, 0xb3, 0xb1, 0xa7, 0xb7, 0x1c,
+	0x57, 0xd4, 0xdb, 0x15, 0xab, 0xca, 0x9a, 0x76, 0x98, 0x97, 0xb5, 0x9c, 0xb3, 0xef, 0x15, 0xe2,
+	0xfb, 0xf6, 0x4b, 0x15, 0x58, 0x74, 0x7c, 0xca, 0x2b, 0x63, 0xf2, 0x89, 0xdf, 0xfe, 0x3d, 0x00,
+	0xab, 0x16, 0x45, 0xcf, 0xc2, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -339,6 +547,9 @@ type QueryClient interface {
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
 	// GenesisTime returns the genesis time.
 	GenesisTime(ctx context.Context, in *QueryGenesisTimeRequest, opts ...grpc.CallOption) (*QueryGenesisTimeResponse, error)
+	// Projection returns the projected inflation rate, annual provisions and
+	// total supply at a future time or over a future height range.
+	Projection(ctx context.Context, in *QueryProjectionRequest, opts ...grpc.CallOption) (*QueryProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -376,6 +587,15 @@ func (c *queryClient) GenesisTime(ctx context.Context, in *QueryGenesisTimeReque
 	return out, nil
 }
 
+func (c *queryClient) Projection(ctx context.Context, in *QueryProjectionRequest, opts ...grpc.CallOption) (*QueryProjectionResponse, error) {
+	out := new(QueryProjectionResponse)
+	err := c.cc.Invoke(ctx, "/celestia.mint.v1.Query/Projection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// InflationRate returns the current inflation rate.
@@ -384,6 +604,9 @@ type QueryServer interface {
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
 	// GenesisTime returns the genesis time.
 	GenesisTime(context.Context, *QueryGenesisTimeRequest) (*QueryGenesisTimeResponse, error)
+	// Projection returns the projected inflation rate, annual provisions and
+	// total supply at a future time or over a future height range.
+	Projection(context.Context, *QueryProjectionRequest) (*QueryProjectionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -399,6 +622,9 @@ func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) GenesisTime(ctx context.Context, req *QueryGenesisTimeRequest) (*QueryGenesisTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenesisTime not implemented")
 }
+func (*UnimplementedQueryServer) Projection(ctx context.Context, req *QueryProjectionRequest) (*QueryProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Projection not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -458,6 +684,24 @@ func _Query_GenesisTime_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Projection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Projection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.mint.v1.Query/Projection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Projection(ctx, req.(*QueryProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.mint.v1.Query",
@@ -475,6 +719,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GenesisTime",
 			Handler:    _Query_GenesisTime_Handler,
 		},
+		{
+			MethodName: "Projection",
+			Handler:    _Query_Projection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/mint/v1/query.proto",
@@ -648,6 +896,168 @@ func (m *QueryGenesisTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Time != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Projection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Projection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Projection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InflationRate.Size()
+		i -= size
+		if _, err := m.InflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -721,16 +1131,70 @@ func (m *QueryGenesisTimeResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Start.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.End.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Minted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
-func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *Projection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -1129,6 +1593,484 @@ func (m *QueryGenesisTimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Projection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Projection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Projection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -45,3 +45,10 @@ func (s *CLITestSuite) TestCmdGetUpgrade() {
 	s.Require().NoError(err)
 	s.Require().Contains(output.String(), "No upgrade is pending.")
 }
+
+func (s *CLITestSuite) TestCmdSignalHistory() {
+	cmd := cli.CmdSignalHistory()
+	output, err := testutil.ExecTestCLICmd(s.ctx.Context, cmd, []string{})
+	s.Require().NoError(err)
+	s.Require().Contains(output.String(), "records")
+}
@@ -23,6 +23,8 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdQueryTally())
 	cmd.AddCommand(CmdGetUpgrade())
 	cmd.AddCommand(CmdGetMissingValidators())
+	cmd.AddCommand(CmdGetUpgradeSchedule())
+	cmd.AddCommand(CmdSignalHistory())
 	return cmd
 }
 
@@ -116,3 +118,88 @@ func CmdGetMissingValidators() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdGetUpgradeSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "upgrade-schedule",
+		Short:   "Query for the scheduled height and estimated time of a pending upgrade",
+		Args:    cobra.NoArgs,
+		Example: "upgrade-schedule",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.GetUpgradeSchedule(cmd.Context(), &types.QueryGetUpgradeScheduleRequest{})
+			if err != nil {
+				return err
+			}
+
+			if resp.Upgrade == nil {
+				return clientCtx.PrintString("No upgrade is pending.\n")
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	// FlagValidator is the flag used to filter the signal history by validator.
+	FlagValidator = "validator"
+	// FlagVersion is the flag used to filter the signal history by version.
+	FlagVersion = "version"
+)
+
+func CmdSignalHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "history",
+		Short:   "Query for the history of validator signals",
+		Long:    "Query for the history of validator signals including the height and time of each signal, the version the validator switched from and the tally after the signal.",
+		Args:    cobra.NoArgs,
+		Example: "history --validator celestiavaloper1... --version 5",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator, err := cmd.Flags().GetString(FlagValidator)
+			if err != nil {
+				return err
+			}
+
+			version, err := cmd.Flags().GetUint64(FlagVersion)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.SignalHistory(cmd.Context(), &types.QuerySignalHistoryRequest{
+				ValidatorAddress: validator,
+				Version:          version,
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().String(FlagValidator, "", "Only return signals from this validator operator address")
+	cmd.Flags().Uint64(FlagVersion, 0, "Only return signals for this version (0 for all versions)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "history")
+	return cmd
+}
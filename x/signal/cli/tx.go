@@ -27,11 +27,25 @@ func GetTxCmd() *cobra.Command {
 	return cmd
 }
 
+const (
+	// FlagMinUpgradeHeight is the flag used to signal the earliest preferred
+	// upgrade height.
+	FlagMinUpgradeHeight = "min-upgrade-height"
+	// FlagMaxUpgradeHeight is the flag used to signal the latest preferred
+	// upgrade height.
+	FlagMaxUpgradeHeight = "max-upgrade-height"
+)
+
 func CmdSignalVersion() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "signal version",
 		Short: "Signal a software upgrade for the specified version",
-		Args:  cobra.ExactArgs(1),
+		Long: `This command will submit a SignalVersion message for the
+specified version. A validator may optionally signal a preferred window of
+heights in which the upgrade should activate using the --min-upgrade-height and
+--max-upgrade-height flags.
+`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -43,13 +57,25 @@ func CmdSignalVersion() *cobra.Command {
 				return err
 			}
 
+			minUpgradeHeight, err := cmd.Flags().GetInt64(FlagMinUpgradeHeight)
+			if err != nil {
+				return err
+			}
+
+			maxUpgradeHeight, err := cmd.Flags().GetInt64(FlagMaxUpgradeHeight)
+			if err != nil {
+				return err
+			}
+
 			addr := clientCtx.GetFromAddress().Bytes()
 			valAddr := sdk.ValAddress(addr)
-			msg := types.NewMsgSignalVersion(valAddr.String(), version)
+			msg := types.NewMsgSignalVersionWithWindow(valAddr.String(), version, minUpgradeHeight, maxUpgradeHeight)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Int64(FlagMinUpgradeHeight, 0, "Earliest height at which the upgrade should activate (0 for no preference)")
+	cmd.Flags().Int64(FlagMaxUpgradeHeight, 0, "Latest height at which the upgrade should activate (0 for no preference)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -0,0 +1,182 @@
+package signal
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/celestiaorg/celestia-app/v10/x/signal/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recordSignal appends a record of a validator signalling for version to the
+// signal history, replacing the validator's previous record for version. It
+// must be called after the signal has been stored so that the recorded tally
+// includes it.
+func (k Keeper) recordSignal(ctx sdk.Context, valAddress sdk.ValAddress, version, previousVersion uint64) (types.SignalRecord, error) {
+	if err := k.removeValidatorRecords(ctx, valAddress, version); err != nil {
+		return types.SignalRecord{}, err
+	}
+
+	power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddress)
+	if err != nil {
+		return types.SignalRecord{}, err
+	}
+
+	tally, err := k.versionVotingPower(ctx, version)
+	if err != nil {
+		return types.SignalRecord{}, err
+	}
+
+	record := types.SignalRecord{
+		ValidatorAddress: valAddress.String(),
+		Version:          version,
+		PreviousVersion:  previousVersion,
+		Height:           ctx.BlockHeight(),
+		Time:             ctx.BlockTime(),
+		VotingPower:      power,
+		TallyVotingPower: tally.Uint64(),
+	}
+
+	sequence, err := k.recordSequence.Next(ctx)
+	if err != nil {
+		return types.SignalRecord{}, err
+	}
+	if err := k.records.Set(ctx, sequence, record); err != nil {
+		return types.SignalRecord{}, err
+	}
+	if err := k.validatorRecords.Set(ctx, collections.Join([]byte(valAddress), sequence)); err != nil {
+		return types.SignalRecord{}, err
+	}
+	return record, nil
+}
+
+// removeValidatorRecords deletes the records of the validator for version.
+func (k Keeper) removeValidatorRecords(ctx sdk.Context, valAddress sdk.ValAddress, version uint64) error {
+	iterator, err := k.validatorRecords.Iterate(ctx, collections.NewPrefixedPairRange[[]byte, uint64](valAddress))
+	if err != nil {
+		return err
+	}
+	keys, err := iterator.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		record, err := k.records.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if record.Version != version {
+			continue
+		}
+		if err := k.records.Remove(ctx, key.K2()); err != nil {
+			return err
+		}
+		if err := k.validatorRecords.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PruneSignalHistory deletes the records of signals for versions below
+// appVersion. It is called after an upgrade to appVersion because those
+// signals can no longer take effect. The records of signals for appVersion are
+// kept until the next upgrade.
+func (k Keeper) PruneSignalHistory(ctx sdk.Context, appVersion uint64) error {
+	iterator, err := k.records.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	records, err := iterator.KeyValues()
+	if err != nil {
+		return err
+	}
+	for _, kv := range records {
+		if kv.Value.Version >= appVersion {
+			continue
+		}
+		valAddress, err := sdk.ValAddressFromBech32(kv.Value.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.records.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+		if err := k.validatorRecords.Remove(ctx, collections.Join([]byte(valAddress), kv.Key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SignalHistory returns the history of validator signals, optionally filtered
+// by validator and version.
+func (k Keeper) SignalHistory(ctx context.Context, req *types.QuerySignalHistoryRequest) (*types.QuerySignalHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pagination := req.Pagination
+	if pagination == nil {
+		pagination = &query.PageRequest{Limit: types.MaxPaginationLimit}
+	} else if pagination.Limit == 0 || pagination.Limit > types.MaxPaginationLimit {
+		pagination = &query.PageRequest{
+			Key:        pagination.Key,
+			Offset:     pagination.Offset,
+			Limit:      types.MaxPaginationLimit,
+			CountTotal: pagination.CountTotal,
+			Reverse:    pagination.Reverse,
+		}
+	}
+
+	matchesVersion := func(record types.SignalRecord) bool {
+		return req.Version == 0 || record.Version == req.Version
+	}
+
+	if req.ValidatorAddress == "" {
+		records, pageRes, err := query.CollectionFilteredPaginate(
+			ctx,
+			k.records,
+			pagination,
+			func(_ uint64, record types.SignalRecord) (bool, error) {
+				return matchesVersion(record), nil
+			},
+			func(_ uint64, record types.SignalRecord) (types.SignalRecord, error) {
+				return record, nil
+			},
+		)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &types.QuerySignalHistoryResponse{Records: records, Pagination: pageRes}, nil
+	}
+
+	valAddress, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	records, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		k.validatorRecords,
+		pagination,
+		func(key collections.Pair[[]byte, uint64], _ collections.NoValue) (bool, error) {
+			record, err := k.records.Get(ctx, key.K2())
+			if err != nil {
+				return false, err
+			}
+			return matchesVersion(record), nil
+		},
+		func(key collections.Pair[[]byte, uint64], _ collections.NoValue) (types.SignalRecord, error) {
+			return k.records.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[[]byte, uint64](valAddress),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySignalHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
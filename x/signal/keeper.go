@@ -5,13 +5,18 @@ import (
 	"context"
 	"encoding/binary"
 	"errors"
+	"time"
 
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v10/x/signal/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -22,6 +27,10 @@ var (
 
 	// defaultSignalThreshold is 5/6 or approximately 83.33%
 	defaultSignalThreshold = math.LegacyNewDec(5).Quo(math.LegacyNewDec(6))
+
+	// expectedBlockTime is the block time used to estimate when a scheduled
+	// upgrade height will be reached.
+	expectedBlockTime = appconsts.DelayedPrecommitTimeout + appconsts.TimeoutCommit
 )
 
 // Threshold is the fraction of voting power that is required
@@ -44,21 +53,56 @@ type Keeper struct {
 	// stakingKeeper is used to fetch validators to calculate the total power
 	// signalled to a version.
 	stakingKeeper StakingKeeper
+
+	// authority is the address allowed to cancel a pending upgrade. It is
+	// expected to be the governance module account.
+	authority string
+
+	// records is the history of validator signals keyed by sequence number.
+	records collections.Map[uint64, types.SignalRecord]
+
+	// recordSequence numbers signal records in the order they were recorded.
+	recordSequence collections.Sequence
+
+	// validatorRecords indexes the sequence numbers of each validator's
+	// signal records.
+	validatorRecords collections.KeySet[collections.Pair[[]byte, uint64]]
 }
 
 // NewKeeper returns a signal keeper.
 func NewKeeper(
 	binaryCodec codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
+	historyStoreService corestore.KVStoreService,
 	stakingKeeper StakingKeeper,
+	authority string,
 ) Keeper {
+	sb := collections.NewSchemaBuilder(historyStoreService)
+
+	records := collections.NewMap(sb, types.SignalRecordsPrefix, "signal_records", collections.Uint64Key, codec.CollValue[types.SignalRecord](binaryCodec))
+	recordSequence := collections.NewSequence(sb, types.SignalRecordSequencePrefix, "signal_record_sequence")
+	validatorRecords := collections.NewKeySet(sb, types.ValidatorSignalRecordsPrefix, "validator_signal_records", collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key))
+
+	if _, err := sb.Build(); err != nil {
+		panic(err)
+	}
+
 	return Keeper{
-		binaryCodec:   binaryCodec,
-		storeKey:      storeKey,
-		stakingKeeper: stakingKeeper,
+		binaryCodec:      binaryCodec,
+		storeKey:         storeKey,
+		stakingKeeper:    stakingKeeper,
+		authority:        authority,
+		records:          records,
+		recordSequence:   recordSequence,
+		validatorRecords: validatorRecords,
 	}
 }
 
+// GetAuthority returns the signal module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SignalVersion is a method required by the MsgServer interface.
 func (k Keeper) SignalVersion(ctx context.Context, req *types.MsgSignalVersion) (*types.MsgSignalVersionResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -77,12 +121,23 @@ func (k Keeper) SignalVersion(ctx context.Context, req *types.MsgSignalVersion)
 		return nil, types.ErrInvalidSignalVersion.Wrapf("signalled version %d, current version %d", req.Version, currentVersion)
 	}
 
+	window := UpgradeWindow{MinHeight: req.MinUpgradeHeight, MaxHeight: req.MaxUpgradeHeight}
+	if window.MaxHeight != 0 && window.MaxHeight < sdkCtx.BlockHeight() {
+		return nil, types.ErrInvalidUpgradeWindow.Wrapf("max upgrade height %d is less than the current height %d", window.MaxHeight, sdkCtx.BlockHeight())
+	}
+
 	_, err = k.stakingKeeper.GetValidator(sdkCtx, valAddr)
 	if err != nil {
 		return nil, err
 	}
 
-	k.SetValidatorVersion(sdkCtx, valAddr, req.Version)
+	previousVersion := k.GetValidatorVersion(sdkCtx, valAddr)
+	k.SetValidatorSignal(sdkCtx, valAddr, req.Version, window)
+
+	record, err := k.recordSignal(sdkCtx, valAddr, req.Version, previousVersion)
+	if err != nil {
+		return nil, err
+	}
 
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -92,6 +147,10 @@ func (k Keeper) SignalVersion(ctx context.Context, req *types.MsgSignalVersion)
 		),
 	)
 
+	if err := sdkCtx.EventManager().EmitTypedEvent(types.NewSignalVersionEvent(record)); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgSignalVersionResponse{}, nil
 }
 
@@ -122,11 +181,19 @@ func (k *Keeper) TryUpgrade(ctx context.Context, req *types.MsgTryUpgrade) (*typ
 			return &types.MsgTryUpgradeResponse{}, types.ErrInvalidUpgradeVersion.Wrapf("can not upgrade to version %v because it is less than or equal to current version %v", version, appVersion)
 		}
 		header := sdkCtx.HeaderInfo()
+		upgradeHeight, err := k.ScheduleUpgradeHeight(sdkCtx, version, header.Height+appconsts.GetUpgradeHeightDelay(header.ChainID))
+		if err != nil {
+			return nil, err
+		}
 		upgrade := types.Upgrade{
 			AppVersion:    version,
-			UpgradeHeight: header.Height + appconsts.GetUpgradeHeightDelay(header.ChainID),
+			UpgradeHeight: upgradeHeight,
 		}
 		k.setUpgrade(sdkCtx, upgrade)
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(types.NewUpgradeScheduledEvent(upgrade)); err != nil {
+			return nil, err
+		}
 	}
 
 	sdkCtx.EventManager().EmitEvent(
@@ -140,6 +207,36 @@ func (k *Keeper) TryUpgrade(ctx context.Context, req *types.MsgTryUpgrade) (*typ
 	return &types.MsgTryUpgradeResponse{}, nil
 }
 
+// CancelUpgrade is a method required by the MsgServer interface. It allows
+// the authority to remove a pending upgrade before its upgrade height has
+// been reached. The signals for the cancelled version are cleared so that the
+// next TryUpgrade does not immediately schedule the same upgrade again.
+func (k *Keeper) CancelUpgrade(ctx context.Context, req *types.MsgCancelUpgrade) (*types.MsgCancelUpgradeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Authority != k.authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority: expected: %s, got: %s", k.authority, req.Authority)
+	}
+
+	upgrade, ok := k.getUpgrade(sdkCtx)
+	if !ok {
+		return nil, types.ErrNoUpgradePending
+	}
+
+	if sdkCtx.BlockHeight() >= upgrade.UpgradeHeight {
+		return nil, types.ErrUpgradeHeightReached.Wrapf("upgrade height %d, current height %d", upgrade.UpgradeHeight, sdkCtx.BlockHeight())
+	}
+
+	k.deleteUpgrade(sdkCtx)
+	k.DeleteSignalsForVersion(sdkCtx, upgrade.AppVersion)
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(types.NewCancelUpgradeEvent(req.Authority, upgrade)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelUpgradeResponse{}, nil
+}
+
 // VersionTally enables a client to query for the tally of voting power has
 // signalled for a particular version.
 func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRequest) (*types.QueryVersionTallyResponse, error) {
@@ -148,23 +245,9 @@ func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRe
 	if err != nil {
 		return nil, err
 	}
-	currentVotingPower := math.NewInt(0)
-	store := sdkCtx.KVStore(k.storeKey)
-	iterator := store.Iterator(types.FirstSignalKey, nil)
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
-			continue
-		}
-		valAddress := sdk.ValAddress(iterator.Key())
-		power, err := k.stakingKeeper.GetLastValidatorPower(sdkCtx, valAddress)
-		if err != nil {
-			return nil, err
-		}
-		version := VersionFromBytes(iterator.Value())
-		if version == req.Version {
-			currentVotingPower = currentVotingPower.AddRaw(power)
-		}
+	currentVotingPower, err := k.versionVotingPower(sdkCtx, req.Version)
+	if err != nil {
+		return nil, err
 	}
 
 	threshold, err := k.GetVotingPowerThreshold(sdkCtx)
@@ -179,6 +262,28 @@ func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRe
 	}, nil
 }
 
+// versionVotingPower returns the voting power that has signalled for version.
+func (k Keeper) versionVotingPower(ctx sdk.Context, version uint64) (math.Int, error) {
+	votingPower := math.NewInt(0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.FirstSignalKey, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
+			continue
+		}
+		valAddress := sdk.ValAddress(iterator.Key())
+		power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddress)
+		if err != nil {
+			return math.ZeroInt(), err
+		}
+		if VersionFromBytes(iterator.Value()) == version {
+			votingPower = votingPower.AddRaw(power)
+		}
+	}
+	return votingPower, nil
+}
+
 // GetMissingValidators returns the validators that have not yet signalled for a particular version
 func (k Keeper) GetMissingValidators(ctx context.Context, req *types.QueryGetMissingValidatorsRequest) (*types.QueryGetMissingValidatorsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -227,18 +332,52 @@ func (k Keeper) GetMissingValidators(ctx context.Context, req *types.QueryGetMis
 	return &types.QueryGetMissingValidatorsResponse{MissingValidators: missingValidators}, nil
 }
 
+// GetValidatorVersion returns the version that a validator has signalled for.
+// It returns zero if the validator has not signalled.
+func (k Keeper) GetValidatorVersion(ctx sdk.Context, valAddress sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	return VersionFromBytes(store.Get(valAddress))
+}
+
 // SetValidatorVersion saves a signalled version for a validator.
 func (k Keeper) SetValidatorVersion(ctx sdk.Context, valAddress sdk.ValAddress, version uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(valAddress, VersionToBytes(version))
 }
 
+// SetValidatorSignal saves a signalled version for a validator along with the
+// validator's preferred upgrade window.
+func (k Keeper) SetValidatorSignal(ctx sdk.Context, valAddress sdk.ValAddress, version uint64, window UpgradeWindow) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(valAddress, SignalToBytes(version, window))
+}
+
 // DeleteValidatorVersion deletes a signalled version for a validator.
 func (k Keeper) DeleteValidatorVersion(ctx sdk.Context, valAddress sdk.ValAddress) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(valAddress)
 }
 
+// DeleteSignalsForVersion deletes the signals of all validators that
+// signalled for the provided version.
+func (k Keeper) DeleteSignalsForVersion(ctx sdk.Context, version uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.FirstSignalKey, nil)
+	defer iterator.Close()
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
+			continue
+		}
+		if VersionFromBytes(iterator.Value()) == version {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // TallyVotingPower tallies the voting power for each version and returns true
 // and the version if any version has reached the quorum in voting power.
 // Returns false and 0 otherwise.
@@ -331,13 +470,13 @@ func VersionToBytes(version uint64) []byte {
 
 func VersionFromBytes(version []byte) uint64 {
 	// Guard against malformed values that could land in the per-validator
-	// store via a future migration or hand-edited state. The canonical encoding
-	// (VersionToBytes) always produces 8 bytes; anything else is treated as
-	// "no recognised version".
-	if len(version) != 8 {
+	// store via a future migration or hand-edited state. The canonical
+	// encodings (VersionToBytes and SignalToBytes) always produce 8 or 24
+	// bytes; anything else is treated as "no recognised version".
+	if len(version) != 8 && len(version) != signalWithWindowLength {
 		return 0
 	}
-	return binary.BigEndian.Uint64(version)
+	return binary.BigEndian.Uint64(version[:8])
 }
 
 // GetUpgrade returns the current upgrade information.
@@ -349,6 +488,25 @@ func (k Keeper) GetUpgrade(ctx context.Context, _ *types.QueryGetUpgradeRequest)
 	return &types.QueryGetUpgradeResponse{Upgrade: &upgrade}, nil
 }
 
+// GetUpgradeSchedule returns the height at which the pending upgrade is
+// scheduled and an estimate of when that height will be reached.
+func (k Keeper) GetUpgradeSchedule(ctx context.Context, _ *types.QueryGetUpgradeScheduleRequest) (*types.QueryGetUpgradeScheduleResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	upgrade, ok := k.getUpgrade(sdkCtx)
+	if !ok {
+		return &types.QueryGetUpgradeScheduleResponse{CurrentHeight: sdkCtx.BlockHeight()}, nil
+	}
+
+	blocksRemaining := max(upgrade.UpgradeHeight-sdkCtx.BlockHeight(), 0)
+	estimatedTime := sdkCtx.BlockTime().Add(time.Duration(blocksRemaining) * expectedBlockTime)
+	return &types.QueryGetUpgradeScheduleResponse{
+		Upgrade:         &upgrade,
+		CurrentHeight:   sdkCtx.BlockHeight(),
+		BlocksRemaining: blocksRemaining,
+		EstimatedTime:   &estimatedTime,
+	}, nil
+}
+
 // IsUpgradePending returns true if an app version has reached quorum and the
 // chain should upgrade to the app version at the upgrade height. While the
 // keeper has an upgrade pending the SignalVersion and TryUpgrade messages will
@@ -377,3 +535,9 @@ func (k *Keeper) setUpgrade(ctx sdk.Context, upgrade types.Upgrade) {
 	value := k.binaryCodec.MustMarshal(&upgrade)
 	store.Set(types.UpgradeKey, value)
 }
+
+// deleteUpgrade removes the pending upgrade from the store.
+func (k *Keeper) deleteUpgrade(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.UpgradeKey)
+}
@@ -6,6 +6,7 @@ import (
 	"math"
 	"math/big"
 	"testing"
+	"time"
 
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
@@ -22,7 +23,12 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -97,7 +103,7 @@ func TestGetVotingPowerThreshold(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
 			stakingKeeper := newMockStakingKeeper(tc.validators)
-			k := signal.NewKeeper(config.Codec, nil, stakingKeeper)
+			k := signal.NewKeeper(config.Codec, nil, nil, stakingKeeper, authority)
 			got, err := k.GetVotingPowerThreshold(sdk.Context{})
 			assert.NoError(t, err)
 			assert.Equal(t, tc.want, got, fmt.Sprintf("want %v, got %v", tc.want.String(), got.String()))
@@ -157,7 +163,8 @@ func TestSignalVersion(t *testing.T) {
 		require.NoError(t, err)
 
 		events := ctx.EventManager().Events()
-		require.Len(t, events, 1)
+		require.Len(t, events, 2)
+		require.Equal(t, "celestia.signal.v1.EventSignalVersion", events[1].Type)
 
 		event := events[0]
 		require.Equal(t, types.EventTypeSignalVersion, event.Type)
@@ -573,11 +580,318 @@ func TestTallyAfterTryUpgrade(t *testing.T) {
 	require.EqualValues(t, 120, res.TotalVotingPower)
 }
 
+func TestSignalVersionWithUpgradeWindow(t *testing.T) {
+	t.Run("should reject a window that has already passed", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		ctx = ctx.WithBlockHeight(10)
+
+		_, err := upgradeKeeper.SignalVersion(ctx, types.NewMsgSignalVersionWithWindow(testutil.ValAddrs[0].String(), 2, 0, 5))
+		require.ErrorIs(t, err, types.ErrInvalidUpgradeWindow)
+	})
+
+	t.Run("should count a signal with a window towards the tally", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+
+		_, err := upgradeKeeper.SignalVersion(ctx, types.NewMsgSignalVersionWithWindow(testutil.ValAddrs[0].String(), 2, 10, 20))
+		require.NoError(t, err)
+
+		resp, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 2})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(40), resp.VotingPower)
+	})
+}
+
+func TestMsgSignalVersionValidateBasic(t *testing.T) {
+	valAddr := testutil.ValAddrs[0].String()
+	tests := []struct {
+		name    string
+		msg     *types.MsgSignalVersion
+		wantErr error
+	}{
+		{"no window", types.NewMsgSignalVersion(valAddr, 2), nil},
+		{"open ended window", types.NewMsgSignalVersionWithWindow(valAddr, 2, 10, 0), nil},
+		{"bounded window", types.NewMsgSignalVersionWithWindow(valAddr, 2, 10, 20), nil},
+		{"negative min height", types.NewMsgSignalVersionWithWindow(valAddr, 2, -1, 0), types.ErrInvalidUpgradeWindow},
+		{"max before min", types.NewMsgSignalVersionWithWindow(valAddr, 2, 20, 10), types.ErrInvalidUpgradeWindow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestScheduleUpgradeHeight(t *testing.T) {
+	delay := appconsts.GetUpgradeHeightDelay(appconsts.TestChainID)
+	earliest := delay
+	beyondMaxDelay := earliest + delay + 1
+
+	type signal struct {
+		validator int
+		min, max  int64
+	}
+	tests := []struct {
+		name    string
+		signals []signal
+		want    int64
+	}{
+		{
+			name:    "no preferences schedules the earliest height",
+			signals: []signal{{0, 0, 0}, {1, 0, 0}, {2, 0, 0}, {3, 0, 0}},
+			want:    earliest,
+		},
+		{
+			name:    "preference with threshold support is honoured",
+			signals: []signal{{0, earliest + 2, earliest + 3}, {1, 0, 0}, {2, earliest + 2, 0}, {3, earliest + 1, earliest + 2}},
+			want:    earliest + 2,
+		},
+		{
+			name:    "preference without threshold support is ignored",
+			signals: []signal{{0, earliest + 2, 0}, {1, 0, 0}, {2, earliest + 2, 0}, {3, 0, 0}},
+			want:    earliest,
+		},
+		{
+			name:    "lowest height with threshold support wins",
+			signals: []signal{{0, earliest + 1, 0}, {1, earliest + 1, 0}, {2, earliest + 1, earliest + 3}, {3, earliest + 2, earliest + 3}},
+			want:    earliest + 1,
+		},
+		{
+			name:    "preferences beyond the maximum delay are ignored",
+			signals: []signal{{0, beyondMaxDelay, 0}, {1, beyondMaxDelay, 0}, {2, beyondMaxDelay, 0}, {3, beyondMaxDelay, 0}},
+			want:    earliest,
+		},
+		{
+			name:    "a single low power validator cannot delay the upgrade",
+			signals: []signal{{0, 0, 0}, {1, earliest + 1, 0}, {2, 0, 0}, {3, 0, 0}},
+			want:    earliest,
+		},
+		{
+			name:    "a single low power validator cannot delay the upgrade indefinitely",
+			signals: []signal{{0, 0, 0}, {1, 1 << 40, 0}, {2, 0, 0}, {3, 0, 0}},
+			want:    earliest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upgradeKeeper, ctx, _ := setup(t)
+			for _, s := range tt.signals {
+				_, err := upgradeKeeper.SignalVersion(ctx, types.NewMsgSignalVersionWithWindow(testutil.ValAddrs[s.validator].String(), 2, s.min, s.max))
+				require.NoError(t, err)
+			}
+
+			_, err := upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+			require.NoError(t, err)
+
+			got, err := upgradeKeeper.GetUpgrade(ctx, &types.QueryGetUpgradeRequest{})
+			require.NoError(t, err)
+			require.NotNil(t, got.Upgrade)
+			assert.Equal(t, tt.want, got.Upgrade.UpgradeHeight)
+		})
+	}
+}
+
+func TestCancelUpgrade(t *testing.T) {
+	signalQuorum := func(t *testing.T, upgradeKeeper signal.Keeper, ctx sdk.Context) {
+		for _, valAddr := range testutil.ValAddrs[:4] {
+			_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: valAddr.String(), Version: 2})
+			require.NoError(t, err)
+		}
+		_, err := upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+		require.NoError(t, err)
+		require.True(t, upgradeKeeper.IsUpgradePending(ctx))
+	}
+
+	t.Run("should reject a non-authority signer", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		signalQuorum(t, upgradeKeeper, ctx)
+
+		_, err := upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(testutil.ValAddrs[0].String()))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+		assert.True(t, upgradeKeeper.IsUpgradePending(ctx))
+	})
+
+	t.Run("should return an error if no upgrade is pending", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+
+		_, err := upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(authority))
+		require.ErrorIs(t, err, types.ErrNoUpgradePending)
+	})
+
+	t.Run("should return an error if the upgrade height has been reached", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		signalQuorum(t, upgradeKeeper, ctx)
+
+		ctx = ctx.WithBlockHeight(appconsts.GetUpgradeHeightDelay(appconsts.TestChainID))
+		_, err := upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(authority))
+		require.ErrorIs(t, err, types.ErrUpgradeHeightReached)
+	})
+
+	t.Run("should clear the pending upgrade and its signals", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		signalQuorum(t, upgradeKeeper, ctx)
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		_, err := upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(authority))
+		require.NoError(t, err)
+		assert.False(t, upgradeKeeper.IsUpgradePending(ctx))
+
+		resp, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 2})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), resp.VotingPower)
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 1)
+		require.Equal(t, "celestia.signal.v1.EventCancelUpgrade", events[0].Type)
+
+		// Validators may signal again once the upgrade has been cancelled.
+		_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 2})
+		require.NoError(t, err)
+	})
+}
+
+func TestGetUpgradeSchedule(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(blockTime)
+
+	got, err := upgradeKeeper.GetUpgradeSchedule(ctx, &types.QueryGetUpgradeScheduleRequest{})
+	require.NoError(t, err)
+	assert.Nil(t, got.Upgrade)
+	assert.Nil(t, got.EstimatedTime)
+
+	for _, valAddr := range testutil.ValAddrs[:4] {
+		_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: valAddr.String(), Version: 2})
+		require.NoError(t, err)
+	}
+	_, err = upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+	require.NoError(t, err)
+
+	got, err = upgradeKeeper.GetUpgradeSchedule(ctx, &types.QueryGetUpgradeScheduleRequest{})
+	require.NoError(t, err)
+	require.NotNil(t, got.Upgrade)
+	delay := appconsts.GetUpgradeHeightDelay(appconsts.TestChainID)
+	assert.Equal(t, delay, got.BlocksRemaining)
+	require.NotNil(t, got.EstimatedTime)
+	assert.True(t, got.EstimatedTime.After(blockTime))
+}
+
+func TestSignalHistory(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	signalAt := func(height int64, validator int, version uint64) {
+		ctx := ctx.WithBlockHeight(height).WithBlockTime(blockTime.Add(time.Duration(height) * time.Minute))
+		_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[validator].String(), Version: version})
+		require.NoError(t, err)
+	}
+	signalAt(1, 0, 2)
+	signalAt(2, 1, 2)
+	signalAt(3, 0, 3)
+	signalAt(4, 2, 3)
+
+	t.Run("should return all records in order", func(t *testing.T) {
+		resp, err := upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Records, 4)
+
+		first := resp.Records[0]
+		assert.Equal(t, testutil.ValAddrs[0].String(), first.ValidatorAddress)
+		assert.Equal(t, uint64(2), first.Version)
+		assert.Equal(t, uint64(0), first.PreviousVersion)
+		assert.Equal(t, int64(1), first.Height)
+		assert.Equal(t, blockTime.Add(time.Minute), first.Time)
+		assert.Equal(t, int64(40), first.VotingPower)
+		assert.Equal(t, uint64(40), first.TallyVotingPower)
+
+		assert.Equal(t, uint64(41), resp.Records[1].TallyVotingPower)
+
+		switched := resp.Records[2]
+		assert.Equal(t, uint64(3), switched.Version)
+		assert.Equal(t, uint64(2), switched.PreviousVersion)
+		assert.Equal(t, uint64(40), switched.TallyVotingPower)
+
+		assert.Equal(t, uint64(99), resp.Records[3].TallyVotingPower)
+	})
+
+	t.Run("should filter by validator", func(t *testing.T) {
+		resp, err := upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{ValidatorAddress: testutil.ValAddrs[0].String()})
+		require.NoError(t, err)
+		require.Len(t, resp.Records, 2)
+		assert.Equal(t, int64(1), resp.Records[0].Height)
+		assert.Equal(t, int64(3), resp.Records[1].Height)
+	})
+
+	t.Run("should filter by version", func(t *testing.T) {
+		resp, err := upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{Version: 3})
+		require.NoError(t, err)
+		require.Len(t, resp.Records, 2)
+		for _, record := range resp.Records {
+			assert.Equal(t, uint64(3), record.Version)
+		}
+	})
+
+	t.Run("should paginate", func(t *testing.T) {
+		resp, err := upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{Pagination: &query.PageRequest{Limit: 3}})
+		require.NoError(t, err)
+		require.Len(t, resp.Records, 3)
+		require.NotNil(t, resp.Pagination.NextKey)
+
+		resp, err = upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{Pagination: &query.PageRequest{Key: resp.Pagination.NextKey}})
+		require.NoError(t, err)
+		require.Len(t, resp.Records, 1)
+		assert.Equal(t, int64(4), resp.Records[0].Height)
+	})
+
+	t.Run("should survive a tally reset", func(t *testing.T) {
+		upgradeKeeper.ResetTally(ctx)
+
+		resp, err := upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Records, 4)
+	})
+
+	t.Run("should keep the latest record of a validator for a version", func(t *testing.T) {
+		signalAt(5, 1, 3)
+		signalAt(6, 1, 3)
+
+		resp, err := upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{ValidatorAddress: testutil.ValAddrs[1].String()})
+		require.NoError(t, err)
+		require.Len(t, resp.Records, 2)
+		assert.Equal(t, uint64(2), resp.Records[0].Version)
+		assert.Equal(t, uint64(3), resp.Records[1].Version)
+		assert.Equal(t, int64(6), resp.Records[1].Height)
+		assert.Equal(t, uint64(3), resp.Records[1].PreviousVersion)
+	})
+
+	t.Run("should prune the records of versions below the app version", func(t *testing.T) {
+		require.NoError(t, upgradeKeeper.PruneSignalHistory(ctx, 3))
+
+		resp, err := upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Records, 3)
+		for _, record := range resp.Records {
+			assert.Equal(t, uint64(3), record.Version)
+		}
+
+		resp, err = upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{ValidatorAddress: testutil.ValAddrs[1].String()})
+		require.NoError(t, err)
+		require.Len(t, resp.Records, 1)
+		assert.Equal(t, int64(6), resp.Records[0].Height)
+	})
+}
+
 func setup(t *testing.T) (signal.Keeper, sdk.Context, *mockStakingKeeper) {
 	signalStore := storetypes.NewKVStoreKey(types.StoreKey)
+	historyStore := storetypes.NewKVStoreKey(types.HistoryStoreKey)
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NoOpMetrics{})
 	stateStore.MountStoreWithDB(signalStore, storetypes.StoreTypeIAVL, nil)
+	stateStore.MountStoreWithDB(historyStore, storetypes.StoreTypeIAVL, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 	mockCtx := sdk.NewContext(stateStore, tmproto.Header{
 		Version: cmtversion.Consensus{
@@ -596,10 +910,12 @@ func setup(t *testing.T) (signal.Keeper, sdk.Context, *mockStakingKeeper) {
 		},
 	)
 	config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
-	upgradeKeeper := signal.NewKeeper(config.Codec, signalStore, mockStakingKeeper)
+	upgradeKeeper := signal.NewKeeper(config.Codec, signalStore, runtime.NewKVStoreService(historyStore), mockStakingKeeper, authority)
 	return upgradeKeeper, mockCtx, mockStakingKeeper
 }
 
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
 var _ signal.StakingKeeper = (*mockStakingKeeper)(nil)
 
 type mockStakingKeeper struct {
@@ -0,0 +1,875 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/signal/v1/event.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventSignalVersion is emitted when a validator signals for a version.
+type EventSignalVersion struct {
+	// ValidatorAddress is the address of the validator that signalled.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Version is the version that the validator signalled for.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// PreviousVersion is the version that the validator had previously
+	// signalled for. It is zero if the validator had not signalled.
+	PreviousVersion uint64 `protobuf:"varint,3,opt,name=previous_version,json=previousVersion,proto3" json:"previous_version,omitempty"`
+	// TallyVotingPower is the total voting power that has signalled for Version
+	// including this signal.
+	TallyVotingPower uint64 `protobuf:"varint,4,opt,name=tally_voting_power,json=tallyVotingPower,proto3" json:"tally_voting_power,omitempty"`
+}
+
+func (m *EventSignalVersion) Reset()         { *m = EventSignalVersion{} }
+func (m *EventSignalVersion) String() string { return proto.CompactTextString(m) }
+func (*EventSignalVersion) ProtoMessage()    {}
+func (*EventSignalVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5279dccee4b47f5, []int{0}
+}
+func (m *EventSignalVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSignalVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSignalVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSignalVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSignalVersion.Merge(m, src)
+}
+func (m *EventSignalVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSignalVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSignalVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSignalVersion proto.InternalMessageInfo
+
+func (m *EventSignalVersion) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *EventSignalVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *EventSignalVersion) GetPreviousVersion() uint64 {
+	if m != nil {
+		return m.PreviousVersion
+	}
+	return 0
+}
+
+func (m *EventSignalVersion) GetTallyVotingPower() uint64 {
+	if m != nil {
+		return m.TallyVotingPower
+	}
+	return 0
+}
+
+// EventUpgradeScheduled is emitted when a version reaches quorum and an
+// upgrade is scheduled.
+type EventUpgradeScheduled struct {
+	// AppVersion is the app version that the network will upgrade to.
+	AppVersion uint64 `protobuf:"varint,1,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// UpgradeHeight is the height at which the network will upgrade.
+	UpgradeHeight int64 `protobuf:"varint,2,opt,name=upgrade_height,json=upgradeHeight,proto3" json:"upgrade_height,omitempty"`
+}
+
+func (m *EventUpgradeScheduled) Reset()         { *m = EventUpgradeScheduled{} }
+func (m *EventUpgradeScheduled) String() string { return proto.CompactTextString(m) }
+func (*EventUpgradeScheduled) ProtoMessage()    {}
+func (*EventUpgradeScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5279dccee4b47f5, []int{1}
+}
+func (m *EventUpgradeScheduled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpgradeScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpgradeScheduled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpgradeScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpgradeScheduled.Merge(m, src)
+}
+func (m *EventUpgradeScheduled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpgradeScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpgradeScheduled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpgradeScheduled proto.InternalMessageInfo
+
+func (m *EventUpgradeScheduled) GetAppVersion() uint64 {
+	if m != nil {
+		return m.AppVersion
+	}
+	return 0
+}
+
+func (m *EventUpgradeScheduled) GetUpgradeHeight() int64 {
+	if m != nil {
+		return m.UpgradeHeight
+	}
+	return 0
+}
+
+// EventCancelUpgrade is emitted when governance cancels a pending upgrade.
+type EventCancelUpgrade struct {
+	// Authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// AppVersion is the app version of the cancelled upgrade.
+	AppVersion uint64 `protobuf:"varint,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// UpgradeHeight is the height at which the cancelled upgrade was scheduled.
+	UpgradeHeight int64 `protobuf:"varint,3,opt,name=upgrade_height,json=upgradeHeight,proto3" json:"upgrade_height,omitempty"`
+}
+
+func (m *EventCancelUpgrade) Reset()         { *m = EventCancelUpgrade{} }
+func (m *EventCancelUpgrade) String() string { return proto.CompactTextString(m) }
+func (*EventCancelUpgrade) ProtoMessage()    {}
+func (*EventCancelUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5279dccee4b47f5, []int{2}
+}
+func (m *EventCancelUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelUpgrade.Merge(m, src)
+}
+func (m *EventCancelUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelUpgrade proto.InternalMessageInfo
+
+func (m *EventCancelUpgrade) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *EventCancelUpgrade) GetAppVersion() uint64 {
+	if m != nil {
+		return m.AppVersion
+	}
+	return 0
+}
+
+func (m *EventCancelUpgrade) GetUpgradeHeight() int64 {
+	if m != nil {
+		return m.UpgradeHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventSignalVersion)(nil), "celestia.signal.v1.EventSignalVersion")
+	proto.RegisterType((*EventUpgradeScheduled)(nil), "celestia.signal.v1.EventUpgradeScheduled")
+	proto.RegisterType((*EventCancelUpgrade)(nil), "celestia.signal.v1.EventCancelUpgrade")
+}
+
+func init() { proto.RegisterFile("celestia/signal/v1/event.proto", fileDescriptor_e5279dccee4b47f5) }
+
+var fileDescriptor_e5279dccee4b47f5 = []byte{
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
+	0x18, 0xc7, 0x97, 0x6d, 0x28, 0x8b, 0xa8, 0x33, 0x20, 0xf4, 0x20, 0x75, 0x0c, 0x84, 0x89, 0xda,
+	0x32, 0x7d, 0x02, 0x15, 0xc1, 0x83, 0x07, 0xd9, 0x70, 0x07, 0x2f, 0x25, 0x6b, 0x3f, 0xda, 0x40,
+	0x6d, 0x42, 0x92, 0x46, 0xe7, 0x53, 0xf8, 0x30, 0x3e, 0x84, 0xc7, 0x1d, 0x3d, 0xca, 0xf6, 0x22,
+	0xb2, 0xb4, 0x99, 0x20, 0x1e, 0xbc, 0xb5, 0xbf, 0xff, 0xff, 0xe3, 0x17, 0xbe, 0x0f, 0xfb, 0x31,
+	0xe4, 0xa0, 0x34, 0xa3, 0xa1, 0x62, 0x69, 0x41, 0xf3, 0xd0, 0x0c, 0x43, 0x30, 0x50, 0xe8, 0x40,
+	0x48, 0xae, 0x39, 0x21, 0x2e, 0x0f, 0xaa, 0x3c, 0x30, 0xc3, 0xfe, 0x3b, 0xc2, 0xe4, 0x66, 0xd5,
+	0x19, 0x5b, 0x34, 0x01, 0xa9, 0x18, 0x2f, 0xc8, 0x09, 0xde, 0x33, 0x34, 0x67, 0x09, 0xd5, 0x5c,
+	0x46, 0x34, 0x49, 0x24, 0x28, 0xe5, 0xa1, 0x1e, 0x1a, 0x74, 0x46, 0xdd, 0x75, 0x70, 0x59, 0x71,
+	0xe2, 0xe1, 0x4d, 0x53, 0xcd, 0x79, 0xcd, 0x1e, 0x1a, 0xb4, 0x47, 0xee, 0x97, 0x1c, 0xe3, 0xae,
+	0x90, 0x60, 0x18, 0x2f, 0x55, 0xe4, 0x2a, 0x2d, 0x5b, 0xd9, 0x75, 0xdc, 0x19, 0x4f, 0x31, 0xd1,
+	0x34, 0xcf, 0x67, 0x91, 0xe1, 0x9a, 0x15, 0x69, 0x24, 0xf8, 0x33, 0x48, 0xaf, 0x6d, 0xcb, 0x5d,
+	0x9b, 0x4c, 0x6c, 0x70, 0xbf, 0xe2, 0xfd, 0x08, 0xef, 0xdb, 0x57, 0x3f, 0x88, 0x54, 0xd2, 0x04,
+	0xc6, 0x71, 0x06, 0x49, 0x99, 0x43, 0x42, 0x0e, 0xf1, 0x16, 0x15, 0x62, 0x2d, 0x43, 0x76, 0x1e,
+	0x53, 0x21, 0x9c, 0xe7, 0x08, 0xef, 0x94, 0xd5, 0x50, 0x94, 0x01, 0x4b, 0x33, 0x6d, 0xdf, 0xdc,
+	0x1a, 0x6d, 0xd7, 0xf4, 0xd6, 0xc2, 0xfe, 0x6b, 0xbd, 0x96, 0x6b, 0x5a, 0xc4, 0x90, 0xd7, 0x1a,
+	0x72, 0x80, 0x3b, 0xb4, 0xd4, 0x19, 0x97, 0x4c, 0xcf, 0xea, 0x75, 0xfc, 0x80, 0xdf, 0xee, 0xe6,
+	0x3f, 0xdc, 0xad, 0x3f, 0xdc, 0x57, 0x77, 0x1f, 0x0b, 0x1f, 0xcd, 0x17, 0x3e, 0xfa, 0x5a, 0xf8,
+	0xe8, 0x6d, 0xe9, 0x37, 0xe6, 0x4b, 0xbf, 0xf1, 0xb9, 0xf4, 0x1b, 0x8f, 0xe7, 0x29, 0xd3, 0x59,
+	0x39, 0x0d, 0x62, 0xfe, 0x14, 0xba, 0x63, 0x72, 0x99, 0xae, 0xbf, 0xcf, 0xa8, 0x10, 0xe1, 0x8b,
+	0x3b, 0xbf, 0x9e, 0x09, 0x50, 0xd3, 0x0d, 0x7b, 0xfc, 0x8b, 0xef, 0x01, 0x00, 0xb0, 0xa7, 0x04,
+	0x7d, 0x1e, 0x02, 0x00, 0x00,
+}
+
+func (m *EventSignalVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSignalVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSignalVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TallyVotingPower != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.TallyVotingPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreviousVersion != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PreviousVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpgradeScheduled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpgradeScheduled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpgradeScheduled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpgradeHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.UpgradeHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AppVersion != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.AppVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpgradeHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.UpgradeHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppVersion != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.AppVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventSignalVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovEvent(uint64(m.Version))
+	}
+	if m.PreviousVersion != 0 {
+		n += 1 + sovEvent(uint64(m.PreviousVersion))
+	}
+	if m.TallyVotingPower != 0 {
+		n += 1 + sovEvent(uint64(m.TallyVotingPower))
+	}
+	return n
+}
+
+func (m *EventUpgradeScheduled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppVersion != 0 {
+		n += 1 + sovEvent(uint64(m.AppVersion))
+	}
+	if m.UpgradeHeight != 0 {
+		n += 1 + sovEvent(uint64(m.UpgradeHeight))
+	}
+	return n
+}
+
+func (m *EventCancelUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.AppVersion != 0 {
+		n += 1 + sovEvent(uint64(m.AppVersion))
+	}
+	if m.UpgradeHeight != 0 {
+		n += 1 + sovEvent(uint64(m.UpgradeHeight))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventSignalVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSignalVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSignalVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersion", wireType)
+			}
+			m.PreviousVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyVotingPower", wireType)
+			}
+			m.TallyVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TallyVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpgradeScheduled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpgradeScheduled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpgradeScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			m.AppVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeHeight", wireType)
+			}
+			m.UpgradeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			m.AppVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeHeight", wireType)
+			}
+			m.UpgradeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvent = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,28 @@
+package types
+
+// NewUpgradeScheduledEvent returns a new EventUpgradeScheduled.
+func NewUpgradeScheduledEvent(upgrade Upgrade) *EventUpgradeScheduled {
+	return &EventUpgradeScheduled{
+		AppVersion:    upgrade.AppVersion,
+		UpgradeHeight: upgrade.UpgradeHeight,
+	}
+}
+
+// NewCancelUpgradeEvent returns a new EventCancelUpgrade.
+func NewCancelUpgradeEvent(authority string, upgrade Upgrade) *EventCancelUpgrade {
+	return &EventCancelUpgrade{
+		Authority:     authority,
+		AppVersion:    upgrade.AppVersion,
+		UpgradeHeight: upgrade.UpgradeHeight,
+	}
+}
+
+// NewSignalVersionEvent returns a new EventSignalVersion.
+func NewSignalVersionEvent(record SignalRecord) *EventSignalVersion {
+	return &EventSignalVersion{
+		ValidatorAddress: record.ValidatorAddress,
+		Version:          record.Version,
+		PreviousVersion:  record.PreviousVersion,
+		TallyVotingPower: record.TallyVotingPower,
+	}
+}
@@ -1,5 +1,19 @@
 package types
 
+import "cosmossdk.io/collections"
+
+const (
+	// HistoryStoreKey is the key of the store used to persist the history of
+	// validator signals. It is kept separate from the signal store because
+	// the signal store is keyed by raw validator addresses and is cleared
+	// after every upgrade.
+	HistoryStoreKey = "signal_history"
+
+	// MaxPaginationLimit is the maximum number of items returned in a
+	// paginated query.
+	MaxPaginationLimit = 100
+)
+
 var (
 	// UpgradeKey is the key in the signal store used to persist an upgrade if one is
 	// pending.
@@ -9,4 +23,16 @@ var (
 	// the keys associated with signals from validators. In practice, this key
 	// isn't expected to be set or retrieved.
 	FirstSignalKey = []byte{0x000}
+
+	// SignalRecordsPrefix is the prefix in the history store for signal
+	// records keyed by their sequence number.
+	SignalRecordsPrefix = collections.NewPrefix(0)
+
+	// SignalRecordSequencePrefix is the prefix in the history store for the
+	// sequence used to number signal records.
+	SignalRecordSequencePrefix = collections.NewPrefix(1)
+
+	// ValidatorSignalRecordsPrefix is the prefix in the history store for the
+	// index from validator address to the sequence numbers of its records.
+	ValidatorSignalRecordsPrefix = collections.NewPrefix(2)
 )
@@ -12,6 +12,7 @@ const (
 
 	URLMsgSignalVersion = "/celestia.signal.v1.Msg/SignalVersion"
 	URLMsgTryUpgrade    = "/celestia.signal.v1.Msg/TryUpgrade"
+	URLMsgCancelUpgrade = "/celestia.signal.v1.Msg/CancelUpgrade"
 
 	EventTypeTryUpgrade    = "signal_try_upgrade"
 	EventTypeSignalVersion = "signal_version"
@@ -23,6 +24,7 @@ const (
 var (
 	_ sdk.Msg = &MsgSignalVersion{}
 	_ sdk.Msg = &MsgTryUpgrade{}
+	_ sdk.Msg = &MsgCancelUpgrade{}
 )
 
 var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
@@ -34,9 +36,28 @@ func NewMsgSignalVersion(valAddress string, version uint64) *MsgSignalVersion {
 	}
 }
 
+// NewMsgSignalVersionWithWindow returns a MsgSignalVersion that also signals
+// the validator's preferred upgrade height window.
+func NewMsgSignalVersionWithWindow(valAddress string, version uint64, minUpgradeHeight, maxUpgradeHeight int64) *MsgSignalVersion {
+	return &MsgSignalVersion{
+		ValidatorAddress: valAddress,
+		Version:          version,
+		MinUpgradeHeight: minUpgradeHeight,
+		MaxUpgradeHeight: maxUpgradeHeight,
+	}
+}
+
 func (msg *MsgSignalVersion) ValidateBasic() error {
-	_, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
-	return err
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return err
+	}
+	if msg.MinUpgradeHeight < 0 || msg.MaxUpgradeHeight < 0 {
+		return ErrInvalidUpgradeWindow.Wrapf("upgrade heights must not be negative: min %d, max %d", msg.MinUpgradeHeight, msg.MaxUpgradeHeight)
+	}
+	if msg.MaxUpgradeHeight != 0 && msg.MaxUpgradeHeight < msg.MinUpgradeHeight {
+		return ErrInvalidUpgradeWindow.Wrapf("max upgrade height %d is less than min upgrade height %d", msg.MaxUpgradeHeight, msg.MinUpgradeHeight)
+	}
+	return nil
 }
 
 func NewMsgTryUpgrade(signer sdk.AccAddress) *MsgTryUpgrade {
@@ -49,3 +70,14 @@ func (msg *MsgTryUpgrade) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.Signer)
 	return err
 }
+
+func NewMsgCancelUpgrade(authority string) *MsgCancelUpgrade {
+	return &MsgCancelUpgrade{
+		Authority: authority,
+	}
+}
+
+func (msg *MsgCancelUpgrade) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
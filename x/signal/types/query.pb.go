@@ -6,21 +6,28 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -307,6 +314,239 @@ func (m *QueryGetMissingValidatorsResponse) GetMissingValidators() []string {
 	return nil
 }
 
+// QueryGetUpgradeScheduleRequest is the request type for the GetUpgradeSchedule
+// query.
+type QueryGetUpgradeScheduleRequest struct {
+}
+
+func (m *QueryGetUpgradeScheduleRequest) Reset()         { *m = QueryGetUpgradeScheduleRequest{} }
+func (m *QueryGetUpgradeScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetUpgradeScheduleRequest) ProtoMessage()    {}
+func (*QueryGetUpgradeScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{6}
+}
+func (m *QueryGetUpgradeScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetUpgradeScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetUpgradeScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetUpgradeScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetUpgradeScheduleRequest.Merge(m, src)
+}
+func (m *QueryGetUpgradeScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetUpgradeScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetUpgradeScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetUpgradeScheduleRequest proto.InternalMessageInfo
+
+// QueryGetUpgradeScheduleResponse is the response type for the
+// GetUpgradeSchedule query.
+type QueryGetUpgradeScheduleResponse struct {
+	// Upgrade is the pending upgrade. It is nil if no upgrade is pending.
+	Upgrade *Upgrade `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	// CurrentHeight is the height at which the query was evaluated.
+	CurrentHeight int64 `protobuf:"varint,2,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+	// BlocksRemaining is the number of blocks until the upgrade height.
+	BlocksRemaining int64 `protobuf:"varint,3,opt,name=blocks_remaining,json=blocksRemaining,proto3" json:"blocks_remaining,omitempty"`
+	// EstimatedTime is the estimated time at which the upgrade height will be
+	// reached based on the expected block time.
+	EstimatedTime *time.Time `protobuf:"bytes,4,opt,name=estimated_time,json=estimatedTime,proto3,stdtime" json:"estimated_time,omitempty"`
+}
+
+func (m *QueryGetUpgradeScheduleResponse) Reset()         { *m = QueryGetUpgradeScheduleResponse{} }
+func (m *QueryGetUpgradeScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetUpgradeScheduleResponse) ProtoMessage()    {}
+func (*QueryGetUpgradeScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{7}
+}
+func (m *QueryGetUpgradeScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetUpgradeScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetUpgradeScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetUpgradeScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetUpgradeScheduleResponse.Merge(m, src)
+}
+func (m *QueryGetUpgradeScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetUpgradeScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetUpgradeScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetUpgradeScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryGetUpgradeScheduleResponse) GetUpgrade() *Upgrade {
+	if m != nil {
+		return m.Upgrade
+	}
+	return nil
+}
+
+func (m *QueryGetUpgradeScheduleResponse) GetCurrentHeight() int64 {
+	if m != nil {
+		return m.CurrentHeight
+	}
+	return 0
+}
+
+func (m *QueryGetUpgradeScheduleResponse) GetBlocksRemaining() int64 {
+	if m != nil {
+		return m.BlocksRemaining
+	}
+	return 0
+}
+
+func (m *QueryGetUpgradeScheduleResponse) GetEstimatedTime() *time.Time {
+	if m != nil {
+		return m.EstimatedTime
+	}
+	return nil
+}
+
+// QuerySignalHistoryRequest is the request type for the SignalHistory query.
+type QuerySignalHistoryRequest struct {
+	// ValidatorAddress optionally restricts the history to a single validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Version optionally restricts the history to signals for a single version.
+	// Zero returns signals for every version.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySignalHistoryRequest) Reset()         { *m = QuerySignalHistoryRequest{} }
+func (m *QuerySignalHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalHistoryRequest) ProtoMessage()    {}
+func (*QuerySignalHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{8}
+}
+func (m *QuerySignalHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignalHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignalHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignalHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalHistoryRequest.Merge(m, src)
+}
+func (m *QuerySignalHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignalHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignalHistoryRequest proto.InternalMessageInfo
+
+func (m *QuerySignalHistoryRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QuerySignalHistoryRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *QuerySignalHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySignalHistoryResponse is the response type for the SignalHistory query.
+type QuerySignalHistoryResponse struct {
+	// Records are the signal records that matched the request.
+	Records []SignalRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySignalHistoryResponse) Reset()         { *m = QuerySignalHistoryResponse{} }
+func (m *QuerySignalHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalHistoryResponse) ProtoMessage()    {}
+func (*QuerySignalHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{9}
+}
+func (m *QuerySignalHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignalHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignalHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignalHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalHistoryResponse.Merge(m, src)
+}
+func (m *QuerySignalHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignalHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignalHistoryResponse proto.InternalMessageInfo
+
+func (m *QuerySignalHistoryResponse) GetRecords() []SignalRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QuerySignalHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryVersionTallyRequest)(nil), "celestia.signal.v1.QueryVersionTallyRequest")
 	proto.RegisterType((*QueryVersionTallyResponse)(nil), "celestia.signal.v1.QueryVersionTallyResponse")
@@ -314,43 +554,70 @@ func init() {
 	proto.RegisterType((*QueryGetUpgradeResponse)(nil), "celestia.signal.v1.QueryGetUpgradeResponse")
 	proto.RegisterType((*QueryGetMissingValidatorsRequest)(nil), "celestia.signal.v1.QueryGetMissingValidatorsRequest")
 	proto.RegisterType((*QueryGetMissingValidatorsResponse)(nil), "celestia.signal.v1.QueryGetMissingValidatorsResponse")
+	proto.RegisterType((*QueryGetUpgradeScheduleRequest)(nil), "celestia.signal.v1.QueryGetUpgradeScheduleRequest")
+	proto.RegisterType((*QueryGetUpgradeScheduleResponse)(nil), "celestia.signal.v1.QueryGetUpgradeScheduleResponse")
+	proto.RegisterType((*QuerySignalHistoryRequest)(nil), "celestia.signal.v1.QuerySignalHistoryRequest")
+	proto.RegisterType((*QuerySignalHistoryResponse)(nil), "celestia.signal.v1.QuerySignalHistoryResponse")
 }
 
 func init() { proto.RegisterFile("celestia/signal/v1/query.proto", fileDescriptor_7af24246367e432c) }
 
 var fileDescriptor_7af24246367e432c = []byte{
-	// 490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0x36, 0x40, 0xc5, 0xb4, 0xe2, 0x63, 0x54, 0x81, 0x31, 0x91, 0x95, 0x5a, 0x48, 0x54,
-	0xd0, 0xd8, 0x6a, 0x68, 0x6f, 0x9c, 0xb8, 0x70, 0x01, 0xa9, 0x44, 0x90, 0x03, 0x97, 0x68, 0xdb,
-	0xac, 0x9c, 0x95, 0x1c, 0xaf, 0xbb, 0xbb, 0x31, 0x44, 0x88, 0x03, 0xfc, 0x01, 0x90, 0x10, 0x3f,
-	0x82, 0x7f, 0x02, 0xb7, 0x4a, 0x5c, 0x38, 0xa2, 0x84, 0x1f, 0x82, 0xb2, 0xbb, 0x4e, 0x02, 0x49,
-	0x4a, 0xb9, 0x25, 0x33, 0xef, 0xbd, 0x79, 0xf3, 0x66, 0x0d, 0xc1, 0x31, 0x4b, 0x99, 0xd2, 0x9c,
-	0xc6, 0x8a, 0x27, 0x19, 0x4d, 0xe3, 0x62, 0x2f, 0x3e, 0x19, 0x30, 0x39, 0x8c, 0x72, 0x29, 0xb4,
-	0x40, 0x2c, 0xfb, 0x91, 0xed, 0x47, 0xc5, 0x9e, 0x5f, 0x4b, 0x84, 0x48, 0x52, 0x16, 0xd3, 0x9c,
-	0xc7, 0x34, 0xcb, 0x84, 0xa6, 0x9a, 0x8b, 0x4c, 0x59, 0x86, 0x5f, 0x5f, 0xa2, 0x38, 0xc8, 0x13,
-	0x49, 0xbb, 0xcc, 0x22, 0xc2, 0x7d, 0xf0, 0x9e, 0x4d, 0x46, 0xb4, 0x99, 0x54, 0x5c, 0x64, 0xcf,
-	0x69, 0x9a, 0x0e, 0x5b, 0xec, 0x64, 0xc0, 0x94, 0x46, 0x0f, 0xd6, 0x0b, 0x5b, 0xf6, 0x48, 0x9d,
-	0xec, 0x5c, 0x68, 0x95, 0x7f, 0xc3, 0xcf, 0x04, 0x6e, 0x2d, 0xa1, 0xa9, 0x5c, 0x64, 0x8a, 0xe1,
-	0x36, 0x6c, 0x16, 0x42, 0xf3, 0x2c, 0xe9, 0xe4, 0xe2, 0x15, 0x93, 0x8e, 0xbc, 0x61, 0x6b, 0x87,
-	0x93, 0x12, 0xde, 0x85, 0xab, 0xba, 0x27, 0x99, 0xea, 0x89, 0xb4, 0xeb, 0x50, 0x6b, 0x06, 0x75,
-	0x65, 0x5a, 0xb6, 0xc0, 0x5d, 0x40, 0x2d, 0x34, 0x4d, 0x3b, 0x7f, 0x28, 0x56, 0x0d, 0xf6, 0x9a,
-	0xe9, 0xb4, 0x67, 0xb2, 0xa1, 0x07, 0x37, 0x8c, 0xad, 0xc7, 0x4c, 0xbf, 0xb0, 0x6b, 0xba, 0x5d,
-	0xc2, 0x43, 0xb8, 0xb9, 0xd0, 0x71, 0x76, 0x0f, 0x60, 0xdd, 0x65, 0x62, 0x9c, 0x6e, 0x34, 0x6f,
-	0x47, 0x8b, 0x41, 0x47, 0x25, 0xab, 0xc4, 0x86, 0x0f, 0xa1, 0x5e, 0x2a, 0x3e, 0xe5, 0x4a, 0xf1,
-	0x2c, 0x69, 0xd3, 0x94, 0x77, 0xa9, 0x16, 0x52, 0xfd, 0x3b, 0xc1, 0x16, 0x6c, 0x9f, 0xc1, 0x76,
-	0xce, 0x1a, 0x80, 0x7d, 0xdb, 0xec, 0x14, 0xd3, 0xae, 0x47, 0xea, 0xd5, 0x9d, 0xcb, 0xad, 0xeb,
-	0xfd, 0xbf, 0x69, 0xcd, 0x6f, 0x55, 0xb8, 0x68, 0x44, 0xf1, 0x03, 0x81, 0xcd, 0xf9, 0xd3, 0xe0,
-	0xee, 0xb2, 0x95, 0x56, 0x1d, 0xde, 0x6f, 0x9c, 0x13, 0x6d, 0x6d, 0x86, 0xe1, 0xfb, 0xef, 0xbf,
-	0x3e, 0xad, 0xd5, 0xd0, 0x9f, 0x7b, 0x65, 0x7a, 0x82, 0x88, 0xdf, 0xb8, 0x75, 0xdf, 0xe2, 0x3b,
-	0x02, 0x30, 0xcb, 0x1e, 0xef, 0xad, 0x9c, 0xb0, 0x70, 0x3a, 0xff, 0xfe, 0xb9, 0xb0, 0xce, 0x8b,
-	0x6f, 0xbc, 0x6c, 0x21, 0x2e, 0xbe, 0x78, 0xfc, 0x42, 0x60, 0x6b, 0x59, 0xde, 0xb8, 0x7f, 0xd6,
-	0x84, 0x55, 0xc7, 0xf5, 0x0f, 0xfe, 0x93, 0xe5, 0x1c, 0xde, 0x31, 0x0e, 0x03, 0xac, 0xcd, 0x39,
-	0x74, 0xb7, 0x9c, 0xe5, 0xf5, 0xe8, 0xc9, 0xd7, 0x51, 0x40, 0x4e, 0x47, 0x01, 0xf9, 0x39, 0x0a,
-	0xc8, 0xc7, 0x71, 0x50, 0x39, 0x1d, 0x07, 0x95, 0x1f, 0xe3, 0xa0, 0xf2, 0xb2, 0x99, 0x70, 0xdd,
-	0x1b, 0x1c, 0x45, 0xc7, 0xa2, 0x1f, 0x97, 0x06, 0x84, 0x4c, 0xa6, 0xbf, 0x1b, 0x34, 0xcf, 0xe3,
-	0xd7, 0xa5, 0xb8, 0x1e, 0xe6, 0x4c, 0x1d, 0x5d, 0x32, 0x1f, 0xfb, 0x83, 0xdf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x79, 0xc3, 0x7d, 0xbc, 0x62, 0x04, 0x00, 0x00,
+	// 860 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0xb3, 0x5b, 0xa2, 0xce, 0x36, 0x69, 0x3a, 0x8a, 0x60, 0xeb, 0xa6, 0xde, 0x8d, 0xf9,
+	0xd1, 0x52, 0xba, 0xb6, 0x76, 0xdb, 0xde, 0x38, 0xc0, 0x1e, 0x48, 0x0f, 0x80, 0x82, 0x53, 0x72,
+	0xe0, 0x62, 0xcd, 0xae, 0x07, 0xef, 0x08, 0xdb, 0xe3, 0xce, 0xcc, 0x2e, 0x44, 0x88, 0x03, 0x1c,
+	0xb9, 0x50, 0x09, 0xf1, 0x0f, 0x70, 0x41, 0xdc, 0xf9, 0x23, 0x7a, 0x41, 0xaa, 0xe0, 0xc2, 0x09,
+	0x50, 0xc2, 0x7f, 0xc0, 0x91, 0x0b, 0xf2, 0xfc, 0x70, 0xf6, 0x87, 0x37, 0xdd, 0xa8, 0x37, 0xfb,
+	0xbd, 0xef, 0x9b, 0xf9, 0xde, 0x7b, 0xf3, 0x3e, 0xe0, 0x0c, 0x71, 0x82, 0xb9, 0x20, 0xc8, 0xe7,
+	0x24, 0xce, 0x50, 0xe2, 0x4f, 0xba, 0xfe, 0xe3, 0x31, 0x66, 0xc7, 0x5e, 0xce, 0xa8, 0xa0, 0x10,
+	0x9a, 0xbc, 0xa7, 0xf2, 0xde, 0xa4, 0x6b, 0xdf, 0x19, 0x52, 0x9e, 0x52, 0xee, 0x0f, 0x10, 0xc7,
+	0x0a, 0xec, 0x4f, 0xba, 0x03, 0x2c, 0x50, 0xd7, 0xcf, 0x51, 0x4c, 0x32, 0x24, 0x08, 0xcd, 0x14,
+	0xdf, 0xbe, 0xae, 0xb0, 0xa1, 0xfc, 0xf3, 0xd5, 0x8f, 0x4e, 0xed, 0xc4, 0x34, 0xa6, 0x2a, 0x5e,
+	0x7c, 0xe9, 0xe8, 0x6e, 0x4c, 0x69, 0x9c, 0x60, 0x1f, 0xe5, 0xc4, 0x47, 0x59, 0x46, 0x85, 0x3c,
+	0xcd, 0x70, 0x5a, 0x3a, 0x2b, 0xff, 0x06, 0xe3, 0x4f, 0x7d, 0x41, 0x52, 0xcc, 0x05, 0x4a, 0x73,
+	0x03, 0xa8, 0xa8, 0x47, 0x2b, 0x57, 0x80, 0x76, 0x05, 0x60, 0x9c, 0xc7, 0x0c, 0x45, 0x58, 0x21,
+	0xdc, 0xfb, 0xa0, 0xf9, 0x51, 0x51, 0xd4, 0x11, 0x66, 0x9c, 0xd0, 0xec, 0x11, 0x4a, 0x92, 0xe3,
+	0x00, 0x3f, 0x1e, 0x63, 0x2e, 0x60, 0x13, 0x6c, 0x4c, 0x54, 0xb8, 0x69, 0xb5, 0xad, 0xdb, 0xf5,
+	0xc0, 0xfc, 0xba, 0x3f, 0x58, 0xe0, 0x7a, 0x05, 0x8d, 0xe7, 0x34, 0xe3, 0x18, 0xee, 0x81, 0x2b,
+	0x13, 0x2a, 0x48, 0x16, 0x87, 0x39, 0xfd, 0x1c, 0x33, 0x4d, 0x6e, 0xa8, 0xd8, 0x41, 0x11, 0x82,
+	0xb7, 0xc0, 0x55, 0x31, 0x62, 0x98, 0x8f, 0x68, 0x12, 0x69, 0xd4, 0xba, 0x44, 0x6d, 0x95, 0x61,
+	0x05, 0xbc, 0x0b, 0xa0, 0xa0, 0x02, 0x25, 0xe1, 0xcc, 0x89, 0x35, 0x89, 0xdd, 0x96, 0x99, 0xa3,
+	0xb3, 0x63, 0xdd, 0x26, 0x78, 0x59, 0xca, 0xda, 0xc7, 0xe2, 0x63, 0x55, 0xa6, 0xae, 0xc5, 0x3d,
+	0x00, 0xaf, 0x2c, 0x64, 0xb4, 0xdc, 0x07, 0x60, 0x43, 0xf7, 0x44, 0x2a, 0x6d, 0xf4, 0x6e, 0x78,
+	0x8b, 0xef, 0xc0, 0x33, 0x2c, 0x83, 0x75, 0xdf, 0x06, 0x6d, 0x73, 0xe2, 0x07, 0x84, 0x73, 0x92,
+	0xc5, 0x47, 0x28, 0x21, 0x11, 0x12, 0x94, 0xf1, 0xe7, 0x77, 0x30, 0x00, 0x7b, 0xe7, 0xb0, 0xb5,
+	0xb2, 0x0e, 0x80, 0xa9, 0x4a, 0x86, 0x93, 0x32, 0xdb, 0xb4, 0xda, 0xb5, 0xdb, 0x97, 0x83, 0x6b,
+	0xe9, 0x3c, 0xcd, 0x6d, 0x03, 0x67, 0xae, 0xc6, 0xc3, 0xe1, 0x08, 0x47, 0xe3, 0xa4, 0xec, 0xc2,
+	0xbf, 0x16, 0x68, 0x2d, 0x85, 0xbc, 0x50, 0x3b, 0xe0, 0xeb, 0x60, 0x6b, 0x38, 0x66, 0x0c, 0x67,
+	0x22, 0x1c, 0x61, 0x12, 0x8f, 0x84, 0x1c, 0x68, 0x2d, 0xd8, 0xd4, 0xd1, 0x87, 0x32, 0x08, 0xdf,
+	0x04, 0xdb, 0x83, 0x84, 0x0e, 0x3f, 0xe3, 0x21, 0xc3, 0x29, 0x22, 0x19, 0xc9, 0x62, 0x39, 0xcd,
+	0x5a, 0x70, 0x55, 0xc5, 0x03, 0x13, 0x86, 0xfb, 0x60, 0xab, 0xb8, 0x35, 0x45, 0x02, 0x47, 0x61,
+	0xf1, 0xf4, 0x9b, 0x75, 0xa9, 0xc7, 0xf6, 0xd4, 0x5e, 0x78, 0x66, 0x2f, 0xbc, 0x47, 0x66, 0x2f,
+	0xfa, 0xf5, 0x27, 0x7f, 0xb5, 0xac, 0x60, 0xb3, 0xe4, 0x15, 0x19, 0xf7, 0x57, 0xf3, 0x5a, 0x0f,
+	0xa5, 0xfc, 0x87, 0x84, 0x0b, 0xca, 0xca, 0x57, 0xfe, 0x21, 0xb8, 0x56, 0x36, 0x37, 0x44, 0x51,
+	0xc4, 0x30, 0xe7, 0xb2, 0xf2, 0xcb, 0xfd, 0xbd, 0xdf, 0x7e, 0xe9, 0xdc, 0xd4, 0x6b, 0x5c, 0xf6,
+	0xf9, 0x5d, 0x05, 0x39, 0x14, 0x8c, 0x64, 0x71, 0xb0, 0x3d, 0x99, 0x8b, 0x4f, 0xcf, 0x7c, 0x7d,
+	0x66, 0xe6, 0xf0, 0x3d, 0x00, 0xce, 0x2c, 0x43, 0x56, 0xdd, 0xe8, 0xbd, 0xe1, 0xe9, 0xf3, 0x0b,
+	0x7f, 0xf1, 0x94, 0x19, 0x69, 0x7f, 0xf1, 0x0e, 0x50, 0x6c, 0x26, 0x17, 0x4c, 0x31, 0xdd, 0x9f,
+	0x2c, 0x60, 0x57, 0xd5, 0xa3, 0x07, 0xf8, 0x0e, 0xd8, 0x60, 0x78, 0x48, 0x59, 0xa4, 0x9e, 0x4a,
+	0xa3, 0xd7, 0xae, 0x1a, 0xa0, 0xe2, 0x06, 0x12, 0xd8, 0xaf, 0x3f, 0xfd, 0xb3, 0xb5, 0x16, 0x18,
+	0x1a, 0xdc, 0x9f, 0x11, 0xba, 0x2e, 0x85, 0xde, 0x7a, 0xae, 0x50, 0x75, 0xfd, 0xb4, 0xd2, 0xde,
+	0x7f, 0x97, 0xc0, 0x25, 0xa9, 0x14, 0x7e, 0x67, 0x81, 0x2b, 0xd3, 0x66, 0x01, 0xef, 0x56, 0x89,
+	0x5a, 0x66, 0x45, 0x76, 0x67, 0x45, 0xb4, 0xd2, 0xe0, 0xba, 0xdf, 0xfc, 0xfe, 0xcf, 0xf7, 0xeb,
+	0xbb, 0xd0, 0x9e, 0xf2, 0x3d, 0x51, 0x20, 0xfc, 0x2f, 0xf5, 0x30, 0xbe, 0x82, 0x5f, 0x5b, 0x00,
+	0x9c, 0xad, 0x01, 0xbc, 0xb3, 0xf4, 0x86, 0x05, 0x33, 0xb1, 0xdf, 0x5a, 0x09, 0xab, 0xb5, 0xd8,
+	0x52, 0xcb, 0x0e, 0x84, 0x8b, 0x1e, 0x0c, 0x7f, 0xb6, 0xc0, 0x4e, 0x95, 0x03, 0xc0, 0xfb, 0xe7,
+	0xdd, 0xb0, 0xcc, 0x6e, 0xec, 0x07, 0x17, 0x64, 0x69, 0x85, 0xaf, 0x49, 0x85, 0x0e, 0xdc, 0x9d,
+	0x52, 0xa8, 0xdd, 0x65, 0xaa, 0x5f, 0x3f, 0x5a, 0x00, 0x2e, 0xda, 0x06, 0xec, 0xad, 0xd0, 0x8b,
+	0x39, 0x1b, 0xb2, 0xef, 0x5d, 0x88, 0xa3, 0x55, 0xbe, 0x2a, 0x55, 0xde, 0x84, 0x37, 0x16, 0xfb,
+	0xe8, 0x73, 0xa3, 0xe6, 0x5b, 0x0b, 0x6c, 0xce, 0x6c, 0x05, 0x5c, 0xfe, 0x72, 0xaa, 0xdc, 0xc0,
+	0xf6, 0x56, 0x85, 0x9f, 0x33, 0xdd, 0x91, 0xc2, 0xf4, 0xdf, 0x7f, 0x7a, 0xe2, 0x58, 0xcf, 0x4e,
+	0x1c, 0xeb, 0xef, 0x13, 0xc7, 0x7a, 0x72, 0xea, 0xac, 0x3d, 0x3b, 0x75, 0xd6, 0xfe, 0x38, 0x75,
+	0xd6, 0x3e, 0xe9, 0xc5, 0x44, 0x8c, 0xc6, 0x03, 0x6f, 0x48, 0x53, 0xdf, 0xdc, 0x47, 0x59, 0x5c,
+	0x7e, 0x77, 0x50, 0x9e, 0xfb, 0x5f, 0x98, 0x23, 0xc5, 0x71, 0x8e, 0xf9, 0xe0, 0x25, 0x69, 0x77,
+	0xf7, 0xfe, 0x1f, 0x00, 0x32, 0x30, 0x00, 0x81, 0xc5, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -374,6 +641,14 @@ type QueryClient interface {
 	// GetMissingValidators enables a client to query for the validators that
 	// have not yet signalled for a particular version
 	GetMissingValidators(ctx context.Context, in *QueryGetMissingValidatorsRequest, opts ...grpc.CallOption) (*QueryGetMissingValidatorsResponse, error)
+	// GetUpgradeSchedule enables a client to query for the height at which a
+	// pending upgrade is scheduled along with an estimate of when that height
+	// will be reached. The response will be empty if no upgrade is pending.
+	GetUpgradeSchedule(ctx context.Context, in *QueryGetUpgradeScheduleRequest, opts ...grpc.CallOption) (*QueryGetUpgradeScheduleResponse, error)
+	// SignalHistory enables a client to query for the history of signals,
+	// optionally filtered by validator and version. Records are returned in the
+	// order in which they were signalled.
+	SignalHistory(ctx context.Context, in *QuerySignalHistoryRequest, opts ...grpc.CallOption) (*QuerySignalHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -411,6 +686,24 @@ func (c *queryClient) GetMissingValidators(ctx context.Context, in *QueryGetMiss
 	return out, nil
 }
 
+func (c *queryClient) GetUpgradeSchedule(ctx context.Context, in *QueryGetUpgradeScheduleRequest, opts ...grpc.CallOption) (*QueryGetUpgradeScheduleResponse, error) {
+	out := new(QueryGetUpgradeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/GetUpgradeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SignalHistory(ctx context.Context, in *QuerySignalHistoryRequest, opts ...grpc.CallOption) (*QuerySignalHistoryResponse, error) {
+	out := new(QuerySignalHistoryResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/SignalHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// VersionTally enables a client to query for the tally of voting power that
@@ -422,6 +715,14 @@ type QueryServer interface {
 	// GetMissingValidators enables a client to query for the validators that
 	// have not yet signalled for a particular version
 	GetMissingValidators(context.Context, *QueryGetMissingValidatorsRequest) (*QueryGetMissingValidatorsResponse, error)
+	// GetUpgradeSchedule enables a client to query for the height at which a
+	// pending upgrade is scheduled along with an estimate of when that height
+	// will be reached. The response will be empty if no upgrade is pending.
+	GetUpgradeSchedule(context.Context, *QueryGetUpgradeScheduleRequest) (*QueryGetUpgradeScheduleResponse, error)
+	// SignalHistory enables a client to query for the history of signals,
+	// optionally filtered by validator and version. Records are returned in the
+	// order in which they were signalled.
+	SignalHistory(context.Context, *QuerySignalHistoryRequest) (*QuerySignalHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -437,6 +738,12 @@ func (*UnimplementedQueryServer) GetUpgrade(ctx context.Context, req *QueryGetUp
 func (*UnimplementedQueryServer) GetMissingValidators(ctx context.Context, req *QueryGetMissingValidatorsRequest) (*QueryGetMissingValidatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMissingValidators not implemented")
 }
+func (*UnimplementedQueryServer) GetUpgradeSchedule(ctx context.Context, req *QueryGetUpgradeScheduleRequest) (*QueryGetUpgradeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpgradeSchedule not implemented")
+}
+func (*UnimplementedQueryServer) SignalHistory(ctx context.Context, req *QuerySignalHistoryRequest) (*QuerySignalHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -496,6 +803,42 @@ func _Query_GetMissingValidators_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetUpgradeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetUpgradeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetUpgradeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Query/GetUpgradeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetUpgradeSchedule(ctx, req.(*QueryGetUpgradeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignalHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignalHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignalHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Query/SignalHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignalHistory(ctx, req.(*QuerySignalHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.signal.v1.Query",
@@ -513,6 +856,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMissingValidators",
 			Handler:    _Query_GetMissingValidators_Handler,
 		},
+		{
+			MethodName: "GetUpgradeSchedule",
+			Handler:    _Query_GetUpgradeSchedule_Handler,
+		},
+		{
+			MethodName: "SignalHistory",
+			Handler:    _Query_SignalHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/signal/v1/query.proto",
@@ -702,82 +1053,256 @@ func (m *QueryGetMissingValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGetUpgradeScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryVersionTallyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovQuery(uint64(m.Version))
-	}
-	return n
+
+func (m *QueryGetUpgradeScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVersionTallyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetUpgradeScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
-	}
-	if m.ThresholdPower != 0 {
-		n += 1 + sovQuery(uint64(m.ThresholdPower))
-	}
-	if m.TotalVotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.TotalVotingPower))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetUpgradeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetUpgradeScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetUpgradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Upgrade != nil {
-		l = m.Upgrade.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGetUpgradeScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetMissingValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetUpgradeScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Version != 0 {
-		n += 1 + sovQuery(uint64(m.Version))
+	if m.EstimatedTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EstimatedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EstimatedTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *QueryGetMissingValidatorsResponse) Size() (n int) {
+	if m.BlocksRemaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksRemaining))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CurrentHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Upgrade != nil {
+		{
+			size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryVersionTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryVersionTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	if m.ThresholdPower != 0 {
+		n += 1 + sovQuery(uint64(m.ThresholdPower))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalVotingPower))
+	}
+	return n
+}
+
+func (m *QueryGetUpgradeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMissingValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryGetMissingValidatorsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -792,6 +1317,77 @@ func (m *QueryGetMissingValidatorsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetUpgradeScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetUpgradeScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CurrentHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentHeight))
+	}
+	if m.BlocksRemaining != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksRemaining))
+	}
+	if m.EstimatedTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EstimatedTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignalHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignalHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1261,6 +1857,473 @@ func (m *QueryGetMissingValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGetUpgradeScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUpgradeScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUpgradeScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUpgradeScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUpgradeScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUpgradeScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Upgrade == nil {
+				m.Upgrade = &Upgrade{}
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
+			}
+			m.CurrentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksRemaining", wireType)
+			}
+			m.BlocksRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksRemaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EstimatedTime == nil {
+				m.EstimatedTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EstimatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignalHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignalHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignalHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignalHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignalHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignalHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, SignalRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,571 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/signal/v1/signal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SignalRecord is a historical record of a validator signalling for a
+// version.
+type SignalRecord struct {
+	// ValidatorAddress is the address of the validator that signalled.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Version is the version that the validator signalled for.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// PreviousVersion is the version that the validator had signalled for
+	// before this signal. It is zero if the validator had not signalled.
+	PreviousVersion uint64 `protobuf:"varint,3,opt,name=previous_version,json=previousVersion,proto3" json:"previous_version,omitempty"`
+	// Height is the block height at which the signal was recorded.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// Time is the block time at which the signal was recorded.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// VotingPower is the voting power of the validator when it signalled.
+	VotingPower int64 `protobuf:"varint,6,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// TallyVotingPower is the total voting power that had signalled for Version
+	// after this signal was recorded.
+	TallyVotingPower uint64 `protobuf:"varint,7,opt,name=tally_voting_power,json=tallyVotingPower,proto3" json:"tally_voting_power,omitempty"`
+}
+
+func (m *SignalRecord) Reset()         { *m = SignalRecord{} }
+func (m *SignalRecord) String() string { return proto.CompactTextString(m) }
+func (*SignalRecord) ProtoMessage()    {}
+func (*SignalRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81f9c6693a696b96, []int{0}
+}
+func (m *SignalRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalRecord.Merge(m, src)
+}
+func (m *SignalRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalRecord proto.InternalMessageInfo
+
+func (m *SignalRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SignalRecord) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SignalRecord) GetPreviousVersion() uint64 {
+	if m != nil {
+		return m.PreviousVersion
+	}
+	return 0
+}
+
+func (m *SignalRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SignalRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *SignalRecord) GetVotingPower() int64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+func (m *SignalRecord) GetTallyVotingPower() uint64 {
+	if m != nil {
+		return m.TallyVotingPower
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SignalRecord)(nil), "celestia.signal.v1.SignalRecord")
+}
+
+func init() { proto.RegisterFile("celestia/signal/v1/signal.proto", fileDescriptor_81f9c6693a696b96) }
+
+var fileDescriptor_81f9c6693a696b96 = []byte{
+	// 372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x41, 0xcf, 0xd2, 0x30,
+	0x18, 0xc7, 0xd7, 0xf7, 0x45, 0xd0, 0x42, 0x22, 0x36, 0xc6, 0x4c, 0x12, 0xb7, 0xe1, 0x69, 0x26,
+	0xb2, 0x05, 0xbc, 0x78, 0x95, 0xb3, 0x31, 0x66, 0x18, 0x0e, 0x5e, 0x96, 0xb2, 0xd5, 0xd2, 0x64,
+	0xdb, 0xb3, 0xb4, 0x65, 0xca, 0xb7, 0xe0, 0xc3, 0xf8, 0x19, 0x0c, 0x47, 0xe2, 0xc9, 0x93, 0x1a,
+	0xf8, 0x22, 0x86, 0x6e, 0xd5, 0xf8, 0xde, 0x9e, 0xff, 0x6f, 0xbf, 0x3d, 0xdb, 0x3f, 0x2d, 0xf6,
+	0x33, 0x56, 0x30, 0xa5, 0x05, 0x8d, 0x95, 0xe0, 0x15, 0x2d, 0xe2, 0x66, 0xde, 0x4d, 0x51, 0x2d,
+	0x41, 0x03, 0x21, 0x56, 0x88, 0x3a, 0xdc, 0xcc, 0x27, 0x4f, 0x33, 0x50, 0x25, 0xa8, 0xd4, 0x18,
+	0x71, 0x1b, 0x5a, 0x7d, 0xf2, 0x98, 0x03, 0x87, 0x96, 0x5f, 0xa7, 0x8e, 0xfa, 0x1c, 0x80, 0x17,
+	0x2c, 0x36, 0x69, 0xb3, 0xfb, 0x14, 0x6b, 0x51, 0x32, 0xa5, 0x69, 0x59, 0xb7, 0xc2, 0xf3, 0x6f,
+	0x37, 0x78, 0xb4, 0x32, 0xfb, 0x13, 0x96, 0x81, 0xcc, 0xc9, 0x3b, 0xfc, 0xa8, 0xa1, 0x85, 0xc8,
+	0xa9, 0x06, 0x99, 0xd2, 0x3c, 0x97, 0x4c, 0x29, 0x17, 0x05, 0x28, 0x7c, 0xb0, 0x9c, 0x7e, 0xff,
+	0x3a, 0x7b, 0xd6, 0x7d, 0x74, 0x6d, 0x9d, 0x37, 0xad, 0xb2, 0xd2, 0x52, 0x54, 0x3c, 0x19, 0x37,
+	0x77, 0x38, 0x71, 0xf1, 0xa0, 0x61, 0x52, 0x09, 0xa8, 0xdc, 0x9b, 0x00, 0x85, 0xbd, 0xc4, 0x46,
+	0xf2, 0x02, 0x8f, 0x6b, 0xc9, 0x1a, 0x01, 0x3b, 0x95, 0x5a, 0xe5, 0xd6, 0x28, 0x0f, 0x2d, 0x5f,
+	0x77, 0xea, 0x13, 0xdc, 0xdf, 0x32, 0xc1, 0xb7, 0xda, 0xed, 0x05, 0x28, 0xbc, 0x4d, 0xba, 0x44,
+	0x5e, 0xe3, 0xde, 0xb5, 0x90, 0x7b, 0x2f, 0x40, 0xe1, 0x70, 0x31, 0x89, 0xda, 0xb6, 0x91, 0x6d,
+	0x1b, 0x7d, 0xb0, 0x6d, 0x97, 0xf7, 0x8f, 0x3f, 0x7d, 0xe7, 0xf0, 0xcb, 0x47, 0x89, 0x79, 0x83,
+	0x4c, 0xf1, 0xa8, 0x01, 0x2d, 0x2a, 0x9e, 0xd6, 0xf0, 0x99, 0x49, 0xb7, 0x6f, 0xf6, 0x0e, 0x5b,
+	0xf6, 0xfe, 0x8a, 0xc8, 0x4b, 0x4c, 0x34, 0x2d, 0x8a, 0x7d, 0xfa, 0x9f, 0x38, 0x30, 0x7f, 0x38,
+	0x36, 0x4f, 0xd6, 0xff, 0xec, 0xe5, 0xdb, 0xe3, 0xd9, 0x43, 0xa7, 0xb3, 0x87, 0x7e, 0x9f, 0x3d,
+	0x74, 0xb8, 0x78, 0xce, 0xe9, 0xe2, 0x39, 0x3f, 0x2e, 0x9e, 0xf3, 0x71, 0xc1, 0x85, 0xde, 0xee,
+	0x36, 0x51, 0x06, 0x65, 0x6c, 0xcf, 0x14, 0x24, 0xff, 0x3b, 0xcf, 0x68, 0x5d, 0xc7, 0x5f, 0xec,
+	0x35, 0xd0, 0xfb, 0x9a, 0xa9, 0x4d, 0xdf, 0x54, 0x78, 0xf5, 0x67, 0x00, 0xa5, 0xc8, 0xa1, 0x6d,
+	0x26, 0x02, 0x00, 0x00,
+}
+
+func (m *SignalRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TallyVotingPower != 0 {
+		i = encodeVarintSignal(dAtA, i, uint64(m.TallyVotingPower))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.VotingPower != 0 {
+		i = encodeVarintSignal(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSignal(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintSignal(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreviousVersion != 0 {
+		i = encodeVarintSignal(dAtA, i, uint64(m.PreviousVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintSignal(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSignal(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSignal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSignal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SignalRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSignal(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovSignal(uint64(m.Version))
+	}
+	if m.PreviousVersion != 0 {
+		n += 1 + sovSignal(uint64(m.PreviousVersion))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSignal(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSignal(uint64(l))
+	if m.VotingPower != 0 {
+		n += 1 + sovSignal(uint64(m.VotingPower))
+	}
+	if m.TallyVotingPower != 0 {
+		n += 1 + sovSignal(uint64(m.TallyVotingPower))
+	}
+	return n
+}
+
+func sovSignal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSignal(x uint64) (n int) {
+	return sovSignal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SignalRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSignal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSignal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSignal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersion", wireType)
+			}
+			m.PreviousVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSignal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSignal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyVotingPower", wireType)
+			}
+			m.TallyVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TallyVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSignal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSignal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSignal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSignal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSignal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSignal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSignal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSignal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSignal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSignal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSignal = fmt.Errorf("proto: unexpected end of group")
+)
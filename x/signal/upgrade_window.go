@@ -0,0 +1,141 @@
+package signal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v10/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v10/x/signal/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// signalWithWindowLength is the length of a signal that includes a preferred
+// upgrade window: the version followed by the min and max heights.
+const signalWithWindowLength = 24
+
+// UpgradeWindow is the range of heights in which a validator would prefer an
+// upgrade to activate. A zero bound means the window is open on that side.
+type UpgradeWindow struct {
+	MinHeight int64
+	MaxHeight int64
+}
+
+// IsEmpty returns true if the window does not constrain the upgrade height.
+func (w UpgradeWindow) IsEmpty() bool {
+	return w.MinHeight == 0 && w.MaxHeight == 0
+}
+
+// Contains returns true if height falls within the window.
+func (w UpgradeWindow) Contains(height int64) bool {
+	if w.MinHeight != 0 && height < w.MinHeight {
+		return false
+	}
+	if w.MaxHeight != 0 && height > w.MaxHeight {
+		return false
+	}
+	return true
+}
+
+// SignalToBytes encodes a validator's signal. Signals without a preferred
+// upgrade window use the same encoding as VersionToBytes so that existing
+// state remains readable.
+func SignalToBytes(version uint64, window UpgradeWindow) []byte {
+	bz := VersionToBytes(version)
+	if window.IsEmpty() {
+		return bz
+	}
+	bz = binary.BigEndian.AppendUint64(bz, uint64(window.MinHeight))
+	return binary.BigEndian.AppendUint64(bz, uint64(window.MaxHeight))
+}
+
+// UpgradeWindowFromBytes decodes the preferred upgrade window from a
+// validator's signal. It returns an empty window if the signal does not
+// include one.
+func UpgradeWindowFromBytes(signal []byte) UpgradeWindow {
+	if len(signal) != signalWithWindowLength {
+		return UpgradeWindow{}
+	}
+	return UpgradeWindow{
+		MinHeight: int64(binary.BigEndian.Uint64(signal[8:16])),
+		MaxHeight: int64(binary.BigEndian.Uint64(signal[16:24])),
+	}
+}
+
+// ScheduleUpgradeHeight picks the height at which the network should upgrade
+// to version. The candidates are the earliest allowed height and every
+// preferred window start after it, up to one upgrade height delay past the
+// earliest height. A candidate after the earliest height is only chosen if the
+// bonded validators that signalled for version with a window containing it
+// reach the voting power threshold on their own. The lowest such candidate
+// wins, otherwise the upgrade is scheduled at the earliest height. Validators
+// that did not express a preference never delay the upgrade.
+func (k Keeper) ScheduleUpgradeHeight(ctx sdk.Context, version uint64, earliest int64) (int64, error) {
+	type preference struct {
+		power  int64
+		window UpgradeWindow
+	}
+
+	threshold, err := k.GetVotingPowerThreshold(ctx)
+	if err != nil {
+		return 0, err
+	}
+	latest := earliest + appconsts.GetUpgradeHeightDelay(ctx.HeaderInfo().ChainID)
+
+	var preferences []preference
+	var candidates []int64
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.FirstSignalKey, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
+			continue
+		}
+		if VersionFromBytes(iterator.Value()) != version {
+			continue
+		}
+		window := UpgradeWindowFromBytes(iterator.Value())
+		if window.IsEmpty() {
+			continue
+		}
+		valAddress := sdk.ValAddress(iterator.Key())
+		val, err := k.stakingKeeper.GetValidator(ctx, valAddress)
+		if err != nil {
+			if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+				continue
+			}
+			return 0, err
+		}
+		if !val.IsBonded() {
+			continue
+		}
+		power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddress)
+		if err != nil {
+			return 0, err
+		}
+		preferences = append(preferences, preference{power: power, window: window})
+		if window.MinHeight > earliest && window.MinHeight <= latest {
+			candidates = append(candidates, window.MinHeight)
+		}
+	}
+
+	slices.Sort(candidates)
+	candidates = slices.Compact(candidates)
+
+	for _, candidate := range candidates {
+		power := math.ZeroInt()
+		for _, p := range preferences {
+			if p.window.Contains(candidate) {
+				power = power.AddRaw(p.power)
+			}
+		}
+		if power.GTE(threshold) {
+			return candidate, nil
+		}
+	}
+	return earliest, nil
+}